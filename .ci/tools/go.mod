@@ -1,6 +1,6 @@
 module github.com/hashicorp/terraform-provider-aws/tools
 
-go 1.20
+go 1.25.8
 
 require (
 	github.com/YakDriver/tfproviderdocs v0.4.0
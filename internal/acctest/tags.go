@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ConfigResourceTags returns config with the "tags" argument of the resource
+// at address resourceName (for example "aws_vpc.test") set to tags.
+// Any existing "tags" argument is replaced, or removed if tags is nil.
+// It allows tagging tests to be driven by a resource's basic configuration.
+func ConfigResourceTags(t *testing.T, config, resourceName string, tags map[string]string) string {
+	t.Helper()
+
+	resourceType, name, ok := strings.Cut(resourceName, ".")
+	if !ok {
+		t.Fatalf("invalid resource address: %s", resourceName)
+	}
+
+	f, diags := hclwrite.ParseConfig([]byte(config), "", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("parsing configuration: %s", diags.Error())
+	}
+
+	block := f.Body().FirstMatchingBlock("resource", []string{resourceType, name})
+	if block == nil {
+		t.Fatalf("resource %s not found in configuration", resourceName)
+	}
+
+	body := block.Body()
+
+	switch {
+	case tags == nil:
+		body.RemoveAttribute("tags")
+	case len(tags) == 0:
+		body.SetAttributeValue("tags", cty.MapValEmpty(cty.String))
+	default:
+		v := make(map[string]cty.Value, len(tags))
+		for key, value := range tags {
+			v[key] = cty.StringVal(value)
+		}
+		body.SetAttributeValue("tags", cty.MapVal(v))
+	}
+
+	return string(f.Bytes())
+}
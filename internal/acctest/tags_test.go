@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestConfigResourceTags(t *testing.T) {
+	t.Parallel()
+
+	config := `
+resource "aws_vpc" "other" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = "test"
+  }
+}
+`
+
+	testCases := []struct {
+		TestName string
+		Tags     map[string]string
+		Want     []string
+		NotWant  []string
+	}{
+		{
+			TestName: "replace",
+			Tags: map[string]string{
+				"key1": "value1",
+				"key2": "",
+			},
+			Want:    []string{`key1 = "value1"`, `key2 = ""`, `cidr_block = "10.1.0.0/16"`},
+			NotWant: []string{`Name = "test"`},
+		},
+		{
+			TestName: "remove",
+			NotWant:  []string{`Name = "test"`, `tags`},
+		},
+		{
+			TestName: "empty",
+			Tags:     map[string]string{},
+			Want:     []string{`tags = {}`},
+			NotWant:  []string{`Name = "test"`},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got := acctest.ConfigResourceTags(t, config, "aws_vpc.test", testCase.Tags)
+
+			for _, want := range testCase.Want {
+				if !strings.Contains(got, want) {
+					t.Errorf("configuration does not contain %s:\n%s", want, got)
+				}
+			}
+			for _, notWant := range testCase.NotWant {
+				if strings.Contains(got, notWant) {
+					t.Errorf("configuration contains %s:\n%s", notWant, got)
+				}
+			}
+			if other := "resource \"aws_vpc\" \"other\" {\n  cidr_block = \"10.0.0.0/16\"\n}"; !strings.Contains(got, other) {
+				t.Errorf("other resource modified:\n%s", got)
+			}
+		})
+	}
+}
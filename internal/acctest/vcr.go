@@ -7,19 +7,18 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/json"
-	"encoding/xml"
 	"fmt"
 	"io"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
@@ -30,17 +29,14 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 	"gopkg.in/dnaeon/go-vcr.v3/recorder"
 )
 
-const (
-	envVarVCRMode = "VCR_MODE"
-	envVarVCRPath = "VCR_PATH"
-)
-
 type randomnessSource struct {
 	seed   int64
 	source rand.Source
@@ -93,17 +89,17 @@ func ProviderMeta(t *testing.T) *conns.AWSClient {
 }
 
 func isVCREnabled() bool {
-	return os.Getenv(envVarVCRMode) != "" && os.Getenv(envVarVCRPath) != ""
+	return vcr.IsEnabled()
 }
 
 func vcrMode() (recorder.Mode, error) {
-	switch v := os.Getenv(envVarVCRMode); v {
-	case "RECORDING":
+	switch v := os.Getenv(envvar.VCRMode); v {
+	case vcr.ModeRecording:
 		return recorder.ModeRecordOnce, nil
-	case "REPLAYING":
+	case vcr.ModeReplaying:
 		return recorder.ModeReplayOnly, nil
 	default:
-		return recorder.ModePassthrough, fmt.Errorf("unsupported value for %s: %s", envVarVCRMode, v)
+		return recorder.ModePassthrough, fmt.Errorf("unsupported value for %s: %s", envvar.VCRMode, v)
 	}
 }
 
@@ -158,7 +154,7 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		}
 		tlsConfig.MinVersion = tls.VersionTLS12
 
-		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
+		path := filepath.Join(os.Getenv(envvar.VCRPath), vcrFileName(testName))
 
 		// Create a VCR recorder around a default HTTP client.
 		r, err := recorder.NewWithOptions(&recorder.Options{
@@ -199,56 +195,9 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			}
 
 			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
-
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
 
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
-
-			return false
+			// JSON, query string and XML request bodies may be the same, but reordered or with different idempotency tokens.
+			return vcr.BodyMatches(r.Header.Get("Content-Type"), b.String(), i.Body)
 		})
 
 		// Use the wrapped HTTP Client for AWS APIs.
@@ -270,22 +219,48 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		}
 
 		// Don't retry requests if a recorded interaction isn't found.
-		// TODO Need to loop through all API clients to do this.
-		// TODO Use []*client.Client?
-		// TODO AWS SDK for Go v2 API clients.
-		meta.LogsConn(ctx).Handlers.AfterRetry.PushFront(func(r *request.Request) {
+		// API clients are built lazily from the session and configuration, so this applies to all API clients.
+		meta.Session.Handlers.AfterRetry.PushFront(func(r *request.Request) {
 			// We have to use 'Contains' rather than 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
 			if errs.Contains(r.Error, cassette.ErrInteractionNotFound.Error()) {
 				r.Retryable = aws.Bool(false)
 			}
 		})
 
+		// All AWS SDK for Go v2 API clients share the recorder's HTTP client.
+		if cfg := meta.AWSConfig(); cfg != nil {
+			cfg.HTTPClient = httpClient
+
+			retryer := cfg.Retryer
+			if retryer == nil {
+				retryer = func() aws_sdkv2.Retryer {
+					return retry_sdkv2.NewStandard()
+				}
+			}
+			cfg.Retryer = func() aws_sdkv2.Retryer {
+				return vcrRetryer{Retryer: retryer()}
+			}
+		}
+
 		providerMetas[testName] = meta
 
 		return meta, nil
 	}
 }
 
+// vcrRetryer is an AWS SDK for Go v2 retryer that doesn't retry requests if a recorded interaction isn't found.
+type vcrRetryer struct {
+	aws_sdkv2.Retryer
+}
+
+func (r vcrRetryer) IsErrorRetryable(err error) bool {
+	if errs.Contains(err, cassette.ErrInteractionNotFound.Error()) {
+		return false
+	}
+
+	return r.Retryer.IsErrorRetryable(err)
+}
+
 // vcrRandomnessSource returns a rand.Source for VCR testing.
 // In RECORDING mode, generates a new seed and saves it to a file, using the seed for the source.
 // In REPLAYING mode, reads a seed from a file and creates a source from it.
@@ -314,7 +289,7 @@ func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 			source: rand.NewSource(seed),
 		}
 	case recorder.ModeReplayOnly:
-		seed, err := readSeedFromFile(vcrSeedFile(os.Getenv(envVarVCRPath), testName))
+		seed, err := readSeedFromFile(vcrSeedFile(os.Getenv(envvar.VCRPath), testName))
 
 		if err != nil {
 			return nil, fmt.Errorf("no cassette found on disk for %s, please replay this testcase in recording mode - %w", testName, err)
@@ -409,7 +384,7 @@ func closeVCRRecorder(t *testing.T) {
 	if ok {
 		if !t.Failed() {
 			t.Log("persisting randomness seed")
-			if err := writeSeedToFile(s.seed, vcrSeedFile(os.Getenv(envVarVCRPath), t.Name())); err != nil {
+			if err := writeSeedToFile(s.seed, vcrSeedFile(os.Getenv(envvar.VCRPath), t.Name())); err != nil {
 				t.Error(err)
 			}
 		}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package awsmock
+
+import (
+	"sync"
+)
+
+// Handler handles an AWS API request.
+// Returning an *Error sends the protocol's error response.
+// Returning any other error sends an InternalFailure error response.
+type Handler func(r *Request) (*Response, error)
+
+// Respond returns a Handler sending a canned response body.
+func Respond(body any) Handler {
+	return func(*Request) (*Response, error) {
+		return &Response{Body: body}, nil
+	}
+}
+
+// RespondError returns a Handler sending a canned error response.
+func RespondError(statusCode int, code, message string) Handler {
+	return func(*Request) (*Response, error) {
+		return nil, &Error{StatusCode: statusCode, Code: code, Message: message}
+	}
+}
+
+// Sequence returns a Handler calling each of the specified handlers in turn.
+// Once all but the last handler have been called the last handler handles all further requests.
+// It can be used to test retry paths, e.g. an error response followed by a successful response.
+func Sequence(handlers ...Handler) Handler {
+	var (
+		mu sync.Mutex
+		n  int
+	)
+
+	return func(r *Request) (*Response, error) {
+		mu.Lock()
+		h := handlers[n]
+		if n < len(handlers)-1 {
+			n++
+		}
+		mu.Unlock()
+
+		return h(r)
+	}
+}
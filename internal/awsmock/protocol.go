@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package awsmock
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Protocol is an AWS API protocol.
+type Protocol string
+
+const (
+	ProtocolJSON     Protocol = "json"
+	ProtocolQuery    Protocol = "query"
+	ProtocolEC2Query Protocol = "ec2"
+	ProtocolRESTJSON Protocol = "rest-json"
+	ProtocolRESTXML  Protocol = "rest-xml"
+)
+
+// restXMLServices are the signing names of services using the REST-XML protocol.
+// All other services not using the JSON or Query protocols are assumed to use REST-JSON.
+var restXMLServices = map[string]bool{
+	"cloudfront": true,
+	"route53":    true,
+	"s3":         true,
+}
+
+// Request is an AWS API request received by a Server.
+type Request struct {
+	// Service is the service's SigV4 signing name, e.g. "sqs".
+	Service string
+	// Operation is the API operation name, e.g. "CreateQueue".
+	Operation string
+	Protocol  Protocol
+	RequestID string
+	// Params are the request parameters.
+	// For the JSON and REST-JSON protocols these are the decoded JSON request body.
+	// For the Query protocols these are the form values keyed by their flattened names, e.g. "Attribute.1.Name".
+	Params map[string]any
+	// PathParams are the REST protocol path parameters.
+	PathParams  map[string]string
+	Body        []byte
+	HTTPRequest *http.Request
+}
+
+// Param returns the string value of the specified top-level request parameter.
+func (r *Request) Param(name string) string {
+	switch v := r.Params[name].(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func (r *Request) decodeJSON() error {
+	if len(r.Body) == 0 {
+		r.Params = make(map[string]any)
+		return nil
+	}
+
+	if err := json.Unmarshal(r.Body, &r.Params); err != nil {
+		return fmt.Errorf("decoding %s %s request: %w", r.Service, r.Operation, err)
+	}
+
+	return nil
+}
+
+// Response is an AWS API response returned by a Handler.
+type Response struct {
+	// StatusCode defaults to 200.
+	StatusCode int
+	Header     http.Header
+	// Body is the response body.
+	//
+	// For the JSON and REST-JSON protocols values other than string or []byte are marshaled to JSON.
+	//
+	// For the Query protocols Body is the XML content of the result element
+	// (e.g. "<QueueUrl>...</QueueUrl>" for SQS CreateQueue) and is wrapped in the protocol's response elements.
+	// Values other than string or []byte are marshaled to XML.
+	//
+	// For the REST-XML protocol values other than string or []byte are marshaled to XML.
+	Body any
+}
+
+// Error is an AWS API error response.
+type Error struct {
+	// StatusCode defaults to 400.
+	StatusCode int
+	Code       string
+	Message    string
+	// QueryErrorCode is the error code returned in the x-amzn-query-error header by
+	// JSON protocol services that are compatible with their former Query protocol (e.g. SQS),
+	// e.g. "AWS.SimpleQueueService.NonExistentQueue" for Code "QueueDoesNotExist".
+	QueryErrorCode string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func writeResponse(w http.ResponseWriter, r *Request, response *Response) error {
+	if response == nil {
+		response = &Response{}
+	}
+
+	var (
+		body        []byte
+		contentType string
+		err         error
+	)
+
+	switch r.Protocol {
+	case ProtocolJSON:
+		contentType = r.HTTPRequest.Header.Get("Content-Type") // The same JSON protocol version as the request.
+		body, err = marshalJSON(response.Body)
+	case ProtocolRESTJSON:
+		contentType = "application/json"
+		body, err = marshalJSON(response.Body)
+	case ProtocolQuery:
+		contentType = "text/xml"
+		body, err = marshalXML(response.Body)
+		body = []byte(fmt.Sprintf(`<%[1]sResponse><%[1]sResult>%[2]s</%[1]sResult><ResponseMetadata><RequestId>%[3]s</RequestId></ResponseMetadata></%[1]sResponse>`, r.Operation, body, r.RequestID))
+	case ProtocolEC2Query:
+		contentType = "text/xml"
+		body, err = marshalXML(response.Body)
+		body = []byte(fmt.Sprintf(`<%[1]sResponse><requestId>%[3]s</requestId>%[2]s</%[1]sResponse>`, r.Operation, body, r.RequestID))
+	case ProtocolRESTXML:
+		contentType = "application/xml"
+		body, err = marshalXML(response.Body)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	header := w.Header()
+	for k, v := range response.Header {
+		header[k] = v
+	}
+	if header.Get("Content-Type") == "" && len(body) > 0 {
+		header.Set("Content-Type", contentType)
+	}
+	header.Set("X-Amzn-Requestid", r.RequestID)
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+
+	statusCode := response.StatusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+
+	w.WriteHeader(statusCode)
+	_, err = w.Write(body)
+
+	return err
+}
+
+func writeError(w http.ResponseWriter, r *Request, err error) {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		apiErr = &Error{StatusCode: http.StatusInternalServerError, Code: "InternalFailure", Message: err.Error()}
+	}
+
+	statusCode := apiErr.StatusCode
+	if statusCode == 0 {
+		statusCode = http.StatusBadRequest
+	}
+
+	var (
+		body        []byte
+		contentType string
+	)
+
+	code, message := xmlEscape(apiErr.Code), xmlEscape(apiErr.Message)
+
+	switch r.Protocol {
+	case ProtocolJSON, ProtocolRESTJSON:
+		contentType = "application/json"
+		body, _ = json.Marshal(map[string]string{
+			"__type":  apiErr.Code,
+			"message": apiErr.Message,
+		})
+		w.Header().Set("X-Amzn-Errortype", apiErr.Code)
+		if r.Protocol == ProtocolJSON && apiErr.QueryErrorCode != "" {
+			w.Header().Set("X-Amzn-Query-Error", apiErr.QueryErrorCode+";Sender")
+		}
+	case ProtocolEC2Query:
+		contentType = "text/xml"
+		body = []byte(fmt.Sprintf(`<Response><Errors><Error><Code>%s</Code><Message>%s</Message></Error></Errors><RequestID>%s</RequestID></Response>`, code, message, r.RequestID))
+	case ProtocolRESTXML:
+		contentType = "application/xml"
+		if r.Service == "s3" {
+			body = []byte(fmt.Sprintf(`<Error><Code>%s</Code><Message>%s</Message><RequestId>%s</RequestId></Error>`, code, message, r.RequestID))
+		} else {
+			body = []byte(fmt.Sprintf(`<ErrorResponse><Error><Type>Sender</Type><Code>%s</Code><Message>%s</Message></Error><RequestId>%s</RequestId></ErrorResponse>`, code, message, r.RequestID))
+		}
+	default:
+		contentType = "text/xml"
+		body = []byte(fmt.Sprintf(`<ErrorResponse><Error><Type>Sender</Type><Code>%s</Code><Message>%s</Message></Error><RequestId>%s</RequestId></ErrorResponse>`, code, message, r.RequestID))
+	}
+
+	header := w.Header()
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+	header.Set("X-Amzn-Requestid", r.RequestID)
+
+	w.WriteHeader(statusCode)
+	w.Write(body) //nolint:errcheck // The client sees a truncated response.
+}
+
+func marshalJSON(v any) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return []byte("{}"), nil
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	default:
+		return json.Marshal(v)
+	}
+}
+
+func marshalXML(v any) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	default:
+		return xml.Marshal(v)
+	}
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s)) //nolint:errcheck // Writing to a strings.Builder can't fail.
+	return b.String()
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package awsmock
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+)
+
+const (
+	// AccountID is the AWS account ID of a provider configured by Server.Provider.
+	AccountID = "123456789012"
+	// Region is the AWS Region of a provider configured to use a Server.
+	Region = "us-west-2"
+
+	accessKey = "awsmock-access-key"
+	secretKey = "awsmock-secret-key"
+	// maxRetries limits the retries of retryable errors so that tests fail fast.
+	maxRetries = 2
+)
+
+// Context returns a context for calling resource CRUD handlers against a Server.
+// Waits for resource state changes don't delay between refreshes.
+func Context(ctx context.Context) context.Context {
+	return retry.WithoutDelays(ctx)
+}
+
+// ProviderConfig returns a provider configuration which sends all AWS API calls to the server.
+// Static credentials are used and credential validation, account ID lookup and metadata API checks are skipped.
+func (s *Server) ProviderConfig() string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  access_key                  = %[1]q
+  secret_key                  = %[2]q
+  region                      = %[3]q
+  endpoint_url                = %[4]q
+  max_retries                 = %[5]d
+  skip_credentials_validation = true
+  skip_metadata_api_check     = true
+  skip_region_validation      = true
+  skip_requesting_account_id  = true
+}
+`, accessKey, secretKey, Region, s.URL, maxRetries)
+}
+
+// Provider returns a provider configured to send all AWS API calls to the server.
+// Resources obtained from the provider's ResourcesMap include the provider's interceptors, e.g. transparent tagging.
+func (s *Server) Provider(ctx context.Context, t testing.TB) *schema.Provider {
+	t.Helper()
+
+	p, err := provider.New(ctx)
+
+	if err != nil {
+		t.Fatalf("creating provider: %s", err)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		"access_key":                  accessKey,
+		"secret_key":                  secretKey,
+		"region":                      Region,
+		"endpoint_url":                s.URL,
+		"max_retries":                 maxRetries,
+		"skip_credentials_validation": true,
+		"skip_metadata_api_check":     "true",
+		"skip_region_validation":      true,
+		"skip_requesting_account_id":  true,
+	})
+
+	if diags := p.Configure(ctx, config); diags.HasError() {
+		t.Fatalf("configuring provider: %v", diags)
+	}
+
+	p.Meta().(*conns.AWSClient).AccountID = AccountID
+
+	return p
+}
+
+// Meta returns the AWS client ("meta") of a provider configured to send all AWS API calls to the server.
+func (s *Server) Meta(ctx context.Context, t testing.TB) *conns.AWSClient {
+	t.Helper()
+
+	return s.Provider(ctx, t).Meta().(*conns.AWSClient)
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package awsmock
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// CreateResource plans and applies the creation of a resource of the specified type from a raw configuration,
+// e.g. map[string]any{"name": "test"}, as Terraform would. The resource's CustomizeDiff and the provider's
+// interceptors are run. The new resource's state is returned.
+func CreateResource(ctx context.Context, t testing.TB, p *schema.Provider, resourceType string, config map[string]any) (*terraform.InstanceState, diag.Diagnostics) {
+	t.Helper()
+
+	r := resourceByType(t, p, resourceType)
+
+	// Terraform sends the raw configuration to providers with the plan.
+	b, err := json.Marshal(config)
+
+	if err != nil {
+		t.Fatalf("encoding %s configuration: %s", resourceType, err)
+	}
+
+	rawConfig, err := ctyjson.Unmarshal(b, r.CoreConfigSchema().ImpliedType())
+
+	if err != nil {
+		t.Fatalf("decoding %s configuration: %s", resourceType, err)
+	}
+
+	// As with Terraform's protocol shims, the prior (empty) state carries the raw values for CustomizeDiff.
+	state := &terraform.InstanceState{
+		RawConfig: rawConfig,
+		RawPlan:   rawConfig,
+	}
+	c := terraform.NewResourceConfigRaw(config)
+	c.CtyValue = rawConfig
+
+	diff, err := r.Diff(ctx, state, c, p.Meta())
+
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	diff.RawConfig = rawConfig
+	diff.RawPlan = rawConfig
+
+	return r.Apply(ctx, state, diff, p.Meta())
+}
+
+// ReadResource refreshes a resource's state.
+// nil is returned if the resource has been removed from state.
+func ReadResource(ctx context.Context, t testing.TB, p *schema.Provider, resourceType string, state *terraform.InstanceState) (*terraform.InstanceState, diag.Diagnostics) {
+	t.Helper()
+
+	return resourceByType(t, p, resourceType).RefreshWithoutUpgrade(ctx, state, p.Meta())
+}
+
+// DeleteResource applies the deletion of a resource.
+func DeleteResource(ctx context.Context, t testing.TB, p *schema.Provider, resourceType string, state *terraform.InstanceState) diag.Diagnostics {
+	t.Helper()
+
+	_, diags := resourceByType(t, p, resourceType).Apply(ctx, state, &terraform.InstanceDiff{Destroy: true}, p.Meta())
+
+	return diags
+}
+
+func resourceByType(t testing.TB, p *schema.Provider, resourceType string) *schema.Resource {
+	t.Helper()
+
+	r, ok := p.ResourcesMap[resourceType]
+
+	if !ok {
+		t.Fatalf("resource type %s not found", resourceType)
+	}
+
+	return r
+}
@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package awsmock provides an in-process fake AWS API endpoint for provider unit tests.
+//
+// A Server speaks the AWS JSON, Query, EC2 Query, REST-JSON and REST-XML protocols
+// (see https://smithy.io/2.0/aws/protocols/index.html). Tests register a Handler for each
+// operation they expect to be called, either a canned response (Respond, RespondError)
+// or a stateful fake closing over test-owned state, and then configure the provider to
+// send all AWS API calls to the server (Provider, ProviderConfig):
+//
+//	s := awsmock.NewServer(t)
+//	s.Handle("iam", "GetRole", awsmock.RespondError(http.StatusNotFound, "NoSuchEntity", "not found"))
+//	meta := s.Meta(ctx, t)
+//
+// The service of each request is identified by the signing name in its SigV4 Authorization header.
+package awsmock
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Server is an in-process AWS API endpoint.
+type Server struct {
+	*httptest.Server
+
+	t testing.TB
+
+	mu       sync.Mutex
+	handlers map[operationKey]Handler
+	routes   []route
+	requests []*Request
+}
+
+type operationKey struct {
+	service   string
+	operation string
+}
+
+// NewServer starts a new Server which is closed when the test completes.
+// Requests for operations without a registered handler fail the test.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		t:        t,
+		handlers: make(map[operationKey]Handler),
+	}
+	s.Server = httptest.NewServer(s)
+
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Handle registers the handler for the specified JSON or Query protocol operation.
+// service is the service's SigV4 signing name, e.g. "sqs", and operation is the API operation name, e.g. "CreateQueue".
+func (s *Server) Handle(service, operation string, h Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers[operationKey{service: service, operation: operation}] = h
+}
+
+// HandleREST registers the handler for the specified REST-JSON or REST-XML protocol operation.
+// pattern is an HTTP method and path, e.g. "GET /2015-03-31/functions/{FunctionName}".
+// A path segment of the form "{Name}" matches any single segment and "{Name+}" matches the rest of the path.
+// The matched values are available in Request.PathParams.
+// The path may be followed by "?" and a query string key which must be present, e.g. "GET /{Bucket}?tagging".
+func (s *Server) HandleREST(service, operation, pattern string, h Handler) {
+	r, err := parseRoute(service, operation, pattern, h)
+	if err != nil {
+		s.t.Fatalf("registering %s %s: %s", service, operation, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.routes = append(s.routes, r)
+}
+
+// Requests returns the requests received for the specified service operation.
+func (s *Server) Requests(service, operation string) []*Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var requests []*Request
+
+	for _, r := range s.requests {
+		if r.Service == service && r.Operation == operation {
+			requests = append(requests, r)
+		}
+	}
+
+	return requests
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, httpRequest *http.Request) {
+	body, err := io.ReadAll(httpRequest.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httpRequest.Body = io.NopCloser(bytes.NewReader(body))
+
+	r, h, err := s.newRequest(httpRequest, body)
+
+	if err != nil {
+		s.t.Errorf("awsmock: %s", err)
+		writeError(w, r, &Error{StatusCode: http.StatusBadRequest, Code: "UnknownOperationException", Message: err.Error()})
+		return
+	}
+
+	s.mu.Lock()
+	r.RequestID = fmt.Sprintf("awsmock-%d", len(s.requests)+1)
+	s.requests = append(s.requests, r)
+	s.mu.Unlock()
+
+	response, err := h(r)
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	if err := writeResponse(w, r, response); err != nil {
+		s.t.Errorf("awsmock: writing %s %s response: %s", r.Service, r.Operation, err)
+	}
+}
+
+// newRequest identifies the operation and handler for an HTTP request.
+func (s *Server) newRequest(httpRequest *http.Request, body []byte) (*Request, Handler, error) {
+	r := &Request{
+		HTTPRequest: httpRequest,
+		Body:        body,
+		Service:     signingName(httpRequest.Header.Get("Authorization")),
+	}
+
+	if r.Service == "" {
+		return r, nil, fmt.Errorf("request %s %s is not signed", httpRequest.Method, httpRequest.URL.Path)
+	}
+
+	contentType := httpRequest.Header.Get("Content-Type")
+
+	switch {
+	case strings.HasPrefix(contentType, "application/x-amz-json-"):
+		r.Protocol = ProtocolJSON
+		_, r.Operation, _ = strings.Cut(httpRequest.Header.Get("X-Amz-Target"), ".")
+
+		if err := r.decodeJSON(); err != nil {
+			return r, nil, err
+		}
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		r.Protocol = ProtocolQuery
+		if r.Service == "ec2" {
+			r.Protocol = ProtocolEC2Query
+		}
+
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return r, nil, fmt.Errorf("parsing %s request: %w", r.Service, err)
+		}
+
+		r.Operation = values.Get("Action")
+		r.Params = make(map[string]any, len(values))
+		for k := range values {
+			r.Params[k] = values.Get(k)
+		}
+	default:
+		r.Protocol = ProtocolRESTJSON
+		if restXMLServices[r.Service] {
+			r.Protocol = ProtocolRESTXML
+		}
+
+		s.mu.Lock()
+		routes := s.routes
+		s.mu.Unlock()
+
+		for _, route := range routes {
+			if pathParams, ok := route.match(r.Service, httpRequest); ok {
+				r.Operation = route.operation
+				r.PathParams = pathParams
+
+				if r.Protocol == ProtocolRESTJSON && len(body) > 0 {
+					if err := r.decodeJSON(); err != nil {
+						return r, nil, err
+					}
+				}
+
+				return r, route.handler, nil
+			}
+		}
+
+		return r, nil, fmt.Errorf("no %s handler registered for %s %s", r.Service, httpRequest.Method, httpRequest.URL.Path)
+	}
+
+	s.mu.Lock()
+	h, ok := s.handlers[operationKey{service: r.Service, operation: r.Operation}]
+	s.mu.Unlock()
+
+	if !ok {
+		return r, nil, fmt.Errorf("no handler registered for %s %s", r.Service, r.Operation)
+	}
+
+	return r, h, nil
+}
+
+// signingName returns the service signing name from a SigV4 Authorization header value, e.g.
+// "AWS4-HMAC-SHA256 Credential=AKID/20230101/us-west-2/sqs/aws4_request, SignedHeaders=..., Signature=...".
+func signingName(authorization string) string {
+	_, credential, ok := strings.Cut(authorization, "Credential=")
+	if !ok {
+		return ""
+	}
+
+	credential, _, _ = strings.Cut(credential, ",")
+	parts := strings.Split(credential, "/")
+
+	if len(parts) != 5 {
+		return ""
+	}
+
+	return parts[3]
+}
+
+// route is a REST protocol operation's HTTP method and path pattern.
+type route struct {
+	service   string
+	operation string
+	method    string
+	segments  []string
+	queryKey  string
+	handler   Handler
+}
+
+func parseRoute(service, operation, pattern string, h Handler) (route, error) {
+	method, path, ok := strings.Cut(pattern, " ")
+	if !ok || !strings.HasPrefix(path, "/") {
+		return route{}, fmt.Errorf("invalid pattern: %q", pattern)
+	}
+
+	path, queryKey, _ := strings.Cut(path, "?")
+
+	return route{
+		service:   service,
+		operation: operation,
+		method:    method,
+		segments:  strings.Split(strings.Trim(path, "/"), "/"),
+		queryKey:  queryKey,
+		handler:   h,
+	}, nil
+}
+
+func (r route) match(service string, httpRequest *http.Request) (map[string]string, bool) {
+	if service != r.service || httpRequest.Method != r.method {
+		return nil, false
+	}
+
+	if r.queryKey != "" {
+		if _, ok := httpRequest.URL.Query()[r.queryKey]; !ok {
+			return nil, false
+		}
+	}
+
+	segments := strings.Split(strings.Trim(httpRequest.URL.EscapedPath(), "/"), "/")
+	pathParams := make(map[string]string)
+
+	for i, pattern := range r.segments {
+		if strings.HasPrefix(pattern, "{") && strings.HasSuffix(pattern, "+}") {
+			if i >= len(segments) {
+				return nil, false
+			}
+
+			v, err := url.PathUnescape(strings.Join(segments[i:], "/"))
+			if err != nil {
+				return nil, false
+			}
+
+			pathParams[strings.TrimSuffix(strings.TrimPrefix(pattern, "{"), "+}")] = v
+
+			return pathParams, true
+		}
+
+		if i >= len(segments) {
+			return nil, false
+		}
+
+		if strings.HasPrefix(pattern, "{") && strings.HasSuffix(pattern, "}") {
+			if segments[i] == "" {
+				return nil, false
+			}
+
+			v, err := url.PathUnescape(segments[i])
+			if err != nil {
+				return nil, false
+			}
+
+			pathParams[strings.TrimSuffix(strings.TrimPrefix(pattern, "{"), "}")] = v
+
+			continue
+		}
+
+		if segments[i] != pattern {
+			return nil, false
+		}
+	}
+
+	if len(segments) != len(r.segments) {
+		return nil, false
+	}
+
+	return pathParams, true
+}
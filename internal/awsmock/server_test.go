@@ -0,0 +1,373 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package awsmock_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go/aws"
+	awscredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	tfawserrv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/awsmock"
+)
+
+func newSession(t *testing.T, s *awsmock.Server) *session.Session {
+	t.Helper()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: awscredentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(s.URL),
+		Region:      aws.String(awsmock.Region),
+		MaxRetries:  aws.Int(1),
+	})
+
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	return sess
+}
+
+func TestServerQuery(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := awsmock.NewServer(t)
+	s.Handle("sns", "CreateTopic", func(r *awsmock.Request) (*awsmock.Response, error) {
+		return &awsmock.Response{
+			Body: "<TopicArn>arn:aws:sns:" + awsmock.Region + ":" + awsmock.AccountID + ":" + r.Param("Name") + "</TopicArn>",
+		}, nil
+	})
+	s.Handle("sns", "GetTopicAttributes", awsmock.RespondError(http.StatusNotFound, sns.ErrCodeNotFoundException, "Topic does not exist"))
+
+	conn := sns.New(newSession(t, s))
+
+	output, err := conn.CreateTopicWithContext(ctx, &sns.CreateTopicInput{
+		Name: aws.String("test"),
+		Attributes: map[string]*string{
+			"DisplayName": aws.String("Test"),
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(output.TopicArn), "arn:aws:sns:"+awsmock.Region+":"+awsmock.AccountID+":test"; got != want {
+		t.Errorf("TopicArn = %q, want %q", got, want)
+	}
+
+	requests := s.Requests("sns", "CreateTopic")
+
+	if got, want := len(requests), 1; got != want {
+		t.Fatalf("CreateTopic requests = %d, want %d", got, want)
+	}
+
+	if got, want := requests[0].Protocol, awsmock.ProtocolQuery; got != want {
+		t.Errorf("Protocol = %q, want %q", got, want)
+	}
+
+	if got, want := requests[0].Param("Attributes.entry.1.value"), "Test"; got != want {
+		t.Errorf("Attributes.entry.1.value = %q, want %q", got, want)
+	}
+
+	_, err = conn.GetTopicAttributesWithContext(ctx, &sns.GetTopicAttributesInput{
+		TopicArn: output.TopicArn,
+	})
+
+	if !tfawserr.ErrCodeEquals(err, sns.ErrCodeNotFoundException) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestServerEC2Query(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := awsmock.NewServer(t)
+	s.Handle("ec2", "DescribeVpcs", awsmock.Respond(`<vpcSet><item><vpcId>vpc-12345678</vpcId><cidrBlock>10.0.0.0/16</cidrBlock></item></vpcSet>`))
+	s.Handle("ec2", "DeleteVpc", awsmock.RespondError(http.StatusBadRequest, "InvalidVpcID.NotFound", "The vpc ID 'vpc-87654321' does not exist"))
+
+	conn := ec2.New(newSession(t, s))
+
+	output, err := conn.DescribeVpcsWithContext(ctx, &ec2.DescribeVpcsInput{
+		VpcIds: aws.StringSlice([]string{"vpc-12345678"}),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(output.Vpcs), 1; got != want {
+		t.Fatalf("Vpcs = %d, want %d", got, want)
+	}
+
+	if got, want := aws.StringValue(output.Vpcs[0].CidrBlock), "10.0.0.0/16"; got != want {
+		t.Errorf("CidrBlock = %q, want %q", got, want)
+	}
+
+	if got, want := s.Requests("ec2", "DescribeVpcs")[0].Param("VpcId.1"), "vpc-12345678"; got != want {
+		t.Errorf("VpcId.1 = %q, want %q", got, want)
+	}
+
+	_, err = conn.DeleteVpcWithContext(ctx, &ec2.DeleteVpcInput{
+		VpcId: aws.String("vpc-87654321"),
+	})
+
+	if !tfawserr.ErrCodeEquals(err, "InvalidVpcID.NotFound") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestServerJSON(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := awsmock.NewServer(t)
+	s.Handle("dynamodb", "DescribeTable", awsmock.Sequence(
+		awsmock.RespondError(http.StatusBadRequest, dynamodb.ErrCodeResourceNotFoundException, "Requested resource not found"),
+		func(r *awsmock.Request) (*awsmock.Response, error) {
+			return &awsmock.Response{
+				Body: map[string]any{
+					"Table": map[string]any{
+						"TableName":   r.Param("TableName"),
+						"TableStatus": dynamodb.TableStatusActive,
+					},
+				},
+			}, nil
+		},
+	))
+
+	conn := dynamodb.New(newSession(t, s))
+	input := &dynamodb.DescribeTableInput{
+		TableName: aws.String("test"),
+	}
+
+	_, err := conn.DescribeTableWithContext(ctx, input)
+
+	if !tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	output, err := conn.DescribeTableWithContext(ctx, input)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(output.Table.TableName), "test"; got != want {
+		t.Errorf("TableName = %q, want %q", got, want)
+	}
+
+	if got, want := len(s.Requests("dynamodb", "DescribeTable")), 2; got != want {
+		t.Errorf("DescribeTable requests = %d, want %d", got, want)
+	}
+}
+
+func TestServerJSONQueryCompatibleError(t *testing.T) {
+	t.Parallel()
+
+	s := awsmock.NewServer(t)
+	s.Handle("sqs", "GetQueueAttributes", func(r *awsmock.Request) (*awsmock.Response, error) {
+		return nil, &awsmock.Error{
+			Code:           "QueueDoesNotExist",
+			Message:        "The specified queue does not exist.",
+			QueryErrorCode: "AWS.SimpleQueueService.NonExistentQueue",
+		}
+	})
+
+	request, err := http.NewRequest(http.MethodPost, s.URL, strings.NewReader(`{"QueueUrl":"`+s.URL+`/`+awsmock.AccountID+`/test"}`))
+	if err != nil {
+		t.Fatalf("creating request: %s", err)
+	}
+	request.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential=AKID/20230101/"+awsmock.Region+"/sqs/aws4_request, SignedHeaders=host, Signature=x")
+	request.Header.Set("Content-Type", "application/x-amz-json-1.0")
+	request.Header.Set("X-Amz-Target", "AmazonSQS.GetQueueAttributes")
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer response.Body.Close()
+
+	if got, want := response.StatusCode, http.StatusBadRequest; got != want {
+		t.Errorf("StatusCode = %d, want %d", got, want)
+	}
+
+	if got, want := response.Header.Get("X-Amzn-Query-Error"), "AWS.SimpleQueueService.NonExistentQueue;Sender"; got != want {
+		t.Errorf("X-Amzn-Query-Error = %q, want %q", got, want)
+	}
+
+	var body map[string]string
+	if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+		t.Fatalf("decoding response: %s", err)
+	}
+
+	if got, want := body["__type"], "QueueDoesNotExist"; got != want {
+		t.Errorf("__type = %q, want %q", got, want)
+	}
+
+	if got, want := s.Requests("sqs", "GetQueueAttributes")[0].Param("QueueUrl"), s.URL+"/"+awsmock.AccountID+"/test"; got != want {
+		t.Errorf("QueueUrl = %q, want %q", got, want)
+	}
+}
+
+func TestServerJSONQueryCompatible(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := awsmock.NewServer(t)
+	s.Handle("sqs", "GetQueueAttributes", func(r *awsmock.Request) (*awsmock.Response, error) {
+		return nil, &awsmock.Error{
+			Code:           sqs.ErrCodeQueueDoesNotExist,
+			Message:        "The specified queue does not exist.",
+			QueryErrorCode: "AWS.SimpleQueueService.NonExistentQueue",
+		}
+	})
+
+	conn := sqs.New(newSession(t, s))
+
+	_, err := conn.GetQueueAttributesWithContext(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl: aws.String(s.URL + "/" + awsmock.AccountID + "/test"),
+	})
+
+	if !tfawserr.ErrCodeEquals(err, "AWS.SimpleQueueService.NonExistentQueue") {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	requests := s.Requests("sqs", "GetQueueAttributes")
+
+	if got, want := len(requests), 1; got != want {
+		t.Fatalf("GetQueueAttributes requests = %d, want %d", got, want)
+	}
+
+	if got, want := requests[0].Protocol, awsmock.ProtocolJSON; got != want {
+		t.Errorf("Protocol = %q, want %q", got, want)
+	}
+}
+
+func TestServerRESTJSON(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := awsmock.NewServer(t)
+	s.HandleREST("lambda", "GetFunction", "GET /2015-03-31/functions/{FunctionName}", func(r *awsmock.Request) (*awsmock.Response, error) {
+		if name := r.PathParams["FunctionName"]; name != "test" {
+			return nil, &awsmock.Error{StatusCode: http.StatusNotFound, Code: "ResourceNotFoundException", Message: "Function not found: " + name}
+		}
+
+		return &awsmock.Response{
+			Body: map[string]any{
+				"Configuration": map[string]any{
+					"FunctionName": "test",
+					"Runtime":      "go1.x",
+				},
+			},
+		}, nil
+	})
+
+	conn := lambda.New(lambda.Options{
+		Credentials:      credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		EndpointResolver: lambda.EndpointResolverFromURL(s.URL),
+		Region:           awsmock.Region,
+		RetryMaxAttempts: 1,
+	})
+
+	output, err := conn.GetFunction(ctx, &lambda.GetFunctionInput{
+		FunctionName: aws.String("test"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(output.Configuration.FunctionName), "test"; got != want {
+		t.Errorf("FunctionName = %q, want %q", got, want)
+	}
+
+	_, err = conn.GetFunction(ctx, &lambda.GetFunctionInput{
+		FunctionName: aws.String("other"),
+	})
+
+	if !tfawserrv2.ErrCodeEquals(err, "ResourceNotFoundException") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestServerRESTXML(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := awsmock.NewServer(t)
+	s.HandleREST("route53", "GetHostedZone", "GET /2013-04-01/hostedzone/{Id}", func(r *awsmock.Request) (*awsmock.Response, error) {
+		return &awsmock.Response{
+			Body: `<GetHostedZoneResponse><HostedZone><Id>/hostedzone/` + r.PathParams["Id"] + `</Id><Name>example.com.</Name><CallerReference>test</CallerReference></HostedZone></GetHostedZoneResponse>`,
+		}, nil
+	})
+	s.HandleREST("route53", "DeleteHostedZone", "DELETE /2013-04-01/hostedzone/{Id}", awsmock.RespondError(http.StatusNotFound, route53.ErrCodeNoSuchHostedZone, "No hosted zone found"))
+
+	conn := route53.New(newSession(t, s))
+
+	output, err := conn.GetHostedZoneWithContext(ctx, &route53.GetHostedZoneInput{
+		Id: aws.String("Z123"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(output.HostedZone.Name), "example.com."; got != want {
+		t.Errorf("Name = %q, want %q", got, want)
+	}
+
+	_, err = conn.DeleteHostedZoneWithContext(ctx, &route53.DeleteHostedZoneInput{
+		Id: aws.String("Z123"),
+	})
+
+	if !tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestServerRetry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := awsmock.NewServer(t)
+	s.Handle("sqs", "ListQueues", awsmock.Sequence(
+		awsmock.RespondError(http.StatusInternalServerError, "InternalError", "We encountered an internal error. Please try again."),
+		awsmock.Respond(map[string]any{
+			"QueueUrls": []string{s.URL + "/" + awsmock.AccountID + "/test"},
+		}),
+	))
+
+	conn := sqs.New(newSession(t, s))
+
+	output, err := conn.ListQueuesWithContext(ctx, &sqs.ListQueuesInput{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(output.QueueUrls), 1; got != want {
+		t.Errorf("QueueUrls = %d, want %d", got, want)
+	}
+
+	if got, want := len(s.Requests("sqs", "ListQueues")), 2; got != want {
+		t.Errorf("ListQueues requests = %d, want %d", got, want)
+	}
+}
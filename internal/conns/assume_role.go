@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	stscreds_sdkv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// assumeRoleHopError identifies the hop in a chain of assumed IAM roles that failed.
+type assumeRoleHopError struct {
+	hop, hops int
+	err       error
+}
+
+func (e assumeRoleHopError) Error() string {
+	return fmt.Sprintf("assume_role (%d of %d): %s", e.hop, e.hops, e.err)
+}
+
+func (e assumeRoleHopError) Unwrap() error {
+	return e.err
+}
+
+// assumeRoleChain assumes each of the specified IAM roles in turn, starting from the credentials in the specified configuration.
+// The first hop number is used in any error, allowing the chain to start part way through the provider's `assume_role` blocks.
+func assumeRoleChain(ctx context.Context, awsConfig aws_sdkv2.Config, stsEndpoint, stsRegion string, chain []*awsbase.AssumeRole, firstHop, hops int) (aws_sdkv2.CredentialsProvider, error) {
+	credentials := awsConfig.Credentials
+
+	for i, ar := range chain {
+		hop := firstHop + i
+
+		if ar == nil || ar.RoleARN == "" {
+			return nil, assumeRoleHopError{hop: hop, hops: hops, err: errors.New("role ARN not set")}
+		}
+
+		tflog.Info(ctx, "Assuming IAM Role", map[string]any{
+			"tf_aws.assume_role.hop":             hop,
+			"tf_aws.assume_role.role_arn":        ar.RoleARN,
+			"tf_aws.assume_role.session_name":    ar.SessionName,
+			"tf_aws.assume_role.external_id":     ar.ExternalID,
+			"tf_aws.assume_role.source_identity": ar.SourceIdentity,
+		})
+
+		cfg := awsConfig.Copy()
+		cfg.Credentials = credentials
+		client := sts_sdkv2.NewFromConfig(cfg, func(o *sts_sdkv2.Options) {
+			if stsRegion != "" {
+				o.Region = stsRegion
+			}
+			if stsEndpoint != "" {
+				o.EndpointResolver = sts_sdkv2.EndpointResolverFromURL(stsEndpoint)
+			}
+		})
+
+		provider := aws_sdkv2.NewCredentialsCache(stscreds_sdkv2.NewAssumeRoleProvider(client, ar.RoleARN, func(o *stscreds_sdkv2.AssumeRoleOptions) {
+			expandAssumeRoleOptions(o, ar)
+		}))
+
+		if _, err := provider.Retrieve(ctx); err != nil {
+			return nil, assumeRoleHopError{hop: hop, hops: hops, err: (&awsbase.Config{AssumeRole: ar}).NewCannotAssumeRoleError(err)}
+		}
+
+		credentials = provider
+	}
+
+	return credentials, nil
+}
+
+func expandAssumeRoleOptions(o *stscreds_sdkv2.AssumeRoleOptions, ar *awsbase.AssumeRole) {
+	o.RoleSessionName = ar.SessionName
+	o.Duration = ar.Duration
+
+	if ar.ExternalID != "" {
+		o.ExternalID = aws_sdkv2.String(ar.ExternalID)
+	}
+
+	if ar.Policy != "" {
+		o.Policy = aws_sdkv2.String(ar.Policy)
+	}
+
+	for _, v := range ar.PolicyARNs {
+		o.PolicyARNs = append(o.PolicyARNs, ststypes_sdkv2.PolicyDescriptorType{
+			Arn: aws_sdkv2.String(v),
+		})
+	}
+
+	for k, v := range ar.Tags {
+		o.Tags = append(o.Tags, ststypes_sdkv2.Tag{
+			Key:   aws_sdkv2.String(k),
+			Value: aws_sdkv2.String(v),
+		})
+	}
+
+	if len(ar.TransitiveTagKeys) > 0 {
+		o.TransitiveTagKeys = ar.TransitiveTagKeys
+	}
+
+	if ar.SourceIdentity != "" {
+		o.SourceIdentity = aws_sdkv2.String(ar.SourceIdentity)
+	}
+}
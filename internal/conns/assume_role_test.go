@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	stscreds_sdkv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/google/go-cmp/cmp"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+func TestExpandAssumeRoleOptions(t *testing.T) {
+	t.Parallel()
+
+	var got stscreds_sdkv2.AssumeRoleOptions
+	expandAssumeRoleOptions(&got, &awsbase.AssumeRole{
+		Duration:          time.Hour,
+		ExternalID:        "external-id",
+		PolicyARNs:        []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"}, //lintignore:AWSAT005
+		RoleARN:           "arn:aws:iam::123456789012:role/workload",          //lintignore:AWSAT005
+		SessionName:       "session-name",
+		SourceIdentity:    "source-identity",
+		Tags:              map[string]string{"team": "platform"},
+		TransitiveTagKeys: []string{"team"},
+	})
+
+	if got, want := got.RoleSessionName, "session-name"; got != want {
+		t.Errorf("RoleSessionName: got %q, expected %q", got, want)
+	}
+	if got, want := got.Duration, time.Hour; got != want {
+		t.Errorf("Duration: got %s, expected %s", got, want)
+	}
+	if got, want := aws_sdkv2.ToString(got.ExternalID), "external-id"; got != want {
+		t.Errorf("ExternalID: got %q, expected %q", got, want)
+	}
+	if got, want := aws_sdkv2.ToString(got.SourceIdentity), "source-identity"; got != want {
+		t.Errorf("SourceIdentity: got %q, expected %q", got, want)
+	}
+	if got.Policy != nil {
+		t.Errorf("Policy: got %q, expected nil", aws_sdkv2.ToString(got.Policy))
+	}
+	if got, want := len(got.PolicyARNs), 1; got != want {
+		t.Errorf("PolicyARNs: got %d, expected %d", got, want)
+	}
+	if got, want := len(got.Tags), 1; got != want {
+		t.Fatalf("Tags: got %d, expected %d", got, want)
+	}
+	if got, want := aws_sdkv2.ToString(got.Tags[0].Key), "team"; got != want {
+		t.Errorf("Tags key: got %q, expected %q", got, want)
+	}
+	if diff := cmp.Diff(got.TransitiveTagKeys, []string{"team"}); diff != "" {
+		t.Errorf("TransitiveTagKeys: unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestAssumeRoleChainHopError(t *testing.T) {
+	t.Parallel()
+
+	_, err := assumeRoleChain(context.Background(), aws_sdkv2.Config{}, "", "", []*awsbase.AssumeRole{{}}, 2, 3)
+
+	var hopErr assumeRoleHopError
+	if !errors.As(err, &hopErr) {
+		t.Fatalf("expected assumeRoleHopError, got %v", err)
+	}
+	if got, want := hopErr.hop, 2; got != want {
+		t.Errorf("hop: got %d, expected %d", got, want)
+	}
+	if got, want := err.Error(), "assume_role (2 of 3): role ARN not set"; got != want {
+		t.Errorf("error: got %q, expected %q", got, want)
+	}
+}
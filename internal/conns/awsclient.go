@@ -16,13 +16,13 @@ import (
 	apigatewayv2_sdkv1 "github.com/aws/aws-sdk-go/service/apigatewayv2"
 	mediaconvert_sdkv1 "github.com/aws/aws-sdk-go/service/mediaconvert"
 	s3_sdkv1 "github.com/aws/aws-sdk-go/service/s3"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type AWSClient struct {
 	AccountID               string
-	DefaultTagsConfig       *tftags.DefaultConfig
 	DNSSuffix               string
 	IgnoreTagsConfig        *tftags.IgnoreConfig
 	MediaConvertAccountConn *mediaconvert_sdkv1.MediaConvert
@@ -31,16 +31,33 @@ type AWSClient struct {
 	ReverseDNSPrefix        string
 	ServicePackages         map[string]ServicePackage
 	Session                 *session_sdkv1.Session
+	TagPolicyCompliance     tftags.TagPolicyCompliance
 	TerraformVersion        string
 
-	awsConfig      *aws_sdkv2.Config
-	clients        map[string]any
-	conns          map[string]any
-	endpoints      map[string]string // From provider configuration.
-	httpClient     *http.Client
-	lock           sync.Mutex
-	s3UsePathStyle bool   // From provider configuration.
-	stsRegion      string // From provider configuration.
+	adaptiveLimiter   *tfsync.AdaptiveLimiter // From provider configuration.
+	awsConfig         *aws_sdkv2.Config
+	clients           map[string]any
+	conns             map[string]any
+	defaultTagsConfig *tftags.DefaultConfig // From provider configuration.
+	endpointURL       string                // From provider configuration.
+	endpoints         map[string]string     // From provider configuration.
+	httpClient        *http.Client
+	lock              sync.Mutex
+	parent            *AWSClient                     // Non-nil for a per-Region view of the provider's client.
+	rateLimiters      map[string]*tfsync.TokenBucket // From provider configuration.
+	s3UsePathStyle    bool                           // From provider configuration.
+	stsRegion         string                         // From provider configuration.
+	tagPolicy         tagPolicy
+	tagPolicyLock     sync.Mutex
+}
+
+// DefaultTagsConfig returns the provider's default_tags configuration for the resource or data source in Context, if any.
+func (client *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if v, ok := tftags.FromContext(ctx); ok {
+		return v.DefaultConfig
+	}
+
+	return client.defaultTagsConfig
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -77,6 +94,12 @@ func (client *AWSClient) HTTPClient() *http.Client {
 	return client.httpClient
 }
 
+// AWSConfig returns the AWS SDK for Go v2 configuration from which all AWS SDK for Go v2 API clients are built.
+// Changes to the configuration only affect API clients built afterwards.
+func (client *AWSClient) AWSConfig() *aws_sdkv2.Config {
+	return client.awsConfig
+}
+
 // APIGatewayInvokeURL returns the Amazon API Gateway (REST APIs) invoke URL for the configured AWS Region.
 // See https://docs.aws.amazon.com/apigateway/latest/developerguide/how-to-call-api.html.
 func (client *AWSClient) APIGatewayInvokeURL(restAPIID, stageName string) string {
@@ -134,17 +157,97 @@ func (client *AWSClient) GlobalAcceleratorHostedZoneID() string {
 	return "Z2BJ6XQ5FK7U4H" // See https://docs.aws.amazon.com/general/latest/gr/global_accelerator.html#global_accelerator_region
 }
 
-// apiClientConfig returns the AWS API client configuration parameters for the specified service.
-func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]any {
+// RegionForContext returns the AWS Region that API calls made in the specified Context target.
+// This is the per-resource `region` override, if any, otherwise the provider-configured Region.
+func (client *AWSClient) RegionForContext(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok && inContext.OverrideRegion != "" {
+		return inContext.OverrideRegion
+	}
+
+	return client.Region
+}
+
+// ForRegion returns a view of the client whose Region is the specified AWS Region.
+// The view shares API client caches and all other configuration with the provider's client,
+// so that values derived from Region (e.g. ARNs and regional hostnames) match the Region targeted by API calls.
+func (client *AWSClient) ForRegion(region string) *AWSClient {
+	if region == "" || region == client.Region {
+		return client
+	}
+
+	root := client.root()
+	if region == root.Region {
+		return root
+	}
+
+	return &AWSClient{
+		AccountID:               root.AccountID,
+		DNSSuffix:               root.DNSSuffix,
+		IgnoreTagsConfig:        root.IgnoreTagsConfig,
+		MediaConvertAccountConn: root.MediaConvertAccountConn,
+		Partition:               root.Partition,
+		Region:                  region,
+		ReverseDNSPrefix:        root.ReverseDNSPrefix,
+		ServicePackages:         root.ServicePackages,
+		Session:                 root.Session,
+		TagPolicyCompliance:     root.TagPolicyCompliance,
+		TerraformVersion:        root.TerraformVersion,
+
+		adaptiveLimiter:   root.adaptiveLimiter,
+		awsConfig:         root.awsConfig,
+		defaultTagsConfig: root.defaultTagsConfig,
+		endpointURL:       root.endpointURL,
+		endpoints:         root.endpoints,
+		httpClient:        root.httpClient,
+		parent:            root,
+		rateLimiters:      root.rateLimiters,
+		s3UsePathStyle:    root.s3UsePathStyle,
+		stsRegion:         root.stsRegion,
+	}
+}
+
+// root returns the provider's client, which owns the API client caches.
+func (client *AWSClient) root() *AWSClient {
+	if client.parent != nil {
+		return client.parent
+	}
+
+	return client
+}
+
+// apiClientConfig returns the AWS API client configuration parameters for the specified service and Region.
+func (client *AWSClient) apiClientConfig(servicePackageName, region string) map[string]any {
+	awsConfig, session := client.awsConfig, client.Session
+	if region != client.Region {
+		// Per-Region clients share credentials and all other configuration with the provider's default clients.
+		if awsConfig != nil {
+			cfg := awsConfig.Copy()
+			cfg.Region = region
+			awsConfig = &cfg
+		}
+		if session != nil {
+			session = session.Copy(&aws_sdkv1.Config{Region: aws_sdkv1.String(region)})
+		}
+	}
+
+	session, awsConfig = client.rateLimitAPICalls(servicePackageName, session, awsConfig)
+
+	// Any service-specific endpoint takes precedence over the base endpoint URL.
+	endpoint, baseEndpoint := client.endpoints[servicePackageName], false
+	if endpoint == "" && client.endpointURL != "" {
+		endpoint, baseEndpoint = client.endpointURL, true
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": client.awsConfig,
-		"endpoint":         client.endpoints[servicePackageName],
+		"aws_sdkv2_config": awsConfig,
+		"endpoint":         endpoint,
 		"partition":        client.Partition,
-		"session":          client.Session,
+		"session":          session,
 	}
 	switch servicePackageName {
 	case names.S3:
-		m["s3_use_path_style"] = client.s3UsePathStyle
+		// AWS API emulators serving all services from a single base endpoint URL don't support virtual hosted-style bucket addressing.
+		m["s3_use_path_style"] = client.s3UsePathStyle || baseEndpoint
 	case names.STS:
 		m["sts_region"] = client.stsRegion
 	}
@@ -152,12 +255,28 @@ func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]a
 	return m
 }
 
+// apiClientKey returns the key used to cache the API client for the specified service and Region.
+func (client *AWSClient) apiClientKey(servicePackageName, region string) string {
+	if region == client.Region {
+		return servicePackageName
+	}
+
+	return servicePackageName + "@" + region
+}
+
 // conn returns the AWS SDK for Go v1 API client for the specified service.
+// API clients for any per-resource Region override are built lazily and cached.
 func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (T, error) {
+	// Any per-Region view determines the Region; the provider's client owns the caches.
+	region := c.RegionForContext(ctx)
+	c = c.root()
+
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if raw, ok := c.conns[servicePackageName]; ok {
+	key := c.apiClientKey(servicePackageName, region)
+
+	if raw, ok := c.conns[key]; ok {
 		if conn, ok := raw.(T); ok {
 			return conn, nil
 		} else {
@@ -180,7 +299,7 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (
 		return zero, fmt.Errorf("no AWS SDK v1 API client factory: %s", servicePackageName)
 	}
 
-	conn, err := v.NewConn(ctx, c.apiClientConfig(servicePackageName))
+	conn, err := v.NewConn(ctx, c.apiClientConfig(servicePackageName, region))
 	if err != nil {
 		var zero T
 		return zero, err
@@ -196,17 +315,24 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (
 		}
 	}
 
-	c.conns[servicePackageName] = conn
+	c.conns[key] = conn
 
 	return conn, nil
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
+// API clients for any per-resource Region override are built lazily and cached.
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string) (T, error) {
+	// Any per-Region view determines the Region; the provider's client owns the caches.
+	region := c.RegionForContext(ctx)
+	c = c.root()
+
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if raw, ok := c.clients[servicePackageName]; ok {
+	key := c.apiClientKey(servicePackageName, region)
+
+	if raw, ok := c.clients[key]; ok {
 		if client, ok := raw.(T); ok {
 			return client, nil
 		} else {
@@ -229,7 +355,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string)
 		return zero, fmt.Errorf("no AWS SDK v2 API client factory: %s", servicePackageName)
 	}
 
-	client, err := v.NewClient(ctx, c.apiClientConfig(servicePackageName))
+	client, err := v.NewClient(ctx, c.apiClientConfig(servicePackageName, region))
 	if err != nil {
 		var zero T
 		return zero, err
@@ -237,7 +363,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string)
 
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
-	c.clients[servicePackageName] = client
+	c.clients[key] = client
 
 	return client, nil
 }
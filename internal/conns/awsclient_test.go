@@ -4,7 +4,10 @@
 package conns
 
 import (
+	"context"
 	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -90,3 +93,163 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 		})
 	}
 }
+
+func TestAWSClientRegionForContext(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+
+	ctx := context.Background()
+	if got, want := client.RegionForContext(ctx), "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("got %s, expected %s", got, want)
+	}
+
+	ctx = NewResourceContext(ctx, "test", "Test")
+	if got, want := client.RegionForContext(ctx), "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("got %s, expected %s", got, want)
+	}
+
+	inContext, _ := FromContext(ctx)
+	inContext.OverrideRegion = "eu-west-1" //lintignore:AWSAT003
+
+	if got, want := client.RegionForContext(ctx), "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("got %s, expected %s", got, want)
+	}
+	if got, want := client.apiClientKey("sqs", client.RegionForContext(ctx)), "sqs@eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("got %s, expected %s", got, want)
+	}
+}
+
+func TestAWSClientAPIClientConfig(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name                   string
+		AWSClient              *AWSClient
+		ServicePackageName     string
+		ExpectedEndpoint       string
+		ExpectedS3UsePathStyle bool
+	}{
+		{
+			Name:               "no endpoints",
+			AWSClient:          &AWSClient{},
+			ServicePackageName: names.SQS,
+			ExpectedEndpoint:   "",
+		},
+		{
+			Name: "service endpoint",
+			AWSClient: &AWSClient{
+				endpoints: map[string]string{
+					names.SQS: "https://sqs.fake.test",
+				},
+			},
+			ServicePackageName: names.SQS,
+			ExpectedEndpoint:   "https://sqs.fake.test",
+		},
+		{
+			Name: "base endpoint URL",
+			AWSClient: &AWSClient{
+				endpointURL: "http://localhost:4566",
+			},
+			ServicePackageName: names.SQS,
+			ExpectedEndpoint:   "http://localhost:4566",
+		},
+		{
+			Name: "service endpoint and base endpoint URL",
+			AWSClient: &AWSClient{
+				endpointURL: "http://localhost:4566",
+				endpoints: map[string]string{
+					names.SQS: "https://sqs.fake.test",
+				},
+			},
+			ServicePackageName: names.SQS,
+			ExpectedEndpoint:   "https://sqs.fake.test",
+		},
+		{
+			Name: "S3 service endpoint",
+			AWSClient: &AWSClient{
+				endpointURL: "http://localhost:4566",
+				endpoints: map[string]string{
+					names.S3: "https://s3.fake.test",
+				},
+			},
+			ServicePackageName:     names.S3,
+			ExpectedEndpoint:       "https://s3.fake.test",
+			ExpectedS3UsePathStyle: false,
+		},
+		{
+			Name: "S3 base endpoint URL",
+			AWSClient: &AWSClient{
+				endpointURL: "http://localhost:4566",
+			},
+			ServicePackageName:     names.S3,
+			ExpectedEndpoint:       "http://localhost:4566",
+			ExpectedS3UsePathStyle: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			m := testCase.AWSClient.apiClientConfig(testCase.ServicePackageName, testCase.AWSClient.Region)
+
+			if got, want := m["endpoint"].(string), testCase.ExpectedEndpoint; got != want {
+				t.Errorf("endpoint: got %s, expected %s", got, want)
+			}
+
+			if testCase.ServicePackageName == names.S3 {
+				if got, want := m["s3_use_path_style"].(bool), testCase.ExpectedS3UsePathStyle; got != want {
+					t.Errorf("s3_use_path_style: got %t, expected %t", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAWSClientForRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		AccountID: "123456789012",
+		DNSSuffix: "amazonaws.com",
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	if got := client.ForRegion(""); got != client {
+		t.Errorf("ForRegion(\"\"): got %p, expected provider client %p", got, client)
+	}
+	if got := client.ForRegion(client.Region); got != client {
+		t.Errorf("ForRegion(%s): got %p, expected provider client %p", client.Region, got, client)
+	}
+
+	regional := client.ForRegion("eu-west-1") //lintignore:AWSAT003
+
+	if got, want := regional.Region, "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("Region: got %s, expected %s", got, want)
+	}
+	if got, want := regional.AccountID, client.AccountID; got != want {
+		t.Errorf("AccountID: got %s, expected %s", got, want)
+	}
+	if got, want := regional.RegionalHostname("test"), "test.eu-west-1.amazonaws.com"; got != want { //lintignore:AWSAT003
+		t.Errorf("RegionalHostname: got %s, expected %s", got, want)
+	}
+	if got := regional.root(); got != client {
+		t.Errorf("root: got %p, expected provider client %p", got, client)
+	}
+	if got := regional.ForRegion(client.Region); got != client {
+		t.Errorf("ForRegion(%s) of per-Region view: got %p, expected provider client %p", client.Region, got, client)
+	}
+
+	ctx := NewResourceContext(context.Background(), "test", "Test")
+	inContext, _ := FromContext(ctx)
+	inContext.OverrideRegion = "eu-west-1" //lintignore:AWSAT003
+
+	if got, want := client.RegionForContext(ctx), regional.Region; got != want {
+		t.Errorf("RegionForContext: got %s, expected %s", got, want)
+	}
+}
@@ -21,13 +21,15 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	APIRateLimits                  *APIRateLimitsConfig
+	AssumeRole                     []*awsbase.AssumeRole // Assumed in order, each using the credentials of the previous.
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
+	EndpointURL                    string
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
 	HTTPProxy                      string
@@ -35,6 +37,7 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	Profile                        string
+	ReadOnly                       bool // Reject AWS API calls that are not read-only.
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
@@ -46,6 +49,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyCompliance            tftags.TagPolicyCompliance
 	TerraformVersion               string
 	Token                          string
 	UseDualStackEndpoint           bool
@@ -61,7 +65,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		CallerDocumentationURL:        "https://registry.terraform.io/providers/hashicorp/aws",
 		CallerName:                    "Terraform AWS Provider",
 		EC2MetadataServiceEnableState: c.EC2MetadataServiceEnableState,
-		IamEndpoint:                   c.Endpoints[names.IAM],
+		IamEndpoint:                   c.endpoint(names.IAM),
 		Insecure:                      c.Insecure,
 		HTTPClient:                    client.HTTPClient(),
 		HTTPProxy:                     c.HTTPProxy,
@@ -72,15 +76,16 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		SecretKey:                     c.SecretKey,
 		SkipCredsValidation:           c.SkipCredsValidation,
 		SkipRequestingAccountId:       c.SkipRequestingAccountId,
-		StsEndpoint:                   c.Endpoints[names.STS],
+		StsEndpoint:                   c.endpoint(names.STS),
 		SuppressDebugLog:              c.SuppressDebugLog,
 		Token:                         c.Token,
 		UseDualStackEndpoint:          c.UseDualStackEndpoint,
 		UseFIPSEndpoint:               c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	// The first role in any chain is assumed using the base credentials.
+	if len(c.AssumeRole) > 0 && c.AssumeRole[0] != nil && c.AssumeRole[0].RoleARN != "" {
+		awsbaseConfig.AssumeRole = c.AssumeRole[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -107,9 +112,21 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	tflog.Debug(ctx, "Configuring Terraform AWS Provider")
 	ctx, cfg, err := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
 	if err != nil {
+		if len(c.AssumeRole) > 1 && awsbase.IsCannotAssumeRoleError(err) {
+			err = assumeRoleHopError{hop: 1, hops: len(c.AssumeRole), err: err}
+		}
 		return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
 	}
 
+	// Any further roles in the chain are each assumed using the credentials of the previous role.
+	if awsbaseConfig.AssumeRole != nil && len(c.AssumeRole) > 1 {
+		credentials, err := assumeRoleChain(ctx, cfg, awsbaseConfig.StsEndpoint, awsbaseConfig.StsRegion, c.AssumeRole[1:], 2, len(c.AssumeRole))
+		if err != nil {
+			return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
+		}
+		cfg.Credentials = credentials
+	}
+
 	if !c.SkipRegionValidation {
 		if err := awsbase.ValidateRegion(cfg.Region); err != nil {
 			return nil, diag.FromErr(err)
@@ -123,6 +140,13 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diag.Errorf("creating AWS SDK v1 session: %s", err)
 	}
 
+	// Opt-in per-API call metrics for both AWS SDK for Go v1 and v2 API clients.
+	instrumentAPICalls(sess, &cfg)
+
+	if c.ReadOnly {
+		rejectNonReadOnlyAPICalls(sess, &cfg)
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
@@ -160,7 +184,6 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.AccountID = accountID
-	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.DNSSuffix = DNSSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
@@ -168,15 +191,28 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.SetHTTPClient(sess.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.Session = sess
+	client.TagPolicyCompliance = c.TagPolicyCompliance
 	client.TerraformVersion = c.TerraformVersion
 
 	// Used for lazy-loading AWS API clients.
+	client.rateLimiters, client.adaptiveLimiter = newAPIRateLimiters(c.APIRateLimits)
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
+	client.defaultTagsConfig = c.DefaultTagsConfig
+	client.endpointURL = c.EndpointURL
 	client.endpoints = c.Endpoints
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.stsRegion = c.STSRegion
 
 	return client, nil
 }
+
+// endpoint returns the endpoint URL configured for the specified service package, falling back to any base endpoint URL.
+func (c *Config) endpoint(servicePackageName string) string {
+	if v := c.Endpoints[servicePackageName]; v != "" {
+		return v
+	}
+
+	return c.EndpointURL
+}
@@ -16,11 +16,13 @@ import (
 )
 
 // ServicePackage is the minimal interface exported from each AWS service package.
-// Its methods return the Plugin SDK and Framework resources and data sources implemented in the package.
+// Its methods return the Plugin SDK and Framework resources, data sources, ephemeral resources and list resources implemented in the package.
 type ServicePackage interface {
 	FrameworkDataSources(context.Context) []*types.ServicePackageFrameworkDataSource
+	FrameworkEphemeralResources(context.Context) []*types.ServicePackageFrameworkEphemeralResource
 	FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource
 	SDKDataSources(context.Context) []*types.ServicePackageSDKDataSource
+	SDKListResources(context.Context) []*types.ServicePackageSDKListResource
 	SDKResources(context.Context) []*types.ServicePackageSDKResource
 	ServicePackageName() string
 }
@@ -36,6 +38,7 @@ var (
 // InContext represents the resource information kept in Context.
 type InContext struct {
 	IsDataSource       bool   // Data source?
+	OverrideRegion     string // Per-resource Region override, if any
 	ResourceName       string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName string // Canonical name defined as a constant in names package
 }
@@ -0,0 +1,377 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	middleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	awserr_sdkv1 "github.com/aws/aws-sdk-go/aws/awserr"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// APICallMetricsEnvVar enables per-API call metrics when set to a non-empty value.
+	APICallMetricsEnvVar = "TF_AWS_API_CALL_METRICS"
+	// APICallMetricsFileEnvVar is the path of a file to which per-API call metrics are exported as JSON Lines.
+	// Setting it also enables per-API call metrics.
+	APICallMetricsFileEnvVar = "TF_AWS_API_CALL_METRICS_FILE"
+)
+
+// apiCall is the record of a single AWS API call, including any retries.
+type apiCall struct {
+	Time               time.Time `json:"time"`
+	Service            string    `json:"service"`
+	Operation          string    `json:"operation"`
+	Region             string    `json:"region,omitempty"`
+	ServicePackageName string    `json:"service_package,omitempty"`
+	ResourceName       string    `json:"resource,omitempty"`
+	IsDataSource       bool      `json:"data_source,omitempty"`
+	LatencyMS          int64     `json:"latency_ms"`
+	Retries            int       `json:"retries"`
+	Throttled          bool      `json:"throttled"`
+	ErrorCode          string    `json:"error_code,omitempty"`
+}
+
+// apiCallStats are the aggregated metrics for a single AWS API operation.
+type apiCallStats struct {
+	Service   string
+	Operation string
+	Calls     int
+	Errors    int
+	Throttled int
+	Retries   int
+	Latency   time.Duration
+	Max       time.Duration
+}
+
+// apiCallSummary is the summary of all recorded AWS API calls, exported as the last line when recording stops.
+type apiCallSummary struct {
+	Time       time.Time               `json:"time"`
+	Operations []apiCallOperationStats `json:"summary"`
+}
+
+// apiCallOperationStats are the exported aggregated metrics for a single AWS API operation.
+type apiCallOperationStats struct {
+	Service        string `json:"service"`
+	Operation      string `json:"operation"`
+	Calls          int    `json:"calls"`
+	Errors         int    `json:"errors"`
+	Throttled      int    `json:"throttled"`
+	Retries        int    `json:"retries"`
+	TotalLatencyMS int64  `json:"total_latency_ms"`
+	MaxLatencyMS   int64  `json:"max_latency_ms"`
+}
+
+// apiCallRecorder records AWS API calls made by all configured provider instances.
+type apiCallRecorder struct {
+	lock      sync.Mutex
+	stats     map[string]*apiCallStats // Keyed by "service.operation".
+	encoder   *json.Encoder
+	file      *os.File
+	stopped   bool
+	throttled sync.Map // AWS SDK for Go v1 requests with throttled attempts.
+}
+
+var (
+	apiCallMetrics     *apiCallRecorder
+	apiCallMetricsOnce sync.Once
+)
+
+// apiCallMetricsRecorder returns the process-wide API call recorder, or nil if per-API call metrics are not enabled.
+func apiCallMetricsRecorder() *apiCallRecorder {
+	apiCallMetricsOnce.Do(func() {
+		path := os.Getenv(APICallMetricsFileEnvVar)
+		if path == "" && os.Getenv(APICallMetricsEnvVar) == "" {
+			return
+		}
+
+		apiCallMetrics = &apiCallRecorder{
+			stats: make(map[string]*apiCallStats),
+		}
+
+		if path != "" {
+			file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+			if err != nil {
+				log.Printf("[WARN] opening AWS API call metrics file (%s): %s", path, err)
+				return
+			}
+
+			apiCallMetrics.encoder = json.NewEncoder(file)
+			apiCallMetrics.file = file
+		}
+	})
+
+	return apiCallMetrics
+}
+
+func (r *apiCallRecorder) record(ctx context.Context, call apiCall) {
+	if inContext, ok := FromContext(ctx); ok {
+		call.IsDataSource = inContext.IsDataSource
+		call.ResourceName = inContext.ResourceName
+		call.ServicePackageName = inContext.ServicePackageName
+	}
+
+	tflog.Debug(ctx, "AWS API call", map[string]any{
+		"tf_aws.api_call.service":    call.Service,
+		"tf_aws.api_call.operation":  call.Operation,
+		"tf_aws.api_call.region":     call.Region,
+		"tf_aws.api_call.resource":   call.ResourceName,
+		"tf_aws.api_call.latency_ms": call.LatencyMS,
+		"tf_aws.api_call.retries":    call.Retries,
+		"tf_aws.api_call.throttled":  call.Throttled,
+		"tf_aws.api_call.error_code": call.ErrorCode,
+	})
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.stopped {
+		return
+	}
+
+	key := call.Service + "." + call.Operation
+	stats, ok := r.stats[key]
+	if !ok {
+		stats = &apiCallStats{
+			Service:   call.Service,
+			Operation: call.Operation,
+		}
+		r.stats[key] = stats
+	}
+
+	latency := time.Duration(call.LatencyMS) * time.Millisecond
+	stats.Calls++
+	if call.ErrorCode != "" {
+		stats.Errors++
+	}
+	if call.Throttled {
+		stats.Throttled++
+	}
+	stats.Retries += call.Retries
+	stats.Latency += latency
+	if latency > stats.Max {
+		stats.Max = latency
+	}
+
+	if r.encoder != nil {
+		if err := r.encoder.Encode(call); err != nil {
+			log.Printf("[WARN] exporting AWS API call metrics: %s", err)
+		}
+	}
+}
+
+// sortedStatsKeys returns the keys of the aggregated metrics, ordered by descending total latency.
+func (r *apiCallRecorder) sortedStatsKeys() []string {
+	keys := make([]string, 0, len(r.stats))
+	for k := range r.stats {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if a, b := r.stats[keys[i]].Latency, r.stats[keys[j]].Latency; a != b {
+			return a > b
+		}
+		return keys[i] < keys[j]
+	})
+
+	return keys
+}
+
+// summary returns a table of the aggregated metrics, ordered by descending total latency.
+func (r *apiCallRecorder) summary() string {
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "OPERATION\tCALLS\tERRORS\tTHROTTLED\tRETRIES\tTOTAL\tMAX")
+	for _, k := range r.sortedStatsKeys() {
+		v := r.stats[k]
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%s\t%s\n", k, v.Calls, v.Errors, v.Throttled, v.Retries, v.Latency, v.Max)
+	}
+	w.Flush()
+
+	return sb.String()
+}
+
+// exportSummary exports the aggregated metrics, ordered by descending total latency, as a single line.
+func (r *apiCallRecorder) exportSummary() error {
+	summary := apiCallSummary{
+		Time:       time.Now(),
+		Operations: make([]apiCallOperationStats, 0, len(r.stats)),
+	}
+	for _, k := range r.sortedStatsKeys() {
+		v := r.stats[k]
+		summary.Operations = append(summary.Operations, apiCallOperationStats{
+			Service:        v.Service,
+			Operation:      v.Operation,
+			Calls:          v.Calls,
+			Errors:         v.Errors,
+			Throttled:      v.Throttled,
+			Retries:        v.Retries,
+			TotalLatencyMS: v.Latency.Milliseconds(),
+			MaxLatencyMS:   v.Max.Milliseconds(),
+		})
+	}
+
+	return r.encoder.Encode(summary)
+}
+
+// StopAPICallMetrics stops recording AWS API calls, exports the summary to and closes any export file and returns a summary table.
+// The summary is returned only once, and is empty if per-API call metrics are not enabled.
+func StopAPICallMetrics() string {
+	r := apiCallMetricsRecorder()
+	if r == nil {
+		return ""
+	}
+
+	return r.stop()
+}
+
+func (r *apiCallRecorder) stop() string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.stopped {
+		return ""
+	}
+	r.stopped = true
+
+	// Terraform may no longer be reading the provider's logs once it has stopped the provider,
+	// so the summary is also exported.
+	if r.encoder != nil {
+		if err := r.exportSummary(); err != nil {
+			log.Printf("[WARN] exporting AWS API call metrics summary: %s", err)
+		}
+	}
+
+	if r.file != nil {
+		if err := r.file.Close(); err != nil {
+			log.Printf("[WARN] closing AWS API call metrics file: %s", err)
+		}
+	}
+
+	return r.summary()
+}
+
+// instrumentAPICalls adds AWS API call metrics recording to the specified AWS SDK for Go v1 session and v2 configuration.
+func instrumentAPICalls(sess *session_sdkv1.Session, cfg *aws_sdkv2.Config) {
+	r := apiCallMetricsRecorder()
+	if r == nil {
+		return
+	}
+
+	sess.Handlers.Complete.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "tf-aws.APICallMetrics",
+		Fn:   r.completeHandler,
+	})
+	sess.Handlers.AfterRetry.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: "tf-aws.APICallMetricsThrottle",
+		Fn:   r.afterRetryHandler,
+	})
+
+	cfg.APIOptions = append(cfg.APIOptions, func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(apiCallMetricsMiddleware{recorder: r}, middleware.After)
+	})
+}
+
+func (r *apiCallRecorder) afterRetryHandler(req *request_sdkv1.Request) {
+	if req.Error != nil && req.IsErrorThrottle() {
+		r.throttled.Store(req, true)
+	}
+}
+
+func (r *apiCallRecorder) completeHandler(req *request_sdkv1.Request) {
+	_, wasThrottled := r.throttled.LoadAndDelete(req)
+
+	call := apiCall{
+		Time:      req.Time,
+		Service:   req.ClientInfo.ServiceID,
+		Operation: req.Operation.Name,
+		Region:    req.ClientInfo.SigningRegion,
+		LatencyMS: time.Since(req.Time).Milliseconds(),
+		Retries:   req.RetryCount,
+		Throttled: wasThrottled,
+	}
+
+	if req.Error != nil {
+		if req.IsErrorThrottle() {
+			call.Throttled = true
+		}
+
+		var awsErr awserr_sdkv1.Error
+		if errors.As(req.Error, &awsErr) {
+			call.ErrorCode = awsErr.Code()
+		} else {
+			call.ErrorCode = "Unknown"
+		}
+	}
+
+	r.record(req.Context(), call)
+}
+
+// apiCallMetricsMiddleware records AWS SDK for Go v2 API calls.
+type apiCallMetricsMiddleware struct {
+	recorder *apiCallRecorder
+}
+
+func (apiCallMetricsMiddleware) ID() string {
+	return "TFAWSAPICallMetrics"
+}
+
+func (m apiCallMetricsMiddleware) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	start := time.Now()
+
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	call := apiCall{
+		Time:      start,
+		Service:   middleware_sdkv2.GetServiceID(ctx),
+		Operation: middleware_sdkv2.GetOperationName(ctx),
+		Region:    middleware_sdkv2.GetRegion(ctx),
+		LatencyMS: time.Since(start).Milliseconds(),
+	}
+
+	isErrorThrottle := retry_sdkv2.IsErrorThrottles(retry_sdkv2.DefaultThrottles)
+	if results, ok := retry_sdkv2.GetAttemptResults(metadata); ok {
+		if n := len(results.Results); n > 0 {
+			call.Retries = n - 1
+		}
+		for _, result := range results.Results {
+			if result.Err != nil && isErrorThrottle.IsErrorThrottle(result.Err) == aws_sdkv2.TrueTernary {
+				call.Throttled = true
+			}
+		}
+	}
+
+	if err != nil {
+		if isErrorThrottle.IsErrorThrottle(err) == aws_sdkv2.TrueTernary {
+			call.Throttled = true
+		}
+
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) {
+			call.ErrorCode = apiErr.ErrorCode()
+		} else {
+			call.ErrorCode = "Unknown"
+		}
+	}
+
+	m.recorder.record(ctx, call)
+
+	return out, metadata, err
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAPICallRecorder(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "metrics.jsonl")
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("creating file: %s", err)
+	}
+
+	r := &apiCallRecorder{
+		stats:   make(map[string]*apiCallStats),
+		encoder: json.NewEncoder(file),
+		file:    file,
+	}
+
+	ctx := NewResourceContext(context.Background(), "sqs", "Queue")
+	r.record(ctx, apiCall{Service: "SQS", Operation: "CreateQueue", LatencyMS: 20})
+	r.record(ctx, apiCall{Service: "SQS", Operation: "GetQueueAttributes", LatencyMS: 10})
+	r.record(ctx, apiCall{Service: "SQS", Operation: "GetQueueAttributes", LatencyMS: 30, Retries: 2, Throttled: true, ErrorCode: "RequestThrottled"})
+
+	if got, want := r.stats["SQS.GetQueueAttributes"].Calls, 2; got != want {
+		t.Errorf("Calls: got %d, expected %d", got, want)
+	}
+	if got, want := r.stats["SQS.GetQueueAttributes"].Errors, 1; got != want {
+		t.Errorf("Errors: got %d, expected %d", got, want)
+	}
+	if got, want := r.stats["SQS.GetQueueAttributes"].Throttled, 1; got != want {
+		t.Errorf("Throttled: got %d, expected %d", got, want)
+	}
+	if got, want := r.stats["SQS.GetQueueAttributes"].Retries, 2; got != want {
+		t.Errorf("Retries: got %d, expected %d", got, want)
+	}
+
+	summary := r.stop()
+	lines := strings.Split(strings.TrimSpace(summary), "\n")
+	if got, want := len(lines), 3; got != want {
+		t.Fatalf("summary lines: got %d, expected %d\n%s", got, want, summary)
+	}
+	// Ordered by descending total latency.
+	if !strings.HasPrefix(lines[1], "SQS.GetQueueAttributes") {
+		t.Errorf("summary line 1: got %q", lines[1])
+	}
+
+	if got := r.stop(); got != "" {
+		t.Errorf("summary returned more than once: %q", got)
+	}
+
+	file, err = os.Open(path)
+	if err != nil {
+		t.Fatalf("opening file: %s", err)
+	}
+	defer file.Close()
+
+	var exported [][]byte
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		exported = append(exported, append([]byte(nil), scanner.Bytes()...))
+	}
+
+	// The calls followed by the summary.
+	if got, want := len(exported), 4; got != want {
+		t.Fatalf("exported lines: got %d, expected %d", got, want)
+	}
+
+	var calls []apiCall
+	for _, line := range exported[:3] {
+		var call apiCall
+		if err := json.Unmarshal(line, &call); err != nil {
+			t.Fatalf("unmarshaling %q: %s", line, err)
+		}
+		calls = append(calls, call)
+	}
+
+	if got, want := calls[0].ResourceName, "Queue"; got != want {
+		t.Errorf("ResourceName: got %s, expected %s", got, want)
+	}
+	if got, want := calls[2].ErrorCode, "RequestThrottled"; got != want {
+		t.Errorf("ErrorCode: got %s, expected %s", got, want)
+	}
+
+	var exportedSummary apiCallSummary
+	if err := json.Unmarshal(exported[3], &exportedSummary); err != nil {
+		t.Fatalf("unmarshaling %q: %s", exported[3], err)
+	}
+
+	if got, want := len(exportedSummary.Operations), 2; got != want {
+		t.Fatalf("exported summary operations: got %d, expected %d", got, want)
+	}
+	if got, want := exportedSummary.Operations[0], (apiCallOperationStats{
+		Service:        "SQS",
+		Operation:      "GetQueueAttributes",
+		Calls:          2,
+		Errors:         1,
+		Throttled:      1,
+		Retries:        2,
+		TotalLatencyMS: 40,
+		MaxLatencyMS:   30,
+	}); got != want {
+		t.Errorf("exported summary operation 0: got %+v, expected %+v", got, want)
+	}
+}
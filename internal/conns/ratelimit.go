@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"math"
+	"slices"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+// APIRateLimitsConfig is the client-side AWS API rate limiting configuration.
+type APIRateLimitsConfig struct {
+	Adaptive          bool               // Reduce request rates for all services when throttling is observed.
+	RequestsPerSecond map[string]float64 // Keyed by service package name.
+}
+
+// apiRateLimiter limits the rate of AWS API calls to a single service.
+// Every attempt, including retries, is limited.
+type apiRateLimiter struct {
+	bucket   *tfsync.TokenBucket     // Per-service limit, if any.
+	adaptive *tfsync.AdaptiveLimiter // Limit shared by all services, if any.
+}
+
+func newAPIRateLimiters(v *APIRateLimitsConfig) (map[string]*tfsync.TokenBucket, *tfsync.AdaptiveLimiter) {
+	if v == nil {
+		return nil, nil
+	}
+
+	buckets := make(map[string]*tfsync.TokenBucket, len(v.RequestsPerSecond))
+	for k, rate := range v.RequestsPerSecond {
+		buckets[k] = tfsync.NewTokenBucket(rate, int(math.Ceil(rate)))
+	}
+
+	var adaptive *tfsync.AdaptiveLimiter
+	if v.Adaptive {
+		adaptive = tfsync.NewAdaptiveLimiter()
+	}
+
+	return buckets, adaptive
+}
+
+func (l apiRateLimiter) wait(ctx context.Context) error {
+	if l.adaptive != nil {
+		if err := l.adaptive.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	if l.bucket != nil {
+		if err := l.bucket.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l apiRateLimiter) observe(err error, isErrorThrottle bool) {
+	if l.adaptive == nil {
+		return
+	}
+
+	if isErrorThrottle {
+		l.adaptive.Throttled()
+	} else if err == nil {
+		l.adaptive.Succeeded()
+	}
+}
+
+// rateLimitAPICalls returns copies of the specified AWS SDK for Go v1 session and v2 configuration
+// that apply any client-side rate limits for the specified service.
+func (client *AWSClient) rateLimitAPICalls(servicePackageName string, session *session_sdkv1.Session, awsConfig *aws_sdkv2.Config) (*session_sdkv1.Session, *aws_sdkv2.Config) {
+	l := apiRateLimiter{
+		bucket:   client.rateLimiters[servicePackageName],
+		adaptive: client.adaptiveLimiter,
+	}
+
+	if l.bucket == nil && l.adaptive == nil {
+		return session, awsConfig
+	}
+
+	if session != nil {
+		session = session.Copy()
+		// Sign handlers run for every attempt.
+		session.Handlers.Sign.PushFrontNamed(request_sdkv1.NamedHandler{
+			Name: "tf-aws.RateLimit",
+			Fn: func(r *request_sdkv1.Request) {
+				if err := l.wait(r.Context()); err != nil {
+					r.Error = err
+				}
+			},
+		})
+		session.Handlers.AfterRetry.PushFrontNamed(request_sdkv1.NamedHandler{
+			Name: "tf-aws.RateLimitThrottle",
+			Fn: func(r *request_sdkv1.Request) {
+				l.observe(r.Error, r.Error != nil && r.IsErrorThrottle())
+			},
+		})
+		session.Handlers.Complete.PushBackNamed(request_sdkv1.NamedHandler{
+			Name: "tf-aws.RateLimitSuccess",
+			Fn: func(r *request_sdkv1.Request) {
+				if r.Error == nil {
+					l.observe(nil, false)
+				}
+			},
+		})
+	}
+
+	if awsConfig != nil {
+		cfg := awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), func(stack *middleware.Stack) error {
+			// Insert after the retry middleware so that every attempt is limited.
+			return stack.Finalize.Insert(apiRateLimitMiddleware{limiter: l}, "Retry", middleware.After)
+		})
+		awsConfig = &cfg
+	}
+
+	return session, awsConfig
+}
+
+// apiRateLimitMiddleware limits the rate of AWS SDK for Go v2 API call attempts.
+type apiRateLimitMiddleware struct {
+	limiter apiRateLimiter
+}
+
+func (apiRateLimitMiddleware) ID() string {
+	return "TFAWSRateLimit"
+}
+
+func (m apiRateLimitMiddleware) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	if err := m.limiter.wait(ctx); err != nil {
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+	}
+
+	out, metadata, err := next.HandleFinalize(ctx, in)
+
+	m.limiter.observe(err, err != nil && retry_sdkv2.IsErrorThrottles(retry_sdkv2.DefaultThrottles).IsErrorThrottle(err) == aws_sdkv2.TrueTernary)
+
+	return out, metadata, err
+}
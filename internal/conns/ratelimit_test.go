@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAWSClientRateLimitAPICalls(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	sess, err := session_sdkv1.NewSession(&aws_sdkv1.Config{Region: aws_sdkv1.String("us-west-2")}) //lintignore:AWSAT003
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+	awsConfig := &aws_sdkv2.Config{}
+
+	client := &AWSClient{}
+	client.rateLimiters, client.adaptiveLimiter = newAPIRateLimiters(&APIRateLimitsConfig{
+		RequestsPerSecond: map[string]float64{
+			names.Route53: 5,
+		},
+	})
+
+	gotSess, gotConfig := client.rateLimitAPICalls(names.SQS, sess, awsConfig)
+
+	if gotSess != sess || gotConfig != awsConfig {
+		t.Errorf("unlimited service: expected unmodified session and configuration")
+	}
+
+	gotSess, gotConfig = client.rateLimitAPICalls(names.Route53, sess, awsConfig)
+
+	if gotSess == sess {
+		t.Errorf("limited service: expected copied session")
+	}
+	if got, want := gotSess.Handlers.Sign.Len(), sess.Handlers.Sign.Len()+1; got != want {
+		t.Errorf("limited service: got %d Sign handlers, expected %d", got, want)
+	}
+	if got, want := len(gotConfig.APIOptions), len(awsConfig.APIOptions)+1; got != want {
+		t.Errorf("limited service: got %d API options, expected %d", got, want)
+	}
+
+	client.rateLimiters, client.adaptiveLimiter = newAPIRateLimiters(&APIRateLimitsConfig{
+		Adaptive: true,
+	})
+
+	gotSess, gotConfig = client.rateLimitAPICalls(names.SQS, sess, awsConfig)
+
+	if gotSess == sess || gotConfig == awsConfig {
+		t.Errorf("adaptive: expected copied session and configuration")
+	}
+}
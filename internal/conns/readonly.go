@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	middleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// readOnlyOperationPrefixes are the prefixes of AWS API operation names that do not modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+}
+
+// readOnlyOverrideKey is the Context key allowing AWS API calls that are not read-only.
+type readOnlyOverrideKey struct{}
+
+// WithReadOnlyOverride returns a Context in which read-only clients also allow AWS API calls that are not read-only.
+func WithReadOnlyOverride(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlyOverrideKey{}, true)
+}
+
+func readOnlyOverridden(ctx context.Context) bool {
+	v, _ := ctx.Value(readOnlyOverrideKey{}).(bool)
+	return v
+}
+
+// ReadOnlyError is returned for AWS API calls that are rejected as they are not read-only.
+type ReadOnlyError struct {
+	Service   string
+	Operation string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("%s %s: AWS API call rejected, client is read-only", e.Service, e.Operation)
+}
+
+func isReadOnlyOperation(operation string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// rejectNonReadOnlyAPICalls makes both AWS SDK for Go v1 and v2 API clients fail any call that is not read-only,
+// unless made with a Context returned by WithReadOnlyOverride.
+func rejectNonReadOnlyAPICalls(sess *session_sdkv1.Session, cfg *aws_sdkv2.Config) {
+	sess.Handlers.Validate.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: "tf-aws.ReadOnly",
+		Fn: func(r *request_sdkv1.Request) {
+			if !isReadOnlyOperation(r.Operation.Name) && !readOnlyOverridden(r.Context()) {
+				r.Error = &ReadOnlyError{Service: r.ClientInfo.ServiceID, Operation: r.Operation.Name}
+			}
+		},
+	})
+
+	cfg.APIOptions = append(cfg.APIOptions, func(stack *middleware.Stack) error {
+		// The operation name is only available after the SDK's own Initialize middleware has run.
+		return stack.Initialize.Add(readOnlyMiddleware{}, middleware.After)
+	})
+}
+
+// readOnlyMiddleware rejects AWS SDK for Go v2 API calls that are not read-only.
+type readOnlyMiddleware struct{}
+
+func (readOnlyMiddleware) ID() string {
+	return "TFAWSReadOnly"
+}
+
+func (readOnlyMiddleware) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	if operation := middleware_sdkv2.GetOperationName(ctx); !isReadOnlyOperation(operation) && !readOnlyOverridden(ctx) {
+		return middleware.InitializeOutput{}, middleware.Metadata{}, &ReadOnlyError{Service: middleware_sdkv2.GetServiceID(ctx), Operation: operation}
+	}
+
+	return next.HandleInitialize(ctx, in)
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	credentials_sdkv2 "github.com/aws/aws-sdk-go-v2/credentials"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	credentials_sdkv1 "github.com/aws/aws-sdk-go/aws/credentials"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	sts_sdkv1 "github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"":                     false,
+		"BatchGetItem":         true,
+		"CreateBucket":         false,
+		"DeleteRole":           false,
+		"DescribeInstances":    true,
+		"GetCallerIdentity":    true,
+		"HeadObject":           true,
+		"ListBuckets":          true,
+		"LookupEvents":         true,
+		"PutObject":            false,
+		"Query":                true,
+		"Scan":                 true,
+		"SearchResources":      true,
+		"TagResource":          false,
+		"UpdateFunctionConfig": false,
+	}
+
+	for operation, want := range testCases {
+		if got := isReadOnlyOperation(operation); got != want {
+			t.Errorf("isReadOnlyOperation(%q) = %t, expected %t", operation, got, want)
+		}
+	}
+}
+
+const testGetCallerIdentityResponse = `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::123456789012:user/test</Arn>
+    <UserId>AIDACKCEVSQ6C2EXAMPLE</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`
+
+func TestRejectNonReadOnlyAPICalls(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var requests atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprint(w, testGetCallerIdentityResponse)
+	}))
+	defer ts.Close()
+
+	sess, err := session_sdkv1.NewSession(&aws_sdkv1.Config{
+		Credentials: credentials_sdkv1.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws_sdkv1.String(ts.URL),
+		MaxRetries:  aws_sdkv1.Int(0),
+		Region:      aws_sdkv1.String("us-west-2"), //lintignore:AWSAT003
+	})
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+	cfg := aws_sdkv2.Config{
+		BaseEndpoint: aws_sdkv2.String(ts.URL),
+		Credentials:  credentials_sdkv2.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		Region:       "us-west-2", //lintignore:AWSAT003
+	}
+
+	rejectNonReadOnlyAPICalls(sess, &cfg)
+
+	const roleARN = "arn:aws:iam::123456789012:role/test" //lintignore:AWSAT005
+
+	// AWS SDK for Go v1.
+	conn := sts_sdkv1.New(sess)
+
+	if _, err := conn.GetCallerIdentityWithContext(ctx, &sts_sdkv1.GetCallerIdentityInput{}); err != nil {
+		t.Errorf("AWS SDK for Go v1 GetCallerIdentity: unexpected error: %s", err)
+	}
+
+	_, err = conn.AssumeRoleWithContext(ctx, &sts_sdkv1.AssumeRoleInput{
+		RoleArn:         aws_sdkv1.String(roleARN),
+		RoleSessionName: aws_sdkv1.String("test"),
+	})
+
+	if readOnlyErr := (*ReadOnlyError)(nil); !errors.As(err, &readOnlyErr) {
+		t.Errorf("AWS SDK for Go v1 AssumeRole: expected ReadOnlyError, got %v", err)
+	} else if got, want := readOnlyErr.Operation, "AssumeRole"; got != want {
+		t.Errorf("AWS SDK for Go v1 AssumeRole: got operation %q, expected %q", got, want)
+	}
+
+	_, err = conn.AssumeRoleWithContext(WithReadOnlyOverride(ctx), &sts_sdkv1.AssumeRoleInput{
+		RoleArn:         aws_sdkv1.String(roleARN),
+		RoleSessionName: aws_sdkv1.String("test"),
+	})
+
+	if readOnlyErr := (*ReadOnlyError)(nil); errors.As(err, &readOnlyErr) {
+		t.Errorf("AWS SDK for Go v1 AssumeRole with override: unexpected ReadOnlyError")
+	}
+
+	// AWS SDK for Go v2.
+	client := sts_sdkv2.NewFromConfig(cfg)
+
+	if _, err := client.GetCallerIdentity(ctx, &sts_sdkv2.GetCallerIdentityInput{}); err != nil {
+		t.Errorf("AWS SDK for Go v2 GetCallerIdentity: unexpected error: %s", err)
+	}
+
+	_, err = client.AssumeRole(ctx, &sts_sdkv2.AssumeRoleInput{
+		RoleArn:         aws_sdkv2.String(roleARN),
+		RoleSessionName: aws_sdkv2.String("test"),
+	})
+
+	if readOnlyErr := (*ReadOnlyError)(nil); !errors.As(err, &readOnlyErr) {
+		t.Errorf("AWS SDK for Go v2 AssumeRole: expected ReadOnlyError, got %v", err)
+	} else if got, want := readOnlyErr.Operation, "AssumeRole"; got != want {
+		t.Errorf("AWS SDK for Go v2 AssumeRole: got operation %q, expected %q", got, want)
+	}
+
+	_, err = client.AssumeRole(WithReadOnlyOverride(ctx), &sts_sdkv2.AssumeRoleInput{
+		RoleArn:         aws_sdkv2.String(roleARN),
+		RoleSessionName: aws_sdkv2.String("test"),
+	})
+
+	if readOnlyErr := (*ReadOnlyError)(nil); errors.As(err, &readOnlyErr) {
+		t.Errorf("AWS SDK for Go v2 AssumeRole with override: unexpected ReadOnlyError")
+	}
+
+	// Only the read-only and overridden calls reach the endpoint.
+	if got, want := requests.Load(), int32(4); got != want {
+		t.Errorf("got %d requests, expected %d", got, want)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	organizations_sdkv1 "github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// tagPolicy is the account's effective tag policy, fetched at most once per provider.
+type tagPolicy struct {
+	fetched bool
+	policy  *tftags.TagPolicy
+	err     error
+}
+
+// TagPolicyViolations returns a description of each way that the specified tags do not comply with the account's effective tag policy
+// for the specified resource type, as "service:type".
+// No violations are returned if tag policy compliance checking is disabled or the account has no effective tag policy.
+func (client *AWSClient) TagPolicyViolations(ctx context.Context, tags tftags.KeyValueTags, resourceType string) ([]string, error) {
+	if client.TagPolicyCompliance == "" || client.TagPolicyCompliance == tftags.TagPolicyComplianceDisabled {
+		return nil, nil
+	}
+
+	policy, err := client.effectiveTagPolicy(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return policy.Violations(tags.IgnoreAWS(), resourceType), nil
+}
+
+func (client *AWSClient) effectiveTagPolicy(ctx context.Context) (*tftags.TagPolicy, error) {
+	// The effective tag policy is account-wide and cached on the provider's client.
+	client = client.root()
+
+	client.tagPolicyLock.Lock()
+	defer client.tagPolicyLock.Unlock()
+
+	if !client.tagPolicy.fetched {
+		client.tagPolicy.policy, client.tagPolicy.err = findEffectiveTagPolicy(ctx, client.OrganizationsConn(ctx))
+		client.tagPolicy.fetched = true
+	}
+
+	return client.tagPolicy.policy, client.tagPolicy.err
+}
+
+func findEffectiveTagPolicy(ctx context.Context, conn *organizations_sdkv1.Organizations) (*tftags.TagPolicy, error) {
+	input := &organizations_sdkv1.DescribeEffectivePolicyInput{
+		PolicyType: aws_sdkv1.String(organizations_sdkv1.EffectivePolicyTypeTagPolicy),
+	}
+
+	output, err := conn.DescribeEffectivePolicyWithContext(ctx, input)
+
+	// No tag policy applies to the account.
+	if tfawserr.ErrCodeEquals(err, organizations_sdkv1.ErrCodeAWSOrganizationsNotInUseException, organizations_sdkv1.ErrCodeEffectivePolicyNotFoundException) {
+		tflog.Debug(ctx, "No effective tag policy found")
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading effective tag policy: %w", err)
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return nil, nil
+	}
+
+	return tftags.ParseTagPolicy(aws_sdkv1.StringValue(output.EffectivePolicy.PolicyContent))
+}
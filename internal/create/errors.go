@@ -84,7 +84,7 @@ func DiagErrorMessage(service, action, resource, id, message string) diag.Diagno
 	return diag.Diagnostics{
 		diag.Diagnostic{
 			Severity: diag.Error,
-			Summary:  ProblemStandardMessage(service, action, resource, id, fmt.Errorf(message)),
+			Summary:  ProblemStandardMessage(service, action, resource, id, errors.New(message)),
 		},
 	}
 }
@@ -116,7 +116,7 @@ func AddWarningMessage(diags diag.Diagnostics, service, action, resource, id, me
 	return append(diags,
 		diag.Diagnostic{
 			Severity: diag.Warning,
-			Summary:  ProblemStandardMessage(service, action, resource, id, fmt.Errorf(message)),
+			Summary:  ProblemStandardMessage(service, action, resource, id, errors.New(message)),
 		},
 	)
 }
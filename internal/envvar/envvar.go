@@ -66,6 +66,12 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For tests recording or replaying AWS API interactions, the VCR mode: "RECORDING" or "REPLAYING"
+	VCRMode = "VCR_MODE"
+
+	// For tests recording or replaying AWS API interactions, the directory containing the cassettes
+	VCRPath = "VCR_PATH"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -84,6 +90,34 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used with resource sweepers
+const (
+	// Set to any value to only report, per region, the resources that sweepers would delete
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// The maximum number of sweepers, and of resource deletions per sweeper, run concurrently.
+	// Defaults to 10.
+	SweepParallelism = "TF_AWS_SWEEP_PARALLELISM"
+
+	// Only sweep resources with this tag key
+	SweepTagKey = "TF_AWS_SWEEP_TAG_KEY"
+
+	// Only sweep resources whose SweepTagKey tag has this value
+	SweepTagValue = "TF_AWS_SWEEP_TAG_VALUE"
+
+	// Only sweep resources created at least this long ago, e.g. "24h"
+	SweepMinimumAge = "TF_AWS_SWEEP_MINIMUM_AGE"
+
+	// Only sweep resources whose name matches this regular expression
+	SweepNameRegex = "TF_AWS_SWEEP_NAME_REGEX"
+
+	// Comma-separated list of ARNs. If set, only resources with these ARNs are swept
+	SweepAllowARNs = "TF_AWS_SWEEP_ALLOW_ARNS"
+
+	// Comma-separated list of ARNs of resources that are never swept
+	SweepDenyARNs = "TF_AWS_SWEEP_DENY_ARNS"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -185,6 +185,41 @@ func (g *Graph) OverallOrder() ([]string, error) {
 	return order, nil
 }
 
+// Layers returns the nodes of the dependency graph grouped into layers, in processing order.
+// Every node's dependencies are in earlier layers, so the nodes in a layer can be processed concurrently
+// once all earlier layers have been processed.
+// Returns an error if a dependency cycle is detected.
+func (g *Graph) Layers() ([][]string, error) {
+	order, err := g.OverallOrder()
+
+	if err != nil {
+		return nil, err
+	}
+
+	// In overall order every node's dependencies precede it.
+	depths := make(map[string]int, len(order))
+	layers := make([][]string, 0)
+
+	for _, node := range order {
+		depth := 0
+
+		for _, dependency := range g.outgoingEdges[node] {
+			if d := depths[dependency] + 1; d > depth {
+				depth = d
+			}
+		}
+
+		depths[node] = depth
+
+		if depth == len(layers) {
+			layers = append(layers, make([]string, 0))
+		}
+		layers[depth] = append(layers[depth], node)
+	}
+
+	return layers, nil
+}
+
 // depthFirstSearch returns a Topological Sort using Depth-First-Search on a set of edges.
 // Returns an error if a dependency cycle is detected.
 func depthFirstSearch(edges map[string][]string) func(s string) ([]string, error) {
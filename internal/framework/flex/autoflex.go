@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const (
+	autoFlexSummary = "AutoFlEx"
+)
+
+// autoFlexer is implemented by the AutoFlEx expander and flattener.
+type autoFlexer interface {
+	// convert copies a single field value.
+	convert(context.Context, string, reflect.Value, reflect.Value) diag.Diagnostics
+}
+
+// autoFlexConvert walks `from` calling `flexer` for each exported field
+// that has a corresponding field in `to`.
+func autoFlexConvert(ctx context.Context, from, to any, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	valFrom, valTo := reflect.ValueOf(from), reflect.ValueOf(to)
+
+	if kind := valFrom.Kind(); kind == reflect.Ptr {
+		valFrom = valFrom.Elem()
+	}
+	if kind := valTo.Kind(); kind != reflect.Ptr {
+		diags.AddError(autoFlexSummary, fmt.Sprintf("target (%T): %s, want pointer", to, kind))
+		return diags
+	}
+	valTo = valTo.Elem()
+
+	diags.Append(autoFlexConvertStruct(ctx, "", valFrom, valTo, flexer)...)
+
+	return diags
+}
+
+// autoFlexConvertStruct calls `flexer` for each exported field of the struct `valFrom`
+// that has a corresponding (same name) settable field in the struct `valTo`.
+// All fields are visited and the diagnostics for each mismatched field are returned.
+func autoFlexConvertStruct(ctx context.Context, path string, valFrom, valTo reflect.Value, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if kind := valFrom.Kind(); kind != reflect.Struct {
+		diags.AddError(autoFlexSummary, fmt.Sprintf("%ssource: %s, want struct", pathPrefix(path), kind))
+		return diags
+	}
+	if kind := valTo.Kind(); kind != reflect.Struct {
+		diags.AddError(autoFlexSummary, fmt.Sprintf("%starget: %s, want struct", pathPrefix(path), kind))
+		return diags
+	}
+
+	typFrom := valFrom.Type()
+	for i := 0; i < typFrom.NumField(); i++ {
+		field := typFrom.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		fieldName := field.Name
+		if fieldName == "Tags" {
+			continue // Resource tags are handled separately.
+		}
+		toFieldVal := valTo.FieldByName(fieldName)
+		if !toFieldVal.IsValid() {
+			continue // Corresponding field not found in to.
+		}
+		if !toFieldVal.CanSet() {
+			continue // Corresponding field value can't be changed.
+		}
+
+		diags.Append(flexer.convert(ctx, fieldPath(path, fieldName), valFrom.Field(i), toFieldVal)...)
+	}
+
+	return diags
+}
+
+// autoFlexConvertNestedObjects copies nested objects (blocks) between Go structs, pointers to structs
+// and slices of structs or pointers to structs.
+// A single source object is copied to a one-element target slice and the first element of a source
+// slice is copied to a single target object.
+// Returns false if either of `valFrom` or `valTo` is not a nested object.
+func autoFlexConvertNestedObjects(ctx context.Context, path string, valFrom, valTo reflect.Value, flexer autoFlexer) (diag.Diagnostics, bool) {
+	var diags diag.Diagnostics
+
+	// Collect the source objects.
+	var elems []reflect.Value
+	switch typFrom := valFrom.Type(); {
+	case isNestedObject(typFrom):
+		elems = append(elems, valFrom)
+
+	case typFrom.Kind() == reflect.Ptr && isNestedObject(typFrom.Elem()):
+		if !valFrom.IsNil() {
+			elems = append(elems, valFrom.Elem())
+		}
+
+	case typFrom.Kind() == reflect.Slice && isNestedObject(typFrom.Elem()):
+		for i := 0; i < valFrom.Len(); i++ {
+			elems = append(elems, valFrom.Index(i))
+		}
+
+	case typFrom.Kind() == reflect.Slice && typFrom.Elem().Kind() == reflect.Ptr && isNestedObject(typFrom.Elem().Elem()):
+		for i := 0; i < valFrom.Len(); i++ {
+			if v := valFrom.Index(i); !v.IsNil() {
+				elems = append(elems, v.Elem())
+			}
+		}
+
+	default:
+		return diags, false
+	}
+
+	switch typTo := valTo.Type(); {
+	case isNestedObject(typTo):
+		valTo.Set(reflect.Zero(typTo))
+		if len(elems) > 0 {
+			diags.Append(autoFlexConvertStruct(ctx, path, elems[0], valTo, flexer)...)
+		}
+
+	case typTo.Kind() == reflect.Ptr && isNestedObject(typTo.Elem()):
+		valTo.Set(reflect.Zero(typTo))
+		if len(elems) > 0 {
+			ptr := reflect.New(typTo.Elem())
+			diags.Append(autoFlexConvertStruct(ctx, path, elems[0], ptr.Elem(), flexer)...)
+			valTo.Set(ptr)
+		}
+
+	case typTo.Kind() == reflect.Slice && isNestedObject(typTo.Elem()):
+		if valFrom.Kind() == reflect.Slice && valFrom.IsNil() || valFrom.Kind() == reflect.Ptr && valFrom.IsNil() {
+			valTo.Set(reflect.Zero(typTo))
+			break
+		}
+		slice := reflect.MakeSlice(typTo, len(elems), len(elems))
+		for i, elem := range elems {
+			diags.Append(autoFlexConvertStruct(ctx, fmt.Sprintf("%s[%d]", path, i), elem, slice.Index(i), flexer)...)
+		}
+		valTo.Set(slice)
+
+	case typTo.Kind() == reflect.Slice && typTo.Elem().Kind() == reflect.Ptr && isNestedObject(typTo.Elem().Elem()):
+		if valFrom.Kind() == reflect.Slice && valFrom.IsNil() || valFrom.Kind() == reflect.Ptr && valFrom.IsNil() {
+			valTo.Set(reflect.Zero(typTo))
+			break
+		}
+		slice := reflect.MakeSlice(typTo, len(elems), len(elems))
+		for i, elem := range elems {
+			ptr := reflect.New(typTo.Elem().Elem())
+			diags.Append(autoFlexConvertStruct(ctx, fmt.Sprintf("%s[%d]", path, i), elem, ptr.Elem(), flexer)...)
+			slice.Index(i).Set(ptr)
+		}
+		valTo.Set(slice)
+
+	default:
+		return diags, false
+	}
+
+	return diags, true
+}
+
+var (
+	attrValueType = reflect.TypeOf((*attr.Value)(nil)).Elem()
+	timeType      = reflect.TypeOf(time.Time{})
+)
+
+// isNestedObject returns whether the specified type models a nested object (block),
+// i.e. is a struct that is neither a Plugin Framework value nor a timestamp.
+func isNestedObject(typ reflect.Type) bool {
+	return typ.Kind() == reflect.Struct && typ != timeType && !typ.Implements(attrValueType)
+}
+
+// scalarTarget returns the settable value of one of the specified kinds for `valTo`.
+// Pointer targets are set to a newly allocated value.
+func scalarTarget(valTo reflect.Value, kinds ...reflect.Kind) (reflect.Value, bool) {
+	switch typTo := valTo.Type(); {
+	case slices.Contains(kinds, typTo.Kind()):
+		return valTo, true
+
+	case typTo.Kind() == reflect.Ptr && slices.Contains(kinds, typTo.Elem().Kind()):
+		ptr := reflect.New(typTo.Elem())
+		valTo.Set(ptr)
+		return ptr.Elem(), true
+	}
+
+	return reflect.Value{}, false
+}
+
+func fieldPath(path, fieldName string) string {
+	if path == "" {
+		return fieldName
+	}
+
+	return path + "." + fieldName
+}
+
+func pathPrefix(path string) string {
+	if path == "" {
+		return ""
+	}
+
+	return path + ": "
+}
@@ -7,10 +7,12 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 )
 
 // Expand "expands" a resource's "business logic" data structure,
@@ -19,178 +21,171 @@ import (
 // The resource's data structure is walked and exported fields that
 // have a corresponding field in the API data structure (and a suitable
 // target data type) are copied.
-func Expand(ctx context.Context, tfObject, apiObject any) error {
-	if err := walkStructFields(ctx, tfObject, apiObject, expandVisitor{}); err != nil {
-		return fmt.Errorf("Expand[%T, %T]: %w", tfObject, apiObject, err)
-	}
-
-	return nil
+// Nested blocks are modelled as Go structs, pointers to structs or slices of structs.
+func Expand(ctx context.Context, tfObject, apiObject any) diag.Diagnostics {
+	return autoFlexConvert(ctx, tfObject, apiObject, autoExpander{})
 }
 
-// walkStructFields traverses `from` calling `visitor` for each exported field.
-func walkStructFields(ctx context.Context, from any, to any, visitor fieldVisitor) error {
-	valFrom, valTo := reflect.ValueOf(from), reflect.ValueOf(to)
+type autoExpander struct{}
 
-	if kind := valFrom.Kind(); kind == reflect.Ptr {
-		valFrom = valFrom.Elem()
-	}
-	if kind := valTo.Kind(); kind != reflect.Ptr {
-		return fmt.Errorf("target (%T): %s, want pointer", to, kind)
-	}
-	valTo = valTo.Elem()
+func (expander autoExpander) convert(ctx context.Context, path string, valFrom, valTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-	typFrom, typTo := valFrom.Type(), valTo.Type()
+	vFrom, ok := valFrom.Interface().(attr.Value)
+	if !ok {
+		if diags, ok := autoFlexConvertNestedObjects(ctx, path, valFrom, valTo, expander); ok {
+			return diags
+		}
 
-	if typFrom.Kind() != reflect.Struct {
-		return fmt.Errorf("source: %s, want struct", typFrom)
+		diags.AddError(autoFlexSummary, fmt.Sprintf("%s: does not implement attr.Value: %s", path, valFrom.Kind()))
+		return diags
 	}
-	if typTo.Kind() != reflect.Struct {
-		return fmt.Errorf("target: %s, want struct", typTo)
+
+	return expander.value(ctx, path, vFrom, valTo)
+}
+
+// value copies the Plugin Framework value `vFrom` to `valTo`.
+func (expander autoExpander) value(ctx context.Context, path string, vFrom attr.Value, valTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// No need to set the target value if there's no source value.
+	if vFrom.IsNull() || vFrom.IsUnknown() {
+		return diags
 	}
 
-	for i := 0; i < typFrom.NumField(); i++ {
-		field := typFrom.Field(i)
-		if field.PkgPath != "" {
-			continue // Skip unexported fields.
+	switch vFrom := vFrom.(type) {
+	// Simple types.
+	case basetypes.BoolValuable:
+		v, d := vFrom.ToBoolValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
 		}
-		fieldName := field.Name
-		if fieldName == "Tags" {
-			continue // Resource tags are handled separately.
+
+		if valTo, ok := scalarTarget(valTo, reflect.Bool); ok {
+			valTo.SetBool(v.ValueBool())
+			return diags
 		}
-		toFieldVal := valTo.FieldByName(fieldName)
-		if !toFieldVal.IsValid() {
-			continue // Corresponding field not found in to.
+
+	case basetypes.Float64Valuable:
+		v, d := vFrom.ToFloat64Value(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
 		}
-		if !toFieldVal.CanSet() {
-			continue // Corresponding field value can't be changed.
+
+		if valTo, ok := scalarTarget(valTo, reflect.Float32, reflect.Float64); ok {
+			valTo.SetFloat(v.ValueFloat64())
+			return diags
 		}
-		if err := visitor.visit(ctx, fieldName, valFrom.Field(i), toFieldVal); err != nil {
-			return fmt.Errorf("visit (%s): %w", fieldName, err)
+
+	case basetypes.Int64Valuable:
+		v, d := vFrom.ToInt64Value(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
 		}
-	}
 
-	return nil
-}
+		if valTo, ok := scalarTarget(valTo, reflect.Int32, reflect.Int64); ok {
+			valTo.SetInt(v.ValueInt64())
+			return diags
+		}
 
-type fieldVisitor interface {
-	visit(context.Context, string, reflect.Value, reflect.Value) error
-}
+	// Timestamps are also StringValuable.
+	case fwtypes.TimestampValue:
+		switch typTo := valTo.Type(); {
+		case typTo == timeType:
+			valTo.Set(reflect.ValueOf(vFrom.ValueTimestamp()))
+			return diags
+
+		case typTo.Kind() == reflect.Ptr && typTo.Elem() == timeType:
+			v := vFrom.ValueTimestamp()
+			valTo.Set(reflect.ValueOf(&v))
+			return diags
+		}
 
-type expandVisitor struct{}
+		if valTo, ok := scalarTarget(valTo, reflect.String); ok {
+			valTo.SetString(vFrom.ValueString())
+			return diags
+		}
 
-func (v expandVisitor) visit(ctx context.Context, fieldName string, valFrom, valTo reflect.Value) error {
-	vFrom, ok := valFrom.Interface().(attr.Value)
-	if !ok {
-		return fmt.Errorf("does not implement attr.Value: %s", valFrom.Kind())
-	}
+	// Includes ARNs, durations and enumerations.
+	case basetypes.StringValuable:
+		v, d := vFrom.ToStringValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
 
-	// No need to set the target value if there's no source value.
-	if vFrom.IsNull() || vFrom.IsUnknown() {
-		return nil
-	}
+		if valTo, ok := scalarTarget(valTo, reflect.String); ok {
+			valTo.SetString(v.ValueString())
+			return diags
+		}
 
-	tFrom, kTo := vFrom.Type(ctx), valTo.Kind()
-	switch {
-	// Simple types.
-	case tFrom.Equal(types.BoolType):
-		vFrom := vFrom.(types.Bool).ValueBool()
-		switch kTo {
-		case reflect.Bool:
-			valTo.SetBool(vFrom)
-			return nil
-		case reflect.Ptr:
-			switch valTo.Type().Elem().Kind() {
-			case reflect.Bool:
-				valTo.Set(reflect.ValueOf(aws.Bool(vFrom)))
-				return nil
-			}
+	// Aggregate types.
+	case basetypes.ListValuable:
+		v, d := vFrom.ToListValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
 		}
 
-	case tFrom.Equal(types.Float64Type):
-		vFrom := vFrom.(types.Float64).ValueFloat64()
-		switch kTo {
-		case reflect.Float32, reflect.Float64:
-			valTo.SetFloat(vFrom)
-			return nil
-		case reflect.Ptr:
-			switch valTo.Type().Elem().Kind() {
-			case reflect.Float32:
-				valTo.Set(reflect.ValueOf(aws.Float32(float32(vFrom))))
-				return nil
-			case reflect.Float64:
-				valTo.Set(reflect.ValueOf(aws.Float64(vFrom)))
-				return nil
-			}
+		if valTo.Kind() == reflect.Slice {
+			return expander.elements(ctx, path, v.Elements(), valTo)
 		}
 
-	case tFrom.Equal(types.Int64Type):
-		vFrom := vFrom.(types.Int64).ValueInt64()
-		switch kTo {
-		case reflect.Int32, reflect.Int64:
-			valTo.SetInt(vFrom)
-			return nil
-		case reflect.Ptr:
-			switch valTo.Type().Elem().Kind() {
-			case reflect.Int32:
-				valTo.Set(reflect.ValueOf(aws.Int32(int32(vFrom))))
-				return nil
-			case reflect.Int64:
-				valTo.Set(reflect.ValueOf(aws.Int64(vFrom)))
-				return nil
-			}
+	case basetypes.SetValuable:
+		v, d := vFrom.ToSetValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
 		}
 
-	case tFrom.Equal(types.StringType):
-		vFrom := vFrom.(types.String).ValueString()
-		switch kTo {
-		case reflect.String:
-			valTo.SetString(vFrom)
-			return nil
-		case reflect.Ptr:
-			switch valTo.Type().Elem().Kind() {
-			case reflect.String:
-				valTo.Set(reflect.ValueOf(aws.String(vFrom)))
-				return nil
-			}
+		if valTo.Kind() == reflect.Slice {
+			return expander.elements(ctx, path, v.Elements(), valTo)
 		}
 
-		// Aggregate types.
-	case tFrom.Equal(types.ListType{ElemType: types.StringType}):
-		vFrom := vFrom.(types.List)
-		switch kTo {
-		case reflect.Slice:
-			switch tSliceElem := valTo.Type().Elem(); tSliceElem.Kind() {
-			case reflect.String:
-				valTo.Set(reflect.ValueOf(ExpandFrameworkStringValueList(ctx, vFrom)))
-				return nil
-
-			case reflect.Ptr:
-				switch tSliceElem.Elem().Kind() {
-				case reflect.String:
-					valTo.Set(reflect.ValueOf(ExpandFrameworkStringList(ctx, vFrom)))
-					return nil
-				}
-			}
+	case basetypes.MapValuable:
+		v, d := vFrom.ToMapValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
 		}
 
-	case tFrom.Equal(types.SetType{ElemType: types.StringType}):
-		vFrom := vFrom.(types.Set)
-		switch kTo {
-		case reflect.Slice:
-			switch tSliceElem := valTo.Type().Elem(); tSliceElem.Kind() {
-			case reflect.String:
-				valTo.Set(reflect.ValueOf(ExpandFrameworkStringValueSet(ctx, vFrom)))
-				return nil
-
-			case reflect.Ptr:
-				switch tSliceElem.Elem().Kind() {
-				case reflect.String:
-					valTo.Set(reflect.ValueOf(ExpandFrameworkStringSet(ctx, vFrom)))
-					return nil
-				}
+		if typTo := valTo.Type(); typTo.Kind() == reflect.Map && typTo.Key().Kind() == reflect.String {
+			elems := v.Elements()
+			keys := make([]string, 0, len(elems))
+			for k := range elems {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+
+			m := reflect.MakeMapWithSize(typTo, len(elems))
+			for _, k := range keys {
+				elem := reflect.New(typTo.Elem()).Elem()
+				diags.Append(expander.value(ctx, fmt.Sprintf("%s[%q]", path, k), elems[k], elem)...)
+				m.SetMapIndex(reflect.ValueOf(k).Convert(typTo.Key()), elem)
 			}
+			valTo.Set(m)
+
+			return diags
 		}
 	}
 
-	return fmt.Errorf("incompatible (%s): %s", tFrom, kTo)
+	diags.AddError(autoFlexSummary, fmt.Sprintf("%s: incompatible (%s): %s", path, vFrom.Type(ctx), valTo.Kind()))
+
+	return diags
+}
+
+// elements copies the elements of a Plugin Framework list or set to the slice `valTo`.
+func (expander autoExpander) elements(ctx context.Context, path string, elems []attr.Value, valTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	slice := reflect.MakeSlice(valTo.Type(), len(elems), len(elems))
+	for i, elem := range elems {
+		diags.Append(expander.value(ctx, fmt.Sprintf("%s[%d]", path, i), elem, slice.Index(i))...)
+	}
+	valTo.Set(slice)
+
+	return diags
 }
@@ -6,11 +6,14 @@ package flex
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 )
 
 type ATestExpand struct{}
@@ -95,11 +98,66 @@ type UTestExpand struct {
 	Names types.List
 }
 
+type testEnum string
+
+type VTestExpand struct {
+	Name testEnum
+}
+
+type WTestExpand struct {
+	Name *testEnum
+}
+
+type XTestExpand struct {
+	Arn      fwtypes.ARN
+	Duration fwtypes.Duration
+}
+
+type YTestExpand struct {
+	Arn      *string
+	Duration string
+}
+
+type ZTestExpand struct {
+	CreatedAt fwtypes.TimestampValue
+}
+
+type AATestExpand struct {
+	CreatedAt *time.Time
+}
+
+type ABTestExpand struct {
+	Names types.Map
+}
+
+type ACTestExpand struct {
+	Names map[string]*string
+}
+
+type ADTestExpand struct {
+	Name   types.String
+	Nested []BTestExpand
+	Single []OTestExpand
+}
+
+type AETestExpand struct {
+	Name   *string
+	Nested []DTestExpand
+	Single *QTestExpand
+}
+
+type AFTestExpand struct {
+	Nested []GTestExpand
+}
+
 func TestGenericExpand(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
 	testString := "test"
+	testEnumA := testEnum("a")
+	testARN := arn.ARN{Partition: "aws", Service: "iam", AccountID: "123456789012", Resource: "role/test"}
+	testTime := time.Date(2023, time.July, 1, 12, 0, 0, 0, time.UTC)
 	testCases := []struct {
 		TestName   string
 		Source     any
@@ -267,6 +325,58 @@ func TestGenericExpand(t *testing.T) {
 			Target:     &TTestExpand{},
 			WantTarget: &TTestExpand{Names: aws.StringSlice([]string{"a"})},
 		},
+		{
+			TestName:   "single string Source and single enum Target",
+			Source:     &BTestExpand{Name: types.StringValue("a")},
+			Target:     &VTestExpand{},
+			WantTarget: &VTestExpand{Name: testEnum("a")},
+		},
+		{
+			TestName:   "single string Source and single *enum Target",
+			Source:     &BTestExpand{Name: types.StringValue("a")},
+			Target:     &WTestExpand{},
+			WantTarget: &WTestExpand{Name: &testEnumA},
+		},
+		{
+			TestName:   "ARN and duration Source and string Target",
+			Source:     &XTestExpand{Arn: fwtypes.ARNValue(testARN), Duration: fwtypes.DurationValue(time.Hour)},
+			Target:     &YTestExpand{},
+			WantTarget: &YTestExpand{Arn: aws.String(testARN.String()), Duration: "1h0m0s"},
+		},
+		{
+			TestName:   "timestamp Source and *time.Time Target",
+			Source:     &ZTestExpand{CreatedAt: fwtypes.NewTimestampValue(testTime)},
+			Target:     &AATestExpand{},
+			WantTarget: &AATestExpand{CreatedAt: &testTime},
+		},
+		{
+			TestName:   "single map Source and single map Target",
+			Source:     &ABTestExpand{Names: types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("b")})},
+			Target:     &ACTestExpand{},
+			WantTarget: &ACTestExpand{Names: map[string]*string{"a": aws.String("b")}},
+		},
+		{
+			TestName: "nested block Source and nested struct Target",
+			Source: &ADTestExpand{
+				Name:   types.StringValue("a"),
+				Nested: []BTestExpand{{Name: types.StringValue("b")}, {Name: types.StringValue("c")}},
+				Single: []OTestExpand{{Name: types.BoolValue(true)}},
+			},
+			Target: &AETestExpand{},
+			WantTarget: &AETestExpand{
+				Name:   aws.String("a"),
+				Nested: []DTestExpand{{Name: aws.String("b")}, {Name: aws.String("c")}},
+				Single: &QTestExpand{Name: aws.Bool(true)},
+			},
+		},
+		{
+			TestName: "nested block Source and incompatible nested struct Target",
+			Source: &ADTestExpand{
+				Nested: []BTestExpand{{Name: types.StringValue("b")}},
+			},
+			Target:  &AFTestExpand{},
+			WantErr: true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -274,8 +384,8 @@ func TestGenericExpand(t *testing.T) {
 		t.Run(testCase.TestName, func(t *testing.T) {
 			t.Parallel()
 
-			err := Expand(ctx, testCase.Source, testCase.Target)
-			gotErr := err != nil
+			diags := Expand(ctx, testCase.Source, testCase.Target)
+			gotErr := diags.HasError()
 
 			if gotErr != testCase.WantErr {
 				t.Errorf("gotErr = %v, wantErr = %v", gotErr, testCase.WantErr)
@@ -283,7 +393,7 @@ func TestGenericExpand(t *testing.T) {
 
 			if gotErr {
 				if !testCase.WantErr {
-					t.Errorf("err = %q", err)
+					t.Errorf("diags = %v", diags)
 				}
 			} else if diff := cmp.Diff(testCase.Target, testCase.WantTarget); diff != "" {
 				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
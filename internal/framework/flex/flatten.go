@@ -7,10 +7,13 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 )
 
 // Flatten "flattens" an AWS SDK for Go v2 API data structure into
@@ -19,149 +22,271 @@ import (
 // The API data structure's fields are walked and exported fields that
 // have a corresponding field in the resource's data structure (and a
 // suitable target data type) are copied.
-func Flatten(ctx context.Context, apiObject, tfObject any) error {
-	if err := walkStructFields(ctx, apiObject, tfObject, flattenVisitor{}); err != nil {
-		return fmt.Errorf("Flatten[%T, %T]: %w", apiObject, tfObject, err)
+// Nested blocks are modelled as Go structs, pointers to structs or slices of structs.
+func Flatten(ctx context.Context, apiObject, tfObject any) diag.Diagnostics {
+	return autoFlexConvert(ctx, apiObject, tfObject, autoFlattener{})
+}
+
+type autoFlattener struct{}
+
+var (
+	arnType       = reflect.TypeOf(fwtypes.ARN{})
+	boolType      = reflect.TypeOf(types.Bool{})
+	durationType  = reflect.TypeOf(fwtypes.Duration{})
+	float64Type   = reflect.TypeOf(types.Float64{})
+	int64Type     = reflect.TypeOf(types.Int64{})
+	listType      = reflect.TypeOf(types.List{})
+	mapType       = reflect.TypeOf(types.Map{})
+	setType       = reflect.TypeOf(types.Set{})
+	stringType    = reflect.TypeOf(types.String{})
+	timestampType = reflect.TypeOf(fwtypes.TimestampValue{})
+)
+
+func (flattener autoFlattener) convert(ctx context.Context, path string, valFrom, valTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if _, ok := valTo.Interface().(attr.Value); !ok {
+		if diags, ok := autoFlexConvertNestedObjects(ctx, path, valFrom, valTo, flattener); ok {
+			return diags
+		}
+
+		diags.AddError(autoFlexSummary, fmt.Sprintf("%s: does not implement attr.Value: %s", path, valTo.Kind()))
+		return diags
 	}
 
-	return nil
+	return flattener.value(ctx, path, valFrom, valTo)
 }
 
-type flattenVisitor struct{}
+// value copies the API value `valFrom` to the Plugin Framework value `valTo`.
+// A nil pointer, slice or map is copied as a null value.
+func (flattener autoFlattener) value(ctx context.Context, path string, valFrom, valTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typFrom, typTo := valFrom.Type(), valTo.Type()
 
-func (v flattenVisitor) visit(ctx context.Context, fieldName string, valFrom, valTo reflect.Value) error {
-	vTo, ok := valTo.Interface().(attr.Value)
-	if !ok {
-		return fmt.Errorf("does not implement attr.Value: %s", valTo.Kind())
+	isNull := false
+	if typFrom.Kind() == reflect.Ptr {
+		isNull = valFrom.IsNil()
+		valFrom, typFrom = valFrom.Elem(), typFrom.Elem()
 	}
+	kFrom := typFrom.Kind()
 
-	kFrom, tTo := valFrom.Kind(), vTo.Type(ctx)
-	switch kFrom {
-	case reflect.Bool:
-		vFrom := valFrom.Bool()
-		switch {
-		case tTo.Equal(types.BoolType):
-			valTo.Set(reflect.ValueOf(types.BoolValue(vFrom)))
-			return nil
+	switch typTo {
+	// Simple types.
+	case boolType:
+		if kFrom == reflect.Bool {
+			if isNull {
+				valTo.Set(reflect.ValueOf(types.BoolNull()))
+			} else {
+				valTo.Set(reflect.ValueOf(types.BoolValue(valFrom.Bool())))
+			}
+			return diags
 		}
 
-	case reflect.Float32, reflect.Float64:
-		vFrom := valFrom.Float()
-		switch {
-		case tTo.Equal(types.Float64Type):
-			valTo.Set(reflect.ValueOf(types.Float64Value(vFrom)))
-			return nil
+	case float64Type:
+		if kFrom == reflect.Float32 || kFrom == reflect.Float64 {
+			if isNull {
+				valTo.Set(reflect.ValueOf(types.Float64Null()))
+			} else {
+				valTo.Set(reflect.ValueOf(types.Float64Value(valFrom.Float())))
+			}
+			return diags
 		}
 
-	case reflect.Int32, reflect.Int64:
-		vFrom := valFrom.Int()
-		switch {
-		case tTo.Equal(types.Int64Type):
-			valTo.Set(reflect.ValueOf(types.Int64Value(vFrom)))
-			return nil
+	case int64Type:
+		if kFrom == reflect.Int32 || kFrom == reflect.Int64 {
+			if isNull {
+				valTo.Set(reflect.ValueOf(types.Int64Null()))
+			} else {
+				valTo.Set(reflect.ValueOf(types.Int64Value(valFrom.Int())))
+			}
+			return diags
 		}
 
-	case reflect.String:
-		vFrom := valFrom.String()
+	// Includes enumerations.
+	case stringType:
 		switch {
-		case tTo.Equal(types.StringType):
-			valTo.Set(reflect.ValueOf(types.StringValue(vFrom)))
-			return nil
-		}
-
-	case reflect.Ptr:
-		vFrom := valFrom.Elem()
-		switch valFrom.Type().Elem().Kind() {
-		case reflect.Bool:
-			switch {
-			case tTo.Equal(types.BoolType):
-				if vFrom.IsValid() {
-					valTo.Set(reflect.ValueOf(types.BoolValue(vFrom.Bool())))
-				} else {
-					valTo.Set(reflect.ValueOf(types.BoolNull()))
-				}
-				return nil
+		case kFrom == reflect.String:
+			if isNull {
+				valTo.Set(reflect.ValueOf(types.StringNull()))
+			} else {
+				valTo.Set(reflect.ValueOf(types.StringValue(valFrom.String())))
 			}
+			return diags
 
-		case reflect.Float32, reflect.Float64:
-			switch {
-			case tTo.Equal(types.Float64Type):
-				if vFrom.IsValid() {
-					valTo.Set(reflect.ValueOf(types.Float64Value(vFrom.Float())))
-				} else {
-					valTo.Set(reflect.ValueOf(types.Float64Null()))
-				}
-				return nil
+		case typFrom == timeType:
+			if isNull {
+				valTo.Set(reflect.ValueOf(types.StringNull()))
+			} else {
+				valTo.Set(reflect.ValueOf(types.StringValue(valFrom.Interface().(time.Time).Format(time.RFC3339))))
 			}
+			return diags
+		}
 
-		case reflect.Int32, reflect.Int64:
-			switch {
-			case tTo.Equal(types.Int64Type):
-				if vFrom.IsValid() {
-					valTo.Set(reflect.ValueOf(types.Int64Value(vFrom.Int())))
-				} else {
-					valTo.Set(reflect.ValueOf(types.Int64Null()))
-				}
-				return nil
+	case arnType:
+		if kFrom == reflect.String {
+			if isNull {
+				valTo.Set(reflect.ValueOf(fwtypes.ARNNull()))
+				return diags
 			}
 
-		case reflect.String:
-			switch {
-			case tTo.Equal(types.StringType):
-				if vFrom.IsValid() {
-					valTo.Set(reflect.ValueOf(types.StringValue(vFrom.String())))
-				} else {
-					valTo.Set(reflect.ValueOf(types.StringNull()))
-				}
-				return nil
+			v, err := arn.Parse(valFrom.String())
+			if err != nil {
+				diags.AddError(autoFlexSummary, fmt.Sprintf("%s: %q cannot be parsed as an ARN: %s", path, valFrom.String(), err))
+				return diags
 			}
+
+			valTo.Set(reflect.ValueOf(fwtypes.ARNValue(v)))
+			return diags
 		}
 
-	case reflect.Slice:
-		vFrom := valFrom.Interface()
-		switch tSliceElem := valFrom.Type().Elem(); tSliceElem.Kind() {
-		case reflect.String:
-			switch {
-			case tTo.TerraformType(ctx).Is(tftypes.List{}):
-				if vFrom != nil {
-					valTo.Set(reflect.ValueOf(FlattenFrameworkStringValueList(ctx, vFrom.([]string))))
-				} else {
-					valTo.Set(reflect.ValueOf(types.ListNull(types.StringType)))
-				}
-				return nil
-
-			case tTo.TerraformType(ctx).Is(tftypes.Set{}):
-				if vFrom != nil {
-					valTo.Set(reflect.ValueOf(FlattenFrameworkStringValueSet(ctx, vFrom.([]string))))
-				} else {
-					valTo.Set(reflect.ValueOf(types.SetNull(types.StringType)))
-				}
-				return nil
+	case durationType:
+		if kFrom == reflect.String {
+			if isNull {
+				valTo.Set(reflect.ValueOf(fwtypes.DurationNull()))
+				return diags
 			}
 
-		case reflect.Ptr:
-			switch tSliceElem.Elem().Kind() {
-			case reflect.String:
-				switch {
-				case tTo.TerraformType(ctx).Is(tftypes.List{}):
-					if vFrom != nil {
-						valTo.Set(reflect.ValueOf(FlattenFrameworkStringList(ctx, vFrom.([]*string))))
-					} else {
-						valTo.Set(reflect.ValueOf(types.ListNull(types.StringType)))
-					}
-					return nil
-
-				case tTo.TerraformType(ctx).Is(tftypes.Set{}):
-					if vFrom != nil {
-						valTo.Set(reflect.ValueOf(FlattenFrameworkStringSet(ctx, vFrom.([]*string))))
-					} else {
-						valTo.Set(reflect.ValueOf(types.SetNull(types.StringType)))
-					}
-					return nil
-				}
+			v, err := time.ParseDuration(valFrom.String())
+			if err != nil {
+				diags.AddError(autoFlexSummary, fmt.Sprintf("%s: %q cannot be parsed as a duration: %s", path, valFrom.String(), err))
+				return diags
 			}
+
+			valTo.Set(reflect.ValueOf(fwtypes.DurationValue(v)))
+			return diags
 		}
+
+	case timestampType:
+		switch {
+		case typFrom == timeType:
+			if isNull {
+				valTo.Set(reflect.ValueOf(fwtypes.NewTimestampNull()))
+			} else {
+				valTo.Set(reflect.ValueOf(fwtypes.NewTimestampValue(valFrom.Interface().(time.Time))))
+			}
+			return diags
+
+		case kFrom == reflect.String:
+			if isNull {
+				valTo.Set(reflect.ValueOf(fwtypes.NewTimestampNull()))
+				return diags
+			}
+
+			v, err := fwtypes.NewTimestampValueString(valFrom.String())
+			if err != nil {
+				diags.AddError(autoFlexSummary, fmt.Sprintf("%s: %q cannot be parsed as a timestamp: %s", path, valFrom.String(), err))
+				return diags
+			}
+
+			valTo.Set(reflect.ValueOf(v))
+			return diags
+		}
+
+	// Aggregate types.
+	case listType, setType:
+		if kFrom != reflect.Slice {
+			break
+		}
+
+		elemType, typElem, ok := flattenElementType(typFrom.Elem())
+		if !ok {
+			break
+		}
+
+		elems, d := flattener.elements(ctx, path, valFrom, typElem)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if typTo == listType {
+			if valFrom.IsNil() {
+				valTo.Set(reflect.ValueOf(types.ListNull(elemType)))
+			} else {
+				v, d := types.ListValue(elemType, elems)
+				diags.Append(d...)
+				valTo.Set(reflect.ValueOf(v))
+			}
+		} else {
+			if valFrom.IsNil() {
+				valTo.Set(reflect.ValueOf(types.SetNull(elemType)))
+			} else {
+				v, d := types.SetValue(elemType, elems)
+				diags.Append(d...)
+				valTo.Set(reflect.ValueOf(v))
+			}
+		}
+		return diags
+
+	case mapType:
+		if kFrom != reflect.Map || typFrom.Key().Kind() != reflect.String {
+			break
+		}
+
+		elemType, typElem, ok := flattenElementType(typFrom.Elem())
+		if !ok {
+			break
+		}
+
+		if valFrom.IsNil() {
+			valTo.Set(reflect.ValueOf(types.MapNull(elemType)))
+			return diags
+		}
+
+		elems := make(map[string]attr.Value, valFrom.Len())
+		for iter := valFrom.MapRange(); iter.Next(); {
+			k := iter.Key().String()
+			elem := reflect.New(typElem).Elem()
+			diags.Append(flattener.value(ctx, fmt.Sprintf("%s[%q]", path, k), iter.Value(), elem)...)
+			elems[k] = elem.Interface().(attr.Value)
+		}
+		if diags.HasError() {
+			return diags
+		}
+
+		v, d := types.MapValue(elemType, elems)
+		diags.Append(d...)
+		valTo.Set(reflect.ValueOf(v))
+		return diags
+	}
+
+	diags.AddError(autoFlexSummary, fmt.Sprintf("%s: incompatible (%s): %s", path, typFrom, typTo))
+
+	return diags
+}
+
+// elements copies the elements of the API slice `valFrom` to Plugin Framework values of type `typElem`.
+func (flattener autoFlattener) elements(ctx context.Context, path string, valFrom reflect.Value, typElem reflect.Type) ([]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elems := make([]attr.Value, valFrom.Len())
+	for i := 0; i < valFrom.Len(); i++ {
+		elem := reflect.New(typElem).Elem()
+		diags.Append(flattener.value(ctx, fmt.Sprintf("%s[%d]", path, i), valFrom.Index(i), elem)...)
+		elems[i] = elem.Interface().(attr.Value)
+	}
+
+	return elems, diags
+}
+
+// flattenElementType returns the Plugin Framework element type, and corresponding Go type,
+// for the elements of an API slice or map.
+func flattenElementType(typ reflect.Type) (attr.Type, reflect.Type, bool) {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return types.BoolType, boolType, true
+	case reflect.Float32, reflect.Float64:
+		return types.Float64Type, float64Type, true
+	case reflect.Int32, reflect.Int64:
+		return types.Int64Type, int64Type, true
+	case reflect.String:
+		return types.StringType, stringType, true
 	}
 
-	return fmt.Errorf("incompatible (%s): %s", kFrom, tTo)
+	return nil, nil, false
 }
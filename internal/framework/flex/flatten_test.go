@@ -6,11 +6,14 @@ package flex
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 )
 
 type ATestFlatten struct{}
@@ -95,11 +98,49 @@ type UTestFlatten struct {
 	Names types.List
 }
 
+type VTestFlatten struct {
+	Name testEnum
+}
+
+type WTestFlatten struct {
+	Arn       *string
+	CreatedAt *time.Time
+	Duration  string
+}
+
+type XTestFlatten struct {
+	Arn       fwtypes.ARN
+	CreatedAt fwtypes.TimestampValue
+	Duration  fwtypes.Duration
+}
+
+type YTestFlatten struct {
+	Names map[string]string
+}
+
+type ZTestFlatten struct {
+	Names types.Map
+}
+
+type AATestFlatten struct {
+	Name   *string
+	Nested []*BTestFlatten
+	Single *OTestFlatten
+}
+
+type ABTestFlatten struct {
+	Name   types.String
+	Nested []DTestFlatten
+	Single []QTestFlatten
+}
+
 func TestGenericFlatten(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
 	testString := "test"
+	testARN := arn.ARN{Partition: "aws", Service: "iam", AccountID: "123456789012", Resource: "role/test"}
+	testTime := time.Date(2023, time.July, 1, 12, 0, 0, 0, time.UTC)
 	testCases := []struct {
 		TestName   string
 		Source     any
@@ -285,6 +326,60 @@ func TestGenericFlatten(t *testing.T) {
 			Target:     &UTestFlatten{},
 			WantTarget: &UTestFlatten{Names: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")})},
 		},
+		{
+			TestName:   "single enum Source and single string Target",
+			Source:     &VTestFlatten{Name: testEnum("a")},
+			Target:     &DTestFlatten{},
+			WantTarget: &DTestFlatten{Name: types.StringValue("a")},
+		},
+		{
+			TestName: "ARN, timestamp and duration Source and Target",
+			Source:   &WTestFlatten{Arn: aws.String(testARN.String()), CreatedAt: &testTime, Duration: "1h"},
+			Target:   &XTestFlatten{},
+			WantTarget: &XTestFlatten{
+				Arn:       fwtypes.ARNValue(testARN),
+				CreatedAt: fwtypes.NewTimestampValue(testTime),
+				Duration:  fwtypes.DurationValue(time.Hour),
+			},
+		},
+		{
+			TestName:   "nil ARN and timestamp Source and Target",
+			Source:     &WTestFlatten{Duration: "1h"},
+			Target:     &XTestFlatten{},
+			WantTarget: &XTestFlatten{Arn: fwtypes.ARNNull(), CreatedAt: fwtypes.NewTimestampNull(), Duration: fwtypes.DurationValue(time.Hour)},
+		},
+		{
+			TestName: "invalid ARN Source",
+			Source:   &WTestFlatten{Arn: aws.String("a"), Duration: "1h"},
+			Target:   &XTestFlatten{},
+			WantErr:  true,
+		},
+		{
+			TestName:   "single map Source and single map Target",
+			Source:     &YTestFlatten{Names: map[string]string{"a": "b"}},
+			Target:     &ZTestFlatten{},
+			WantTarget: &ZTestFlatten{Names: types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("b")})},
+		},
+		{
+			TestName: "nested struct Source and nested block Target",
+			Source: &AATestFlatten{
+				Name:   aws.String("a"),
+				Nested: []*BTestFlatten{{Name: "b"}, {Name: "c"}},
+				Single: &OTestFlatten{Name: true},
+			},
+			Target: &ABTestFlatten{},
+			WantTarget: &ABTestFlatten{
+				Name:   types.StringValue("a"),
+				Nested: []DTestFlatten{{Name: types.StringValue("b")}, {Name: types.StringValue("c")}},
+				Single: []QTestFlatten{{Name: types.BoolValue(true)}},
+			},
+		},
+		{
+			TestName:   "nil nested struct Source and nested block Target",
+			Source:     &AATestFlatten{},
+			Target:     &ABTestFlatten{Single: []QTestFlatten{{Name: types.BoolValue(true)}}},
+			WantTarget: &ABTestFlatten{Name: types.StringNull()},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -292,8 +387,8 @@ func TestGenericFlatten(t *testing.T) {
 		t.Run(testCase.TestName, func(t *testing.T) {
 			t.Parallel()
 
-			err := Flatten(ctx, testCase.Source, testCase.Target)
-			gotErr := err != nil
+			diags := Flatten(ctx, testCase.Source, testCase.Target)
+			gotErr := diags.HasError()
 
 			if gotErr != testCase.WantErr {
 				t.Errorf("gotErr = %v, wantErr = %v", gotErr, testCase.WantErr)
@@ -301,7 +396,7 @@ func TestGenericFlatten(t *testing.T) {
 
 			if gotErr {
 				if !testCase.WantErr {
-					t.Errorf("err = %q", err)
+					t.Errorf("diags = %v", diags)
 				}
 			} else if diff := cmp.Diff(testCase.Target, testCase.WantTarget); diff != "" {
 				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	ctymsgpack "github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ListResourceWithSDKv2Resource is a list resource for a Plugin SDK v2 resource.
+// The resource and its identity are set by the provider when the list resource is registered.
+type ListResourceWithSDKv2Resource struct {
+	withMeta
+	resource *schema.Resource
+	identity *types.ServicePackageResourceIdentity
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined ListResource type. It is separately executed for each
+// ListResource.
+func (l *ListResourceWithSDKv2Resource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		l.meta = v
+	}
+}
+
+// SetSDKv2Resource sets the listed Plugin SDK v2 resource and its identity.
+func (l *ListResourceWithSDKv2Resource) SetSDKv2Resource(r *schema.Resource, identity *types.ServicePackageResourceIdentity) {
+	l.resource = r
+	l.identity = identity
+}
+
+// RawV5Schemas returns the listed resource's schema and identity schema.
+func (l *ListResourceWithSDKv2Resource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	response.ProtoV5Schema = l.resource.ProtoSchema(ctx)()
+	if f := l.resource.ProtoIdentitySchema(ctx); f != nil {
+		response.ProtoV5IdentitySchema = f()
+	}
+}
+
+// NewListResult returns the list result for the resource with the specified ID.
+// The resource is read if its attribute values are requested or are needed for its identity.
+// The returned boolean is false if the resource no longer exists.
+func (l *ListResourceWithSDKv2Resource) NewListResult(ctx context.Context, request list.ListRequest, id, displayName string) (list.ListResult, bool) {
+	result := request.NewListResult(ctx)
+	result.DisplayName = displayName
+
+	meta := l.Meta()
+	d := l.resource.Data(nil)
+	d.SetId(id)
+
+	if request.IncludeResource || len(l.identity.Attributes) > 1 {
+		if diags := l.resource.ReadWithoutTimeout(ctx, d, meta); diags.HasError() {
+			result.Diagnostics.AddError(fmt.Sprintf("reading %s", id), sdkdiag.DiagnosticsError(diags).Error())
+
+			return result, true
+		}
+
+		if d.Id() == "" {
+			return result, false
+		}
+	}
+
+	if request.IncludeResource {
+		ty := l.resource.CoreConfigSchema().ImpliedType()
+		value, err := d.State().AttrsAsObjectValue(ty)
+		if err != nil {
+			result.Diagnostics.AddError(fmt.Sprintf("converting %s", id), err.Error())
+
+			return result, true
+		}
+
+		b, err := ctymsgpack.Marshal(value, ty)
+		if err != nil {
+			result.Diagnostics.AddError(fmt.Sprintf("converting %s", id), err.Error())
+
+			return result, true
+		}
+
+		raw, err := (&tfprotov5.DynamicValue{MsgPack: b}).Unmarshal(result.Resource.Schema.Type().TerraformType(ctx))
+		if err != nil {
+			result.Diagnostics.AddError(fmt.Sprintf("converting %s", id), err.Error())
+
+			return result, true
+		}
+
+		result.Resource.Raw = raw
+	}
+
+	result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(names.AttrAccountID), meta.AccountID)...)
+	if !l.identity.Global {
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(names.AttrRegion), meta.RegionForContext(ctx))...)
+	}
+	for _, attr := range l.identity.Attributes {
+		value := id
+		if len(l.identity.Attributes) > 1 {
+			value = d.Get(attr).(string)
+		}
+
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(attr), value)...)
+	}
+
+	return result, true
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// regionNameValidator validates that a string Attribute's value is a well-formed AWS Region name.
+type regionNameValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator regionNameValidator) Description(_ context.Context) string {
+	return "value must be a valid AWS Region name"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator regionNameValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (validator regionNameValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, errs := verify.ValidRegionName(request.ConfigValue.ValueString(), request.Path.String()); len(errs) > 0 {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			request.ConfigValue.ValueString(),
+		))
+
+		return
+	}
+}
+
+// RegionName returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a well-formed AWS Region name.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func RegionName() validator.String {
+	return regionNameValidator{}
+}
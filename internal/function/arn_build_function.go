@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = arnBuildFunction{}
+
+func NewARNBuildFunction() function.Function {
+	return &arnBuildFunction{}
+}
+
+type arnBuildFunction struct{}
+
+func (f arnBuildFunction) Metadata(ctx context.Context, request function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "arn_build"
+}
+
+func (f arnBuildFunction) Definition(ctx context.Context, request function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:     "Build an ARN",
+		Description: "Builds an Amazon Resource Name (ARN) from its constituent parts.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "partition",
+				Description: "Partition in which the resource is located.",
+			},
+			function.StringParameter{
+				Name:        "service",
+				Description: "Service namespace.",
+			},
+			function.StringParameter{
+				Name:        "region",
+				Description: "Region code.",
+			},
+			function.StringParameter{
+				Name:        "account_id",
+				Description: "AWS account identifier.",
+			},
+			function.StringParameter{
+				Name:        "resource",
+				Description: "Resource section, typically composed of a resource type and identifier.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f arnBuildFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var partition, service, region, accountID, resource string
+
+	response.Error = function.ConcatFuncErrors(response.Error, request.Arguments.Get(ctx, &partition, &service, &region, &accountID, &resource))
+	if response.Error != nil {
+		return
+	}
+
+	result := arn.ARN{
+		Partition: partition,
+		Service:   service,
+		Region:    region,
+		AccountID: accountID,
+		Resource:  resource,
+	}.String()
+
+	if _, err := itypes.ParseARN(result); err != nil {
+		response.Error = function.ConcatFuncErrors(response.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Error, response.Result.Set(ctx, result))
+}
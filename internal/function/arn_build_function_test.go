@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestARNBuildFunction(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		arguments     []string
+		expected      string
+		expectedError bool
+	}{
+		{
+			name:      "global",
+			arguments: []string{"aws", "iam", "", "444455556666", "role/example"},
+			expected:  "arn:aws:iam::444455556666:role/example", //lintignore:AWSAT005
+		},
+		{
+			name:      "regional",
+			arguments: []string{"aws", "sqs", "us-west-2", "444455556666", "queue"}, //lintignore:AWSAT003
+			expected:  "arn:aws:sqs:us-west-2:444455556666:queue",                   //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			name:          "missing partition",
+			arguments:     []string{"", "iam", "", "444455556666", "role/example"},
+			expectedError: true,
+		},
+		{
+			name:          "missing resource",
+			arguments:     []string{"aws", "iam", "", "444455556666", ""},
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			var arguments []attr.Value
+			for _, v := range testCase.arguments {
+				arguments = append(arguments, types.StringValue(v))
+			}
+			request := function.RunRequest{
+				Arguments: function.NewArgumentsData(arguments),
+			}
+			response := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			NewARNBuildFunction().Run(ctx, request, &response)
+
+			if got, want := response.Error != nil, testCase.expectedError; got != want {
+				t.Fatalf("Error = %v, expectedError %v", response.Error, want)
+			}
+			if response.Error != nil {
+				return
+			}
+
+			if got, want := response.Result.Value(), types.StringValue(testCase.expected); !got.Equal(want) {
+				t.Errorf("Result = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var arnParseResultAttrTypes = map[string]attr.Type{
+	"partition":  types.StringType,
+	"service":    types.StringType,
+	"region":     types.StringType,
+	"account_id": types.StringType,
+	"resource":   types.StringType,
+}
+
+var _ function.Function = arnParseFunction{}
+
+func NewARNParseFunction() function.Function {
+	return &arnParseFunction{}
+}
+
+type arnParseFunction struct{}
+
+func (f arnParseFunction) Metadata(ctx context.Context, request function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "arn_parse"
+}
+
+func (f arnParseFunction) Definition(ctx context.Context, request function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:     "Parse an ARN",
+		Description: "Parses an Amazon Resource Name (ARN) into an object containing its partition, service, region, account_id and resource components.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "arn",
+				Description: "ARN (Amazon Resource Name) to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: arnParseResultAttrTypes,
+		},
+	}
+}
+
+func (f arnParseFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var arnString string
+
+	response.Error = function.ConcatFuncErrors(response.Error, request.Arguments.Get(ctx, &arnString))
+	if response.Error != nil {
+		return
+	}
+
+	v, err := itypes.ParseARN(arnString)
+	if err != nil {
+		response.Error = function.ConcatFuncErrors(response.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"partition":  types.StringValue(v.Partition),
+		"service":    types.StringValue(v.Service),
+		"region":     types.StringValue(v.Region),
+		"account_id": types.StringValue(v.AccountID),
+		"resource":   types.StringValue(v.Resource),
+	}
+
+	result, diags := types.ObjectValue(arnParseResultAttrTypes, value)
+	response.Error = function.ConcatFuncErrors(response.Error, function.FuncErrorFromDiags(ctx, diags))
+	if response.Error != nil {
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Error, response.Result.Set(ctx, result))
+}
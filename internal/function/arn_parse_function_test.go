@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestARNParseFunction(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		arn           string
+		expected      map[string]attr.Value
+		expectedError bool
+	}{
+		{
+			name: "valid",
+			arn:  "arn:aws:iam::444455556666:role/example", //lintignore:AWSAT005
+			expected: map[string]attr.Value{
+				"partition":  types.StringValue("aws"),
+				"service":    types.StringValue("iam"),
+				"region":     types.StringValue(""),
+				"account_id": types.StringValue("444455556666"),
+				"resource":   types.StringValue("role/example"),
+			},
+		},
+		{
+			name: "regional",
+			arn:  "arn:aws:sqs:us-west-2:444455556666:queue", //lintignore:AWSAT003,AWSAT005
+			expected: map[string]attr.Value{
+				"partition":  types.StringValue("aws"),
+				"service":    types.StringValue("sqs"),
+				"region":     types.StringValue("us-west-2"), //lintignore:AWSAT003
+				"account_id": types.StringValue("444455556666"),
+				"resource":   types.StringValue("queue"),
+			},
+		},
+		{
+			name:          "invalid",
+			arn:           "not-an-arn",
+			expectedError: true,
+		},
+		{
+			name:          "invalid account ID",
+			arn:           "arn:aws:iam::4444:role/example", //lintignore:AWSAT005
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			request := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(testCase.arn)}),
+			}
+			response := function.RunResponse{
+				Result: function.NewResultData(types.ObjectUnknown(arnParseResultAttrTypes)),
+			}
+
+			NewARNParseFunction().Run(ctx, request, &response)
+
+			if got, want := response.Error != nil, testCase.expectedError; got != want {
+				t.Fatalf("Error = %v, expectedError %v", response.Error, want)
+			}
+			if response.Error != nil {
+				return
+			}
+
+			expected := types.ObjectValueMust(arnParseResultAttrTypes, testCase.expected)
+			if diff := cmp.Diff(response.Result.Value(), expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	// IAM role ARN resources are of the form `role/<path>/<name>`.
+	iamRoleResourcePrefix = "role/"
+)
+
+var _ function.Function = trimIAMRolePathFunction{}
+
+func NewTrimIAMRolePathFunction() function.Function {
+	return &trimIAMRolePathFunction{}
+}
+
+type trimIAMRolePathFunction struct{}
+
+func (f trimIAMRolePathFunction) Metadata(ctx context.Context, request function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "trim_iam_role_path"
+}
+
+func (f trimIAMRolePathFunction) Definition(ctx context.Context, request function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:     "Trim role path from an IAM role ARN",
+		Description: "Removes the path from an IAM role Amazon Resource Name (ARN). This is useful where a role ARN must not contain a path, for example as a principal in an Amazon EKS aws-auth ConfigMap.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "arn",
+				Description: "IAM role ARN (Amazon Resource Name).",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f trimIAMRolePathFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var arnString string
+
+	response.Error = function.ConcatFuncErrors(response.Error, request.Arguments.Get(ctx, &arnString))
+	if response.Error != nil {
+		return
+	}
+
+	result, err := trimIAMRolePath(arnString)
+	if err != nil {
+		response.Error = function.ConcatFuncErrors(response.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Error, response.Result.Set(ctx, result))
+}
+
+// trimIAMRolePath removes the path from the specified IAM role ARN.
+func trimIAMRolePath(s string) (string, error) {
+	v, err := itypes.ParseARN(s)
+	if err != nil {
+		return "", err
+	}
+
+	if v.Service != "iam" || !strings.HasPrefix(v.Resource, iamRoleResourcePrefix) {
+		return "", fmt.Errorf("%q is not an IAM role ARN", s)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(v.Resource, iamRoleResourcePrefix), "/")
+	v.Resource = iamRoleResourcePrefix + parts[len(parts)-1]
+
+	return v.String(), nil
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"testing"
+)
+
+func TestTrimIAMRolePath(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		arn           string
+		expected      string
+		expectedError bool
+	}{
+		{
+			name:     "no path",
+			arn:      "arn:aws:iam::444455556666:role/example", //lintignore:AWSAT005
+			expected: "arn:aws:iam::444455556666:role/example", //lintignore:AWSAT005
+		},
+		{
+			name:     "single level path",
+			arn:      "arn:aws:iam::444455556666:role/path/example", //lintignore:AWSAT005
+			expected: "arn:aws:iam::444455556666:role/example",      //lintignore:AWSAT005
+		},
+		{
+			name:     "multi level path",
+			arn:      "arn:aws:iam::444455556666:role/a/b/c/example", //lintignore:AWSAT005
+			expected: "arn:aws:iam::444455556666:role/example",       //lintignore:AWSAT005
+		},
+		{
+			name:          "not a role",
+			arn:           "arn:aws:iam::444455556666:user/path/example", //lintignore:AWSAT005
+			expectedError: true,
+		},
+		{
+			name:          "not IAM",
+			arn:           "arn:aws:sqs:us-west-2:444455556666:queue", //lintignore:AWSAT003,AWSAT005
+			expectedError: true,
+		},
+		{
+			name:          "invalid",
+			arn:           "not-an-arn",
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := trimIAMRolePath(testCase.arn)
+
+			if gotErr, want := err != nil, testCase.expectedError; gotErr != want {
+				t.Fatalf("err = %v, expectedError %v", err, want)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
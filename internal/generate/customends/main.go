@@ -24,6 +24,7 @@ var footer string
 type ServiceDatum struct {
 	ProviderPackage string
 	Aliases         []string
+	RetiredName     string
 }
 
 type TemplateData struct {
@@ -53,6 +54,14 @@ func main() {
 		}
 
 		if l[names.ColExclude] != "" {
+			// Endpoints of retired services are accepted, but ignored.
+			if l[names.ColNote] == "Retired" && l[names.ColProviderPackageCorrect] != "" {
+				td.Services = append(td.Services, ServiceDatum{
+					ProviderPackage: l[names.ColProviderPackageCorrect],
+					RetiredName:     l[names.ColHumanFriendly],
+				})
+			}
+
 			continue
 		}
 
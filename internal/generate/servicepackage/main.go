@@ -15,6 +15,7 @@ import (
 	"os"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	multierror "github.com/hashicorp/go-multierror"
@@ -65,10 +66,12 @@ func main() {
 		v := &visitor{
 			g: g,
 
-			frameworkDataSources: make([]ResourceDatum, 0),
-			frameworkResources:   make([]ResourceDatum, 0),
-			sdkDataSources:       make(map[string]ResourceDatum),
-			sdkResources:         make(map[string]ResourceDatum),
+			frameworkDataSources:        make([]ResourceDatum, 0),
+			frameworkEphemeralResources: make([]ResourceDatum, 0),
+			frameworkResources:          make([]ResourceDatum, 0),
+			sdkDataSources:              make(map[string]ResourceDatum),
+			sdkListResources:            make(map[string]ResourceDatum),
+			sdkResources:                make(map[string]ResourceDatum),
 		}
 
 		v.processDir(".")
@@ -78,15 +81,17 @@ func main() {
 		}
 
 		s := ServiceDatum{
-			SkipClientGenerate:   l[names.ColSkipClientGenerate] != "",
-			GoV1Package:          l[names.ColGoV1Package],
-			GoV2Package:          l[names.ColGoV2Package],
-			ProviderPackage:      p,
-			ProviderNameUpper:    l[names.ColProviderNameUpper],
-			FrameworkDataSources: v.frameworkDataSources,
-			FrameworkResources:   v.frameworkResources,
-			SDKDataSources:       v.sdkDataSources,
-			SDKResources:         v.sdkResources,
+			SkipClientGenerate:          l[names.ColSkipClientGenerate] != "",
+			GoV1Package:                 l[names.ColGoV1Package],
+			GoV2Package:                 l[names.ColGoV2Package],
+			ProviderPackage:             p,
+			ProviderNameUpper:           l[names.ColProviderNameUpper],
+			FrameworkDataSources:        v.frameworkDataSources,
+			FrameworkEphemeralResources: v.frameworkEphemeralResources,
+			FrameworkResources:          v.frameworkResources,
+			SDKDataSources:              v.sdkDataSources,
+			SDKListResources:            v.sdkListResources,
+			SDKResources:                v.sdkResources,
 		}
 
 		if l[names.ColClientSDKV1] != "" {
@@ -104,6 +109,9 @@ func main() {
 		sort.SliceStable(s.FrameworkDataSources, func(i, j int) bool {
 			return s.FrameworkDataSources[i].FactoryName < s.FrameworkDataSources[j].FactoryName
 		})
+		sort.SliceStable(s.FrameworkEphemeralResources, func(i, j int) bool {
+			return s.FrameworkEphemeralResources[i].FactoryName < s.FrameworkEphemeralResources[j].FactoryName
+		})
 		sort.SliceStable(s.FrameworkResources, func(i, j int) bool {
 			return s.FrameworkResources[i].FactoryName < s.FrameworkResources[j].FactoryName
 		})
@@ -128,20 +136,24 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	IdentityAttributes      []string
+	IdentityGlobal          bool
 }
 
 type ServiceDatum struct {
-	SkipClientGenerate   bool
-	SDKVersion           string // AWS SDK for Go version ("1", "2" or "1,2")
-	GoV1Package          string // AWS SDK for Go v1 package name
-	GoV1ClientTypeName   string // AWS SDK for Go v1 client type name
-	GoV2Package          string // AWS SDK for Go v2 package name
-	ProviderPackage      string
-	ProviderNameUpper    string
-	FrameworkDataSources []ResourceDatum
-	FrameworkResources   []ResourceDatum
-	SDKDataSources       map[string]ResourceDatum
-	SDKResources         map[string]ResourceDatum
+	SkipClientGenerate          bool
+	SDKVersion                  string // AWS SDK for Go version ("1", "2" or "1,2")
+	GoV1Package                 string // AWS SDK for Go v1 package name
+	GoV1ClientTypeName          string // AWS SDK for Go v1 client type name
+	GoV2Package                 string // AWS SDK for Go v2 package name
+	ProviderPackage             string
+	ProviderNameUpper           string
+	FrameworkDataSources        []ResourceDatum
+	FrameworkEphemeralResources []ResourceDatum
+	FrameworkResources          []ResourceDatum
+	SDKDataSources              map[string]ResourceDatum
+	SDKListResources            map[string]ResourceDatum
+	SDKResources                map[string]ResourceDatum
 }
 
 //go:embed file.tmpl
@@ -160,10 +172,12 @@ type visitor struct {
 	functionName string
 	packageName  string
 
-	frameworkDataSources []ResourceDatum
-	frameworkResources   []ResourceDatum
-	sdkDataSources       map[string]ResourceDatum
-	sdkResources         map[string]ResourceDatum
+	frameworkDataSources        []ResourceDatum
+	frameworkEphemeralResources []ResourceDatum
+	frameworkResources          []ResourceDatum
+	sdkDataSources              map[string]ResourceDatum
+	sdkListResources            map[string]ResourceDatum
+	sdkResources                map[string]ResourceDatum
 }
 
 // processDir scans a single service package directory and processes contained Go sources files.
@@ -201,16 +215,38 @@ func (v *visitor) processFile(file *ast.File) {
 }
 
 // processFuncDecl processes a single Go function.
-// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source.
+// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource, data source or ephemeral resource.
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
+	// Look first for tagging and identity annotations.
 	d := ResourceDatum{}
 
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Identity" {
+			args := common.ParseArgs(m[3])
+
+			if len(d.IdentityAttributes) > 0 {
+				v.err = multierror.Append(v.err, fmt.Errorf("multiple Identity annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			if len(args.Positional) == 0 {
+				v.err = multierror.Append(v.err, fmt.Errorf("no identity attributes: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			d.IdentityAttributes = args.Positional
+
+			if attr, ok := args.Keyword["global"]; ok {
+				if global, err := strconv.ParseBool(attr); err != nil {
+					v.err = multierror.Append(v.err, fmt.Errorf("invalid global value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.IdentityGlobal = global
+				}
+			}
+		}
+
 		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
 			args := common.ParseArgs(m[3])
 
@@ -249,6 +285,12 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.frameworkDataSources = append(v.frameworkDataSources, d)
 				}
+			case "FrameworkEphemeralResource":
+				if slices.ContainsFunc(v.frameworkEphemeralResources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
+					v.err = multierror.Append(v.err, fmt.Errorf("duplicate Framework Ephemeral Resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.frameworkEphemeralResources = append(v.frameworkEphemeralResources, d)
+				}
 			case "FrameworkResource":
 				if slices.ContainsFunc(v.frameworkResources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
 					v.err = multierror.Append(v.err, fmt.Errorf("duplicate Framework Resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -281,8 +323,23 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "SDKListResource":
+				if len(args.Positional) == 0 {
+					v.err = multierror.Append(v.err, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				typeName := args.Positional[0]
+
+				if _, ok := v.sdkListResources[typeName]; ok {
+					v.err = multierror.Append(v.err, fmt.Errorf("duplicate SDK List Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.sdkListResources[typeName] = d
+				}
+			case "Identity", "Tags":
 				// Handled above.
+			case "Testing":
+				// Handled by internal/generate/tagstests.
 			default:
 				v.g.Warnf("unknown annotation: %s", annotationName)
 			}
@@ -0,0 +1,1035 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"golang.org/x/tools/imports"
+)
+
+const (
+	generatedFileSuffix = "_tags_gen_test.go"
+
+	acctestImportPath  = "github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	resourceImportPath = "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func main() {
+	g := common.NewGenerator()
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating tagging tests for internal/service/%s", servicePackage)
+
+	fset := token.NewFileSet()
+
+	resources, err := taggedResources(fset, ".")
+
+	if err != nil {
+		g.Fatalf("finding tagged resources: %s", err)
+	}
+
+	tests, err := parseTests(fset, ".")
+
+	if err != nil {
+		g.Fatalf("parsing tests: %s", err)
+	}
+
+	generated := make(map[string]bool)
+
+	for _, r := range resources {
+		if r.SkipTagsTests {
+			continue
+		}
+
+		d, err := newTemplateData(fset, servicePackage, r, tests)
+
+		if err != nil {
+			g.Warnf("skipping %s: %s", r.TypeName, err)
+			continue
+		}
+
+		filename := d.filename
+
+		source, err := render(d, filename)
+
+		if err != nil {
+			g.Fatalf("generating %s: %s", filename, err)
+		}
+
+		destination := g.NewUnformattedFileDestination(filename)
+
+		if err := destination.WriteBytes(source); err != nil {
+			g.Fatalf("generating %s: %s", filename, err)
+		}
+
+		if err := destination.Write(); err != nil {
+			g.Fatalf("generating %s: %s", filename, err)
+		}
+
+		generated[filename] = true
+	}
+
+	// Remove any stale generated files.
+	stale, err := filepath.Glob("*" + generatedFileSuffix)
+
+	if err != nil {
+		g.Fatalf("listing generated files: %s", err)
+	}
+
+	for _, filename := range stale {
+		if !generated[filename] {
+			if err := os.Remove(filename); err != nil {
+				g.Fatalf("removing %s: %s", filename, err)
+			}
+		}
+	}
+}
+
+// taggedResource describes a resource annotated with @Tags.
+type taggedResource struct {
+	FactoryName   string
+	TypeName      string
+	Filename      string
+	SkipTagsTests bool
+}
+
+var (
+	annotation = regexp.MustCompile(`^//\s*@([a-zA-Z0-9]+)(\(([^)]*)\))?\s*$`)
+)
+
+// taggedResources returns the Plugin Framework and SDK resources annotated with @Tags in the service package directory.
+func taggedResources(fset *token.FileSet, path string) ([]taggedResource, error) {
+	packages, err := parser.ParseDir(fset, path, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []taggedResource
+
+	for _, pkg := range packages {
+		for filename, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				funcDecl, ok := decl.(*ast.FuncDecl)
+
+				if !ok || funcDecl.Recv != nil || funcDecl.Doc == nil {
+					continue
+				}
+
+				r := taggedResource{
+					FactoryName: funcDecl.Name.Name,
+					Filename:    filepath.Base(filename),
+				}
+				var tagged, framework bool
+
+				for _, line := range funcDecl.Doc.List {
+					m := annotation.FindStringSubmatch(line.Text)
+
+					if len(m) == 0 {
+						continue
+					}
+
+					args := common.ParseArgs(m[3])
+
+					switch m[1] {
+					case "Tags":
+						tagged = true
+					case "SDKResource":
+						if len(args.Positional) > 0 {
+							r.TypeName = args.Positional[0]
+						}
+					case "FrameworkResource":
+						framework = true
+					case "Testing":
+						if v, ok := args.Keyword["tagsTest"]; ok {
+							if b, err := strconv.ParseBool(v); err == nil {
+								r.SkipTagsTests = !b
+							}
+						}
+					}
+				}
+
+				if !tagged {
+					continue
+				}
+
+				if framework {
+					r.TypeName = frameworkTypeName(file)
+				}
+
+				if r.TypeName == "" {
+					continue
+				}
+
+				resources = append(resources, r)
+			}
+		}
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].TypeName < resources[j].TypeName
+	})
+
+	return resources, nil
+}
+
+// frameworkTypeName returns the type name set in a Plugin Framework resource's Metadata method.
+// An empty string is returned unless the file sets exactly one type name.
+func frameworkTypeName(file *ast.File) string {
+	var typeNames []string
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+
+		if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			return true
+		}
+
+		if sel, ok := assign.Lhs[0].(*ast.SelectorExpr); !ok || sel.Sel.Name != "TypeName" {
+			return true
+		}
+
+		if lit, ok := assign.Rhs[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			if v, err := strconv.Unquote(lit.Value); err == nil {
+				typeNames = append(typeNames, v)
+			}
+		}
+
+		return true
+	})
+
+	if len(typeNames) != 1 {
+		return ""
+	}
+
+	return typeNames[0]
+}
+
+// testFunc is a hand-written test function.
+type testFunc struct {
+	decl     *ast.FuncDecl
+	file     *ast.File
+	filename string
+}
+
+// testFuncs are the hand-written test functions in a service package, keyed by name.
+type testFuncs map[string]testFunc
+
+// parseTests parses the hand-written tests in the service package directory.
+func parseTests(fset *token.FileSet, path string) (testFuncs, error) {
+	packages, err := parser.ParseDir(fset, path, func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return strings.HasSuffix(name, "_test.go") && !strings.HasSuffix(name, "_gen_test.go")
+	}, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tests := make(testFuncs)
+
+	for _, pkg := range packages {
+		for filename, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv == nil {
+					tests[funcDecl.Name.Name] = testFunc{
+						decl:     funcDecl,
+						file:     file,
+						filename: filename,
+					}
+				}
+			}
+		}
+	}
+
+	return tests, nil
+}
+
+// basicTest returns the hand-written basic acceptance test for the specified resource.
+// The basic test is the one named *_basic which declares a variable holding the resource's "test" address.
+// Tests in the resource's own test file are preferred.
+func (tests testFuncs) basicTest(r taggedResource) (testFunc, string, bool) {
+	var (
+		found               testFunc
+		foundResourceName   string
+		foundName           string
+		resourceAddressLit  = strconv.Quote(r.TypeName + ".test")
+		testFilename        = strings.TrimSuffix(r.Filename, ".go") + "_test.go"
+		basicTestNameSuffix = "_basic"
+	)
+
+	for name, test := range tests {
+		if !strings.HasPrefix(name, "TestAcc") && !strings.HasPrefix(name, "testAcc") {
+			continue
+		}
+		if !strings.HasSuffix(name, basicTestNameSuffix) || strings.Contains(name, "DataSource") || strings.HasSuffix(test.filename, "_data_source_test.go") {
+			continue
+		}
+
+		var resourceName string
+
+		for _, stmt := range test.decl.Body.List {
+			if assign, ok := stmt.(*ast.AssignStmt); ok && len(assign.Lhs) == 1 && len(assign.Rhs) == 1 {
+				if lit, ok := assign.Rhs[0].(*ast.BasicLit); ok && lit.Value == resourceAddressLit {
+					if ident, ok := assign.Lhs[0].(*ast.Ident); ok {
+						resourceName = ident.Name
+					}
+				}
+			}
+		}
+
+		if resourceName == "" {
+			continue
+		}
+
+		if foundName == "" || preferTest(name, filepath.Base(test.filename) == testFilename, foundName, filepath.Base(found.filename) == testFilename) {
+			found, foundResourceName, foundName = test, resourceName, name
+		}
+	}
+
+	return found, foundResourceName, foundName != ""
+}
+
+// preferTest returns whether test a is preferred to test b.
+// Tests in the resource's own test file are preferred, then exported (parallel) tests and then the shortest name.
+func preferTest(a string, aInFile bool, b string, bInFile bool) bool {
+	if aInFile != bInFile {
+		return aInFile
+	}
+
+	if ea, eb := ast.IsExported(a), ast.IsExported(b); ea != eb {
+		return ea
+	}
+
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+
+	return a < b
+}
+
+// templateData is the data passed to the generated file's template.
+type templateData struct {
+	ServicePackage string
+	Imports        []string
+	TestFunc       string
+	Preamble       []string
+	ResourceName   string
+	TestCaseFields []string
+	Config         string
+	ExistsCheck    string
+	ImportStep     string
+	Tests          []tagsTest
+
+	filename string
+}
+
+func newTemplateData(fset *token.FileSet, servicePackage string, r taggedResource, tests testFuncs) (*templateData, error) {
+	basic, resourceName, ok := tests.basicTest(r)
+
+	if !ok {
+		return nil, fmt.Errorf("no basic acceptance test found")
+	}
+
+	name := basic.decl.Name.Name
+
+	if !ast.IsExported(name) {
+		return nil, fmt.Errorf("%s is a serialized test", name)
+	}
+
+	d := &templateData{
+		ServicePackage: servicePackage,
+		ResourceName:   resourceName,
+		filename:       strings.TrimSuffix(filepath.Base(basic.filename), "_test.go") + generatedFileSuffix,
+	}
+
+	// Imports.
+	importNames := make(map[string]string)
+
+	for _, spec := range basic.file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		localName := filepath.Base(path)
+
+		if spec.Name != nil {
+			localName = spec.Name.Name
+		}
+
+		importNames[localName] = path
+
+		if spec.Name != nil {
+			d.Imports = append(d.Imports, fmt.Sprintf("%s %q", spec.Name.Name, path))
+		} else {
+			d.Imports = append(d.Imports, strconv.Quote(path))
+		}
+	}
+
+	if importNames["acctest"] != acctestImportPath || importNames["resource"] != resourceImportPath {
+		return nil, fmt.Errorf("%s does not import acctest and resource", basic.filename)
+	}
+
+	// Locate the resource.Test or resource.ParallelTest call.
+	var (
+		call     *ast.CallExpr
+		preamble []ast.Stmt
+	)
+
+	for i, stmt := range basic.decl.Body.List {
+		if expr, ok := stmt.(*ast.ExprStmt); ok {
+			if c, ok := expr.X.(*ast.CallExpr); ok && isResourceTestCall(c) {
+				call, preamble = c, basic.decl.Body.List[:i]
+				break
+			}
+		}
+	}
+
+	if call == nil || len(call.Args) != 2 {
+		return nil, fmt.Errorf("%s does not call resource.ParallelTest", name)
+	}
+
+	d.TestFunc = nodeString(fset, call.Fun)
+
+	testCase, ok := call.Args[1].(*ast.CompositeLit)
+
+	if !ok {
+		return nil, fmt.Errorf("%s: test case is not a composite literal", name)
+	}
+
+	var steps *ast.CompositeLit
+	var used []ast.Node
+
+	for _, elt := range testCase.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+
+		if !ok {
+			return nil, fmt.Errorf("%s: unexpected test case element", name)
+		}
+
+		if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "Steps" {
+			steps, _ = kv.Value.(*ast.CompositeLit)
+			continue
+		}
+
+		d.TestCaseFields = append(d.TestCaseFields, nodeString(fset, kv))
+		used = append(used, kv)
+	}
+
+	if steps == nil || len(steps.Elts) == 0 {
+		return nil, fmt.Errorf("%s: no test steps", name)
+	}
+
+	// The first step provides the configuration and existence check.
+	first, ok := steps.Elts[0].(*ast.CompositeLit)
+
+	if !ok {
+		return nil, fmt.Errorf("%s: unexpected test step", name)
+	}
+
+	if v := compositeField(first, "Config"); v != nil {
+		d.Config = nodeString(fset, v)
+		used = append(used, v)
+	} else {
+		return nil, fmt.Errorf("%s: first test step has no Config", name)
+	}
+
+	if v := compositeField(first, "Check"); v != nil {
+		ast.Inspect(v, func(n ast.Node) bool {
+			if d.ExistsCheck != "" {
+				return false
+			}
+
+			if c, ok := n.(*ast.CallExpr); ok && isExistsCheck(c, resourceName) {
+				d.ExistsCheck = nodeString(fset, c)
+				used = append(used, c)
+				return false
+			}
+
+			return true
+		})
+	}
+
+	if d.ExistsCheck == "" {
+		return nil, fmt.Errorf("%s: no existence check", name)
+	}
+
+	// The first import step, without any configuration, is reused.
+	for _, elt := range steps.Elts[1:] {
+		step, ok := elt.(*ast.CompositeLit)
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := compositeField(step, "ImportState").(*ast.Ident); !ok || v.Name != "true" {
+			continue
+		}
+
+		var fields []string
+
+		for _, elt := range step.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "Config" {
+					continue
+				}
+			}
+
+			fields = append(fields, nodeString(fset, elt)+",")
+			used = append(used, elt)
+		}
+
+		d.ImportStep = strings.Join(fields, "\n")
+
+		break
+	}
+
+	// Keep only the preamble statements needed by the copied code.
+	stmts, err := prunePreamble(preamble, used, resourceName)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	for _, stmt := range stmts {
+		d.Preamble = append(d.Preamble, nodeString(fset, stmt))
+	}
+
+	// Don't generate tests which would clash with hand-written ones.
+	prefix := strings.TrimSuffix(name, "_basic")
+
+	for _, t := range tagsTests(d.ImportStep != "") {
+		t.Name = prefix + t.Name
+
+		if _, ok := tests[t.Name]; ok {
+			continue
+		}
+
+		d.Tests = append(d.Tests, t)
+	}
+
+	if len(d.Tests) == 0 {
+		return nil, fmt.Errorf("all tagging tests are hand-written")
+	}
+
+	return d, nil
+}
+
+func isResourceTestCall(c *ast.CallExpr) bool {
+	sel, ok := c.Fun.(*ast.SelectorExpr)
+
+	if !ok {
+		return false
+	}
+
+	x, ok := sel.X.(*ast.Ident)
+
+	return ok && x.Name == "resource" && (sel.Sel.Name == "ParallelTest" || sel.Sel.Name == "Test")
+}
+
+// isExistsCheck returns whether the call is an existence check of the resource whose address is in variable resourceName.
+func isExistsCheck(c *ast.CallExpr, resourceName string) bool {
+	var name string
+
+	switch fun := c.Fun.(type) {
+	case *ast.Ident:
+		name = fun.Name
+	case *ast.SelectorExpr:
+		name = fun.Sel.Name
+	}
+
+	if !strings.HasSuffix(name, "Exists") || !(strings.HasPrefix(name, "testAccCheck") || strings.HasPrefix(name, "Check")) {
+		return false
+	}
+
+	for _, arg := range c.Args {
+		if ident, ok := arg.(*ast.Ident); ok && ident.Name == resourceName {
+			return true
+		}
+	}
+
+	return false
+}
+
+func compositeField(lit *ast.CompositeLit, name string) ast.Expr {
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			if key, ok := kv.Key.(*ast.Ident); ok && key.Name == name {
+				return kv.Value
+			}
+		}
+	}
+
+	return nil
+}
+
+// prunePreamble returns the statements preceding the test case that declare identifiers used by the copied code.
+// Statements that declare nothing (e.g. skips) are kept unless they assign to variables that are otherwise unused.
+func prunePreamble(preamble []ast.Stmt, used []ast.Node, resourceName string) ([]ast.Stmt, error) {
+	reads := map[string]bool{
+		resourceName: true,
+	}
+
+	for _, n := range used {
+		addReads(reads, n)
+	}
+
+	var kept []ast.Stmt
+
+	for i := len(preamble) - 1; i >= 0; i-- {
+		stmt := preamble[i]
+		declared := declaredNames(stmt)
+
+		var keep, unused bool
+
+		if len(declared) == 0 {
+			keep = true
+
+			for _, name := range assignedNames(stmt) {
+				if !reads[name] {
+					keep = false
+				}
+			}
+		}
+
+		for _, name := range declared {
+			if reads[name] {
+				keep = true
+			} else if name != "_" {
+				unused = true
+			}
+		}
+
+		if !keep {
+			continue
+		}
+
+		if unused {
+			return nil, fmt.Errorf("preamble statement declares unused variables")
+		}
+
+		addReads(reads, stmt)
+		kept = append([]ast.Stmt{stmt}, kept...)
+	}
+
+	return kept, nil
+}
+
+// addReads adds the identifiers referred to by n, other than as the target of an assignment, to reads.
+func addReads(reads map[string]bool, n ast.Node) {
+	assigned := make(map[*ast.Ident]bool)
+
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if n.Tok != token.DEFINE {
+				for _, expr := range n.Lhs {
+					if ident, ok := expr.(*ast.Ident); ok {
+						assigned[ident] = true
+					}
+				}
+			}
+		case *ast.Ident:
+			if !assigned[n] {
+				reads[n.Name] = true
+			}
+		}
+
+		return true
+	})
+}
+
+// assignedNames returns the names of the variables assigned to (but not declared) by stmt.
+func assignedNames(stmt ast.Stmt) []string {
+	var names []string
+
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		if assign, ok := n.(*ast.AssignStmt); ok && assign.Tok != token.DEFINE {
+			for _, expr := range assign.Lhs {
+				if ident, ok := expr.(*ast.Ident); ok {
+					names = append(names, ident.Name)
+				}
+			}
+		}
+
+		return true
+	})
+
+	return names
+}
+
+func declaredNames(stmt ast.Stmt) []string {
+	var names []string
+
+	switch stmt := stmt.(type) {
+	case *ast.AssignStmt:
+		if stmt.Tok == token.DEFINE {
+			for _, expr := range stmt.Lhs {
+				if ident, ok := expr.(*ast.Ident); ok {
+					names = append(names, ident.Name)
+				}
+			}
+		}
+	case *ast.DeclStmt:
+		if genDecl, ok := stmt.Decl.(*ast.GenDecl); ok {
+			for _, spec := range genDecl.Specs {
+				if spec, ok := spec.(*ast.ValueSpec); ok {
+					for _, ident := range spec.Names {
+						names = append(names, ident.Name)
+					}
+				}
+			}
+		}
+	}
+
+	return names
+}
+
+func nodeString(fset *token.FileSet, n ast.Node) string {
+	var buf bytes.Buffer
+
+	if err := printer.Fprint(&buf, fset, n); err != nil {
+		panic(err)
+	}
+
+	return buf.String()
+}
+
+//go:embed file.tmpl
+var tmpl string
+
+func render(d *templateData, filename string) ([]byte, error) {
+	t, err := template.New("tagstests").Funcs(template.FuncMap{
+		"quote": strconv.Quote,
+	}).Parse(tmpl)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing template: %w", err)
+	}
+
+	var buf bytes.Buffer
+
+	if err := t.Execute(&buf, d); err != nil {
+		return nil, fmt.Errorf("executing template: %w", err)
+	}
+
+	// Remove any imports the copied code doesn't use.
+	source, err := imports.Process(filename, buf.Bytes(), &imports.Options{
+		Comments:  true,
+		TabIndent: true,
+		TabWidth:  8,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("formatting:\n%s\n%w", buf.String(), err)
+	}
+
+	return source, nil
+}
+
+// tagsTest is a generated tagging acceptance test.
+type tagsTest struct {
+	Name  string
+	Steps []tagsStep
+}
+
+// tagsStep is a step in a generated tagging acceptance test.
+type tagsStep struct {
+	// Provider default tags as key/value pairs.
+	DefaultTags []string
+	// Provider ignored tag key.
+	IgnoreTagsKey string
+	// Resource tags as key/value pairs. A nil value removes any tags from the basic configuration.
+	ResourceTags []string
+	// Expected tags and tags_all as key/value pairs.
+	Tags, TagsAll []string
+	// Whether to skip checking tags.
+	SkipTagsCheck      bool
+	ExpectNonEmptyPlan bool
+	Import             bool
+}
+
+// Config returns the step's configuration expression built around the basic configuration.
+func (s tagsStep) Config(basicConfig, resourceName string) string {
+	config := fmt.Sprintf("acctest.ConfigResourceTags(t, %s, %s, %s)", basicConfig, resourceName, goStringMap(s.ResourceTags))
+
+	var provider string
+
+	switch {
+	case s.IgnoreTagsKey != "" && len(s.DefaultTags) == 2 && s.DefaultTags[0] == s.IgnoreTagsKey:
+		provider = fmt.Sprintf("acctest.ConfigDefaultAndIgnoreTagsKeys1(%q, %q)", s.DefaultTags[0], s.DefaultTags[1])
+	case s.IgnoreTagsKey != "":
+		provider = fmt.Sprintf("acctest.ConfigIgnoreTagsKeys(%q)", s.IgnoreTagsKey)
+	case len(s.DefaultTags) == 2:
+		provider = fmt.Sprintf("acctest.ConfigDefaultTags_Tags1(%q, %q)", s.DefaultTags[0], s.DefaultTags[1])
+	case len(s.DefaultTags) == 4:
+		provider = fmt.Sprintf("acctest.ConfigDefaultTags_Tags2(%q, %q, %q, %q)", s.DefaultTags[0], s.DefaultTags[1], s.DefaultTags[2], s.DefaultTags[3])
+	}
+
+	if provider == "" {
+		return config
+	}
+
+	return fmt.Sprintf("acctest.ConfigCompose(\n%s,\n%s,\n)", provider, config)
+}
+
+// Checks returns the step's tags and tags_all checks.
+func (s tagsStep) Checks(resourceName string) []string {
+	var checks []string
+
+	add := func(attr string, kvs []string) {
+		checks = append(checks, fmt.Sprintf("resource.TestCheckResourceAttr(%s, %q, %q)", resourceName, attr+".%", strconv.Itoa(len(kvs)/2)))
+
+		for i := 0; i < len(kvs); i += 2 {
+			checks = append(checks, fmt.Sprintf("resource.TestCheckResourceAttr(%s, %q, %q)", resourceName, attr+"."+kvs[i], kvs[i+1]))
+		}
+	}
+
+	if !s.SkipTagsCheck {
+		add("tags", s.Tags)
+	}
+	add("tags_all", s.TagsAll)
+
+	return checks
+}
+
+func goStringMap(kvs []string) string {
+	if kvs == nil {
+		return "nil"
+	}
+
+	var b strings.Builder
+
+	b.WriteString("map[string]string{\n")
+
+	for i := 0; i < len(kvs); i += 2 {
+		fmt.Fprintf(&b, "%q: %q,\n", kvs[i], kvs[i+1])
+	}
+
+	b.WriteString("}")
+
+	return b.String()
+}
+
+// tagsTests returns the standard matrix of tagging acceptance tests.
+// Test names are relative to the basic test's name prefix.
+func tagsTests(importable bool) []tagsTest {
+	return []tagsTest{
+		{
+			Name: "_tags",
+			Steps: []tagsStep{
+				{
+					ResourceTags: []string{"key1", "value1"},
+					Tags:         []string{"key1", "value1"},
+					TagsAll:      []string{"key1", "value1"},
+					Import:       importable,
+				},
+				{
+					ResourceTags: []string{"key1", "value1updated", "key2", "value2"},
+					Tags:         []string{"key1", "value1updated", "key2", "value2"},
+					TagsAll:      []string{"key1", "value1updated", "key2", "value2"},
+				},
+				{
+					ResourceTags: []string{"key2", "value2"},
+					Tags:         []string{"key2", "value2"},
+					TagsAll:      []string{"key2", "value2"},
+				},
+				{},
+			},
+		},
+		{
+			Name: "_tags_EmptyMap",
+			Steps: []tagsStep{
+				{
+					ResourceTags: []string{},
+				},
+			},
+		},
+		{
+			Name: "_tags_AddOnUpdate",
+			Steps: []tagsStep{
+				{},
+				{
+					ResourceTags: []string{"key1", "value1"},
+					Tags:         []string{"key1", "value1"},
+					TagsAll:      []string{"key1", "value1"},
+				},
+			},
+		},
+		{
+			Name: "_tags_EmptyTag_OnCreate",
+			Steps: []tagsStep{
+				{
+					ResourceTags: []string{"key1", ""},
+					Tags:         []string{"key1", ""},
+					TagsAll:      []string{"key1", ""},
+					Import:       importable,
+				},
+				{},
+			},
+		},
+		{
+			Name: "_tags_EmptyTag_OnUpdate_Add",
+			Steps: []tagsStep{
+				{
+					ResourceTags: []string{"key1", "value1"},
+					Tags:         []string{"key1", "value1"},
+					TagsAll:      []string{"key1", "value1"},
+				},
+				{
+					ResourceTags: []string{"key1", "value1", "key2", ""},
+					Tags:         []string{"key1", "value1", "key2", ""},
+					TagsAll:      []string{"key1", "value1", "key2", ""},
+				},
+				{
+					ResourceTags: []string{"key1", "value1"},
+					Tags:         []string{"key1", "value1"},
+					TagsAll:      []string{"key1", "value1"},
+				},
+			},
+		},
+		{
+			Name: "_tags_DefaultTags_providerOnly",
+			Steps: []tagsStep{
+				{
+					DefaultTags: []string{"providerkey1", "providervalue1"},
+					TagsAll:     []string{"providerkey1", "providervalue1"},
+					Import:      importable,
+				},
+				{
+					DefaultTags: []string{"providerkey1", "providervalue1", "providerkey2", "providervalue2"},
+					TagsAll:     []string{"providerkey1", "providervalue1", "providerkey2", "providervalue2"},
+				},
+				{
+					DefaultTags: []string{"providerkey1", "providervalue1updated"},
+					TagsAll:     []string{"providerkey1", "providervalue1updated"},
+				},
+				{},
+			},
+		},
+		{
+			Name: "_tags_DefaultTags_nonOverlapping",
+			Steps: []tagsStep{
+				{
+					DefaultTags:  []string{"providerkey1", "providervalue1"},
+					ResourceTags: []string{"resourcekey1", "resourcevalue1"},
+					Tags:         []string{"resourcekey1", "resourcevalue1"},
+					TagsAll:      []string{"providerkey1", "providervalue1", "resourcekey1", "resourcevalue1"},
+				},
+				{
+					DefaultTags:  []string{"providerkey1", "providervalue1updated"},
+					ResourceTags: []string{"resourcekey1", "resourcevalue1updated", "resourcekey2", "resourcevalue2"},
+					Tags:         []string{"resourcekey1", "resourcevalue1updated", "resourcekey2", "resourcevalue2"},
+					TagsAll:      []string{"providerkey1", "providervalue1updated", "resourcekey1", "resourcevalue1updated", "resourcekey2", "resourcevalue2"},
+				},
+				{},
+			},
+		},
+		{
+			Name: "_tags_DefaultTags_overlapping",
+			Steps: []tagsStep{
+				{
+					DefaultTags:  []string{"overlapkey1", "providervalue1"},
+					ResourceTags: []string{"overlapkey1", "resourcevalue1"},
+					Tags:         []string{"overlapkey1", "resourcevalue1"},
+					TagsAll:      []string{"overlapkey1", "resourcevalue1"},
+				},
+				{
+					DefaultTags:  []string{"overlapkey1", "providervalue1", "overlapkey2", "providervalue2"},
+					ResourceTags: []string{"overlapkey1", "resourcevalue1", "overlapkey2", "resourcevalue2"},
+					Tags:         []string{"overlapkey1", "resourcevalue1", "overlapkey2", "resourcevalue2"},
+					TagsAll:      []string{"overlapkey1", "resourcevalue1", "overlapkey2", "resourcevalue2"},
+				},
+				{
+					DefaultTags:  []string{"overlapkey1", "providervalue1"},
+					ResourceTags: []string{"overlapkey1", "resourcevalue2"},
+					Tags:         []string{"overlapkey1", "resourcevalue2"},
+					TagsAll:      []string{"overlapkey1", "resourcevalue2"},
+				},
+			},
+		},
+		{
+			Name: "_tags_DefaultTags_updateToProviderOnly",
+			Steps: []tagsStep{
+				{
+					ResourceTags: []string{"key1", "value1"},
+					Tags:         []string{"key1", "value1"},
+					TagsAll:      []string{"key1", "value1"},
+				},
+				{
+					DefaultTags: []string{"key1", "value1"},
+					TagsAll:     []string{"key1", "value1"},
+				},
+			},
+		},
+		{
+			Name: "_tags_DefaultTags_updateToResourceOnly",
+			Steps: []tagsStep{
+				{
+					DefaultTags: []string{"key1", "value1"},
+					TagsAll:     []string{"key1", "value1"},
+				},
+				{
+					ResourceTags: []string{"key1", "value1"},
+					Tags:         []string{"key1", "value1"},
+					TagsAll:      []string{"key1", "value1"},
+				},
+			},
+		},
+		{
+			Name: "_tags_DefaultTags_emptyResourceTag",
+			Steps: []tagsStep{
+				{
+					DefaultTags:  []string{"providerkey1", "providervalue1"},
+					ResourceTags: []string{"key1", ""},
+					Tags:         []string{"key1", ""},
+					TagsAll:      []string{"key1", "", "providerkey1", "providervalue1"},
+				},
+			},
+		},
+		{
+			Name: "_tags_IgnoreTags_Overlap_DefaultTag",
+			Steps: []tagsStep{
+				{
+					DefaultTags:   []string{"providerkey1", "providervalue1"},
+					IgnoreTagsKey: "providerkey1",
+					ResourceTags:  []string{"resourcekey1", "resourcevalue1"},
+					Tags:          []string{"resourcekey1", "resourcevalue1"},
+					TagsAll:       []string{"resourcekey1", "resourcevalue1"},
+				},
+			},
+		},
+		{
+			// The ignored tag is removed from tags in state, so the configuration never converges.
+			Name: "_tags_IgnoreTags_Overlap_ResourceTag",
+			Steps: []tagsStep{
+				{
+					IgnoreTagsKey:      "resourcekey1",
+					ResourceTags:       []string{"resourcekey1", "resourcevalue1", "resourcekey2", "resourcevalue2"},
+					TagsAll:            []string{"resourcekey2", "resourcevalue2"},
+					SkipTagsCheck:      true,
+					ExpectNonEmptyPlan: true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,472 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package iampolicy analyzes IAM-style JSON policy documents at plan time.
+//
+// The analysis catches problems that would otherwise only be reported by AWS at apply time, or not at all:
+// grammar (policy elements and their value types), unknown action service prefixes, malformed ARNs,
+// unknown condition operators and document size limits.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_grammar.html.
+package iampolicy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// DocumentType is the type of a policy document, which determines the policy elements that are allowed and required.
+type DocumentType int
+
+const (
+	// IdentityBasedPolicy is a policy attached to an IAM identity, e.g. aws_iam_policy. Principal is not allowed.
+	IdentityBasedPolicy DocumentType = iota
+	// ResourceBasedPolicy is a policy attached to a resource, e.g. an S3 bucket policy. Principal is required.
+	ResourceBasedPolicy
+	// TrustPolicy is an IAM role's trust (assume role) policy. Principal is required and Resource is not allowed.
+	TrustPolicy
+)
+
+// Options configures the analysis of a policy document.
+type Options struct {
+	Type DocumentType
+	// MaxSize is the maximum size of the document in characters, excluding whitespace.
+	// Zero means no limit.
+	MaxSize int
+	// WarnFullAccess enables warnings for statements allowing all actions on all resources.
+	WarnFullAccess bool
+}
+
+// Finding is a problem found in a policy document.
+type Finding struct {
+	Severity diag.Severity
+	// Path is the location of the problem in the document, e.g. "Statement[0].Action[1]".
+	// It is empty for problems with the document as a whole.
+	Path    string
+	Message string
+}
+
+func (f Finding) String() string {
+	if f.Path == "" {
+		return f.Message
+	}
+
+	return fmt.Sprintf("%s: %s", f.Path, f.Message)
+}
+
+var (
+	policyElements    = []string{"Version", "Id", "Statement"}
+	statementElements = []string{"Sid", "Effect", "Principal", "NotPrincipal", "Action", "NotAction", "Resource", "NotResource", "Condition"}
+	principalTypes    = []string{"AWS", "CanonicalUser", "Federated", "Service"}
+	policyVersions    = []string{"2008-10-17", "2012-10-17"}
+
+	actionNameRegexp     = regexp.MustCompile(`^[A-Za-z0-9*?_-]+$`)
+	actionPrefixRegexp   = regexp.MustCompile(`^[a-z0-9*?-]+$`)
+	accountIDRegexp      = regexp.MustCompile(`^\d{12}$`)
+	iamSidRegexp         = regexp.MustCompile(`^[A-Za-z0-9]*$`)
+	policyVariableRegexp = regexp.MustCompile(`\$\{[^}]*\}`)
+	uniqueIDRegexp       = regexp.MustCompile(`^A[A-Z0-9]{15,127}$`)
+)
+
+// Analyze returns the problems found in the specified policy document.
+func Analyze(document string, options Options) []Finding {
+	a := &analyzer{
+		options: options,
+		sids:    make(map[string]string),
+	}
+
+	a.analyze(document)
+
+	return a.findings
+}
+
+type analyzer struct {
+	options  Options
+	findings []Finding
+	sids     map[string]string
+}
+
+func (a *analyzer) errorf(path, format string, args ...any) {
+	a.findings = append(a.findings, Finding{Severity: diag.Error, Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (a *analyzer) warnf(path, format string, args ...any) {
+	a.findings = append(a.findings, Finding{Severity: diag.Warning, Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (a *analyzer) analyze(document string) {
+	var compact bytes.Buffer
+
+	if err := json.Compact(&compact, []byte(document)); err != nil {
+		a.errorf("", "invalid JSON: %s", err)
+		return
+	}
+
+	if maxSize := a.options.MaxSize; maxSize > 0 && compact.Len() > maxSize {
+		a.errorf("", "size (%d characters excluding whitespace) exceeds the maximum of %d", compact.Len(), maxSize)
+	}
+
+	var policy any
+	decoder := json.NewDecoder(&compact)
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&policy); err != nil {
+		a.errorf("", "invalid JSON: %s", err)
+		return
+	}
+
+	m, ok := policy.(map[string]any)
+	if !ok {
+		var hint string
+		// There are some common mistakes that lead to strings appearing here instead of objects.
+		if s, ok := policy.(string); ok {
+			if strings.HasSuffix(s, ".json") {
+				hint = " (have you passed a JSON-encoded filename instead of the content of that file?)"
+			} else if json.Valid([]byte(s)) {
+				hint = " (have you double-encoded your JSON data?)"
+			}
+		}
+		a.errorf("", "must be a JSON object%s", hint)
+		return
+	}
+
+	a.checkElements("", m, policyElements)
+
+	if v, ok := m["Version"]; ok {
+		if s, ok := v.(string); !ok || !slices.Contains(policyVersions, s) {
+			a.errorf("Version", "must be one of %s", quoteAll(policyVersions))
+		}
+	}
+
+	if v, ok := m["Id"]; ok {
+		if _, ok := v.(string); !ok {
+			a.errorf("Id", "must be a string")
+		}
+	}
+
+	switch v := m["Statement"].(type) {
+	case nil:
+		a.errorf("", "Statement is required")
+	case map[string]any:
+		a.checkStatement("Statement", v)
+	case []any:
+		if len(v) == 0 {
+			a.errorf("Statement", "must contain at least one statement")
+		}
+		for i, v := range v {
+			path := fmt.Sprintf("Statement[%d]", i)
+
+			if v, ok := v.(map[string]any); ok {
+				a.checkStatement(path, v)
+			} else {
+				a.errorf(path, "must be a JSON object")
+			}
+		}
+	default:
+		a.errorf("Statement", "must be a JSON object or array")
+	}
+}
+
+// checkElements reports any element of m that isn't one of the specified names.
+func (a *analyzer) checkElements(path string, m map[string]any, names []string) {
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		if slices.Contains(names, k) {
+			continue
+		}
+
+		if name, ok := containsFold(names, k); ok {
+			a.errorf(join(path, k), "unknown element (did you mean %q?)", name)
+		} else {
+			a.errorf(join(path, k), "unknown element, expected one of %s", quoteAll(names))
+		}
+	}
+}
+
+func (a *analyzer) checkStatement(path string, statement map[string]any) {
+	a.checkElements(path, statement, statementElements)
+
+	if v, ok := statement["Sid"]; ok {
+		sidPath := join(path, "Sid")
+
+		if sid, ok := v.(string); !ok {
+			a.errorf(sidPath, "must be a string")
+		} else if a.options.Type != ResourceBasedPolicy {
+			// IAM policy statement IDs are alphanumeric and must be unique.
+			if !iamSidRegexp.MatchString(sid) {
+				a.errorf(sidPath, "%q must only contain alphanumeric characters", sid)
+			}
+			if other, ok := a.sids[sid]; ok && sid != "" {
+				a.errorf(sidPath, "duplicate statement ID %q (also used by %s)", sid, other)
+			}
+			a.sids[sid] = path
+		}
+	}
+
+	switch v, ok := statement["Effect"]; {
+	case !ok:
+		a.errorf(path, "Effect is required")
+	case v != "Allow" && v != "Deny":
+		a.errorf(join(path, "Effect"), `must be "Allow" or "Deny"`)
+	}
+
+	if name, v := a.exactlyOne(path, statement, "Action", "NotAction"); v != nil {
+		for _, e := range a.stringOrList(join(path, name), v) {
+			a.checkAction(e.path, e.value)
+		}
+	}
+
+	if a.options.Type == TrustPolicy {
+		for _, name := range []string{"Resource", "NotResource"} {
+			if _, ok := statement[name]; ok {
+				a.errorf(join(path, name), "not allowed in a trust policy")
+			}
+		}
+	} else if name, v := a.exactlyOne(path, statement, "Resource", "NotResource"); v != nil {
+		for _, e := range a.stringOrList(join(path, name), v) {
+			a.checkResource(e.path, e.value)
+		}
+	}
+
+	if a.options.Type == IdentityBasedPolicy {
+		for _, name := range []string{"Principal", "NotPrincipal"} {
+			if _, ok := statement[name]; ok {
+				a.errorf(join(path, name), "not allowed in an identity-based policy")
+			}
+		}
+	} else if name, v := a.exactlyOne(path, statement, "Principal", "NotPrincipal"); v != nil {
+		a.checkPrincipal(join(path, name), v)
+	}
+
+	if v, ok := statement["Condition"]; ok {
+		a.checkCondition(join(path, "Condition"), v)
+	}
+
+	if a.options.WarnFullAccess && statement["Effect"] == "Allow" && allowsAll(statement["Action"]) && (a.options.Type == TrustPolicy || allowsAll(statement["Resource"])) {
+		a.warnf(path, "allows all actions (*:*) on all resources")
+	}
+}
+
+// exactlyOne checks that exactly one of the specified elements is present in a statement.
+// The name and value of the element present are returned.
+func (a *analyzer) exactlyOne(path string, statement map[string]any, name, notName string) (string, any) {
+	v, ok := statement[name]
+	notV, notOK := statement[notName]
+
+	switch {
+	case ok && notOK:
+		a.errorf(path, "only one of %s or %s is allowed", name, notName)
+		return "", nil
+	case ok:
+		return name, v
+	case notOK:
+		return notName, notV
+	default:
+		a.errorf(path, "%s or %s is required", name, notName)
+		return "", nil
+	}
+}
+
+type element struct {
+	path  string
+	value string
+}
+
+// stringOrList returns the strings in a string or list of strings value.
+func (a *analyzer) stringOrList(path string, v any) []element {
+	switch v := v.(type) {
+	case string:
+		return []element{{path: path, value: v}}
+	case []any:
+		if len(v) == 0 {
+			a.errorf(path, "must not be empty")
+		}
+
+		var elements []element
+		for i, v := range v {
+			elementPath := fmt.Sprintf("%s[%d]", path, i)
+
+			if s, ok := v.(string); ok {
+				elements = append(elements, element{path: elementPath, value: s})
+			} else {
+				a.errorf(elementPath, "must be a string")
+			}
+		}
+
+		return elements
+	default:
+		a.errorf(path, "must be a string or list of strings")
+		return nil
+	}
+}
+
+func (a *analyzer) checkAction(path, action string) {
+	if action == "*" {
+		return
+	}
+
+	prefix, name, ok := strings.Cut(action, ":")
+	if !ok {
+		a.errorf(path, `%q is not a valid action, expected "<service>:<action>"`, action)
+		return
+	}
+
+	prefix = strings.ToLower(prefix)
+
+	if !actionPrefixRegexp.MatchString(prefix) {
+		a.errorf(path, "%q is not a valid action: invalid service prefix", action)
+		return
+	}
+
+	if !actionNameRegexp.MatchString(name) {
+		a.errorf(path, "%q is not a valid action: invalid action name", action)
+		return
+	}
+
+	if !strings.ContainsAny(prefix, "*?") && !IsKnownServicePrefix(prefix) {
+		a.warnf(path, "%q has an unknown service prefix %q", action, prefix)
+	}
+}
+
+func (a *analyzer) checkResource(path, resource string) {
+	if resource == "*" {
+		return
+	}
+
+	if !isARN(resource) {
+		a.errorf(path, `%q is not a valid ARN, expected "arn:<partition>:<service>:<region>:<account>:<resource>" or "*"`, resource)
+	}
+}
+
+func (a *analyzer) checkPrincipal(path string, v any) {
+	switch v := v.(type) {
+	case string:
+		if v != "*" {
+			a.errorf(path, `must be "*" or a JSON object`)
+		}
+	case map[string]any:
+		a.checkElements(path, v, principalTypes)
+
+		for _, typ := range slices.Sorted(maps.Keys(v)) {
+			if !slices.Contains(principalTypes, typ) {
+				continue
+			}
+
+			for _, e := range a.stringOrList(join(path, typ), v[typ]) {
+				elementPath, principal := e.path, e.value
+
+				if principal == "" {
+					a.errorf(elementPath, "must not be empty")
+					continue
+				}
+
+				if typ != "AWS" || principal == "*" || accountIDRegexp.MatchString(principal) || uniqueIDRegexp.MatchString(principal) {
+					continue
+				}
+
+				if !isARN(principal) {
+					a.errorf(elementPath, `%q is not a valid AWS principal, expected "*", an account ID or an ARN`, principal)
+				}
+			}
+		}
+	default:
+		a.errorf(path, `must be "*" or a JSON object`)
+	}
+}
+
+func (a *analyzer) checkCondition(path string, v any) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		a.errorf(path, "must be a JSON object")
+		return
+	}
+
+	for _, operator := range slices.Sorted(maps.Keys(m)) {
+		operatorPath := join(path, operator)
+		v := m[operator]
+
+		if !isConditionOperator(operator) {
+			a.errorf(operatorPath, "unknown condition operator %q", operator)
+		}
+
+		m, ok := v.(map[string]any)
+		if !ok {
+			a.errorf(operatorPath, "must be a JSON object")
+			continue
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(m)) {
+			keyPath := join(operatorPath, key)
+			v := m[key]
+
+			values, ok := v.([]any)
+			if !ok {
+				values = []any{v}
+			}
+
+			for _, v := range values {
+				switch v.(type) {
+				case string, bool, json.Number:
+				default:
+					a.errorf(keyPath, "must be a string, number, boolean or list of those")
+				}
+			}
+		}
+	}
+}
+
+// isARN returns whether the specified value has the shape of an ARN, allowing wildcards and policy variables.
+func isARN(s string) bool {
+	parts := strings.SplitN(policyVariableRegexp.ReplaceAllString(s, "x"), ":", 6)
+
+	return len(parts) == 6 && parts[0] == "arn" && parts[1] != "" && parts[2] != "" && parts[5] != ""
+}
+
+// allowsAll returns whether an Action or Resource element value matches everything.
+func allowsAll(v any) bool {
+	values, ok := v.([]any)
+	if !ok {
+		values = []any{v}
+	}
+
+	for _, v := range values {
+		if v == "*" || v == "*:*" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+func containsFold(values []string, s string) (string, bool) {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return v, true
+		}
+	}
+
+	return "", false
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestAnalyze(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		document string
+		options  Options
+		expected []Finding
+	}{
+		{
+			name: "valid identity-based policy",
+			document: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "ReadObjects",
+    "Effect": "Allow",
+    "Action": ["s3:GetObject", "s3:List*"],
+    "Resource": ["arn:aws:s3:::example/${aws:username}/*", "arn:aws:s3:::example"],
+    "Condition": {
+      "StringEqualsIfExists": {"aws:RequestedRegion": "us-west-2"},
+      "ForAnyValue:StringLike": {"s3:prefix": ["home/", "home/*"]},
+      "Bool": {"aws:SecureTransport": true}
+    }
+  }]
+}`,
+		},
+		{
+			name:     "valid resource-based policy",
+			document: `{"Statement": {"Effect": "Allow", "Principal": {"AWS": ["123456789012", "arn:aws:iam::123456789012:root"], "Service": "sns.amazonaws.com"}, "Action": "sqs:SendMessage", "Resource": "*"}}`,
+			options:  Options{Type: ResourceBasedPolicy},
+		},
+		{
+			name:     "valid trust policy",
+			document: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Principal": {"Service": "ec2.amazonaws.com"}, "Action": "sts:AssumeRole"}]}`,
+			options:  Options{Type: TrustPolicy},
+		},
+		{
+			name:     "invalid JSON",
+			document: `{"Statement": [}`,
+			expected: []Finding{
+				{Severity: diag.Error, Message: "invalid JSON: invalid character '}' looking for beginning of value"},
+			},
+		},
+		{
+			name:     "double-encoded",
+			document: `"{\"Statement\": []}"`,
+			expected: []Finding{
+				{Severity: diag.Error, Message: "must be a JSON object (have you double-encoded your JSON data?)"},
+			},
+		},
+		{
+			name:     "too large",
+			document: `{"Statement": {"Effect": "Allow", "Action": "*", "Resource": "*"}}`,
+			options:  Options{MaxSize: 32},
+			expected: []Finding{
+				{Severity: diag.Error, Message: "size (60 characters excluding whitespace) exceeds the maximum of 32"},
+			},
+		},
+		{
+			name:     "grammar",
+			document: `{"version": "2012-10-17", "Statement": [{"Effect": "allow", "Action": "s3:GetObject", "NotAction": "s3:PutObject", "Resource": 42}, "x"]}`,
+			expected: []Finding{
+				{Severity: diag.Error, Path: "version", Message: `unknown element (did you mean "Version"?)`},
+				{Severity: diag.Error, Path: "Statement[0].Effect", Message: `must be "Allow" or "Deny"`},
+				{Severity: diag.Error, Path: "Statement[0]", Message: "only one of Action or NotAction is allowed"},
+				{Severity: diag.Error, Path: "Statement[0].Resource", Message: "must be a string or list of strings"},
+				{Severity: diag.Error, Path: "Statement[1]", Message: "must be a JSON object"},
+			},
+		},
+		{
+			name:     "missing statement",
+			document: `{"Version": "2012-10-17"}`,
+			expected: []Finding{
+				{Severity: diag.Error, Message: "Statement is required"},
+			},
+		},
+		{
+			name:     "actions",
+			document: `{"Statement": {"Effect": "Deny", "Action": ["GetObject", "s3:Get Object", "nosuchservice:Get*", "execute-api:Invoke", "*"], "Resource": "*"}}`,
+			expected: []Finding{
+				{Severity: diag.Error, Path: "Statement.Action[0]", Message: `"GetObject" is not a valid action, expected "<service>:<action>"`},
+				{Severity: diag.Error, Path: "Statement.Action[1]", Message: `"s3:Get Object" is not a valid action: invalid action name`},
+				{Severity: diag.Warning, Path: "Statement.Action[2]", Message: `"nosuchservice:Get*" has an unknown service prefix "nosuchservice"`},
+			},
+		},
+		{
+			name:     "resources",
+			document: `{"Statement": {"Effect": "Allow", "Action": "s3:*", "Resource": ["example-bucket", "arn:aws:s3:::", "arn:aws:s3:::*"]}}`,
+			expected: []Finding{
+				{Severity: diag.Error, Path: "Statement.Resource[0]", Message: `"example-bucket" is not a valid ARN, expected "arn:<partition>:<service>:<region>:<account>:<resource>" or "*"`},
+				{Severity: diag.Error, Path: "Statement.Resource[1]", Message: `"arn:aws:s3:::" is not a valid ARN, expected "arn:<partition>:<service>:<region>:<account>:<resource>" or "*"`},
+			},
+		},
+		{
+			name:     "principal in identity-based policy",
+			document: `{"Statement": {"Effect": "Allow", "Principal": "*", "Action": "s3:*", "Resource": "*"}}`,
+			expected: []Finding{
+				{Severity: diag.Error, Path: "Statement.Principal", Message: "not allowed in an identity-based policy"},
+			},
+		},
+		{
+			name:     "principals",
+			document: `{"Statement": [{"Effect": "Allow", "Principal": {"AWS": ["alice", "AROAQ7SSZBKHREXAMPLE"], "Users": "bob"}, "Action": "sqs:*", "Resource": "*"}, {"Effect": "Allow", "Action": "sqs:*", "Resource": "*"}]}`,
+			options:  Options{Type: ResourceBasedPolicy},
+			expected: []Finding{
+				{Severity: diag.Error, Path: "Statement[0].Principal.Users", Message: `unknown element, expected one of "AWS", "CanonicalUser", "Federated", "Service"`},
+				{Severity: diag.Error, Path: "Statement[0].Principal.AWS[0]", Message: `"alice" is not a valid AWS principal, expected "*", an account ID or an ARN`},
+				{Severity: diag.Error, Path: "Statement[1]", Message: "Principal or NotPrincipal is required"},
+			},
+		},
+		{
+			name:     "resource in trust policy",
+			document: `{"Statement": {"Effect": "Allow", "Principal": {"AWS": "*"}, "Action": "sts:AssumeRole", "Resource": "*"}}`,
+			options:  Options{Type: TrustPolicy},
+			expected: []Finding{
+				{Severity: diag.Error, Path: "Statement.Resource", Message: "not allowed in a trust policy"},
+			},
+		},
+		{
+			name:     "statement IDs",
+			document: `{"Statement": [{"Sid": "Read Only", "Effect": "Allow", "Action": "s3:Get*", "Resource": "*"}, {"Sid": "Read Only", "Effect": "Allow", "Action": "s3:List*", "Resource": "*"}]}`,
+			expected: []Finding{
+				{Severity: diag.Error, Path: "Statement[0].Sid", Message: `"Read Only" must only contain alphanumeric characters`},
+				{Severity: diag.Error, Path: "Statement[1].Sid", Message: `"Read Only" must only contain alphanumeric characters`},
+				{Severity: diag.Error, Path: "Statement[1].Sid", Message: `duplicate statement ID "Read Only" (also used by Statement[0])`},
+			},
+		},
+		{
+			name:     "statement IDs in resource-based policy",
+			document: `{"Statement": [{"Sid": "Read Only", "Effect": "Allow", "Principal": "*", "Action": "s3:Get*", "Resource": "*"}]}`,
+			options:  Options{Type: ResourceBasedPolicy},
+		},
+		{
+			name:     "conditions",
+			document: `{"Statement": {"Effect": "Allow", "Action": "s3:*", "Resource": "*", "Condition": {"StringEqualz": {"aws:username": "alice"}, "NullIfExists": {"aws:TokenIssueTime": "true"}, "ForAllValues:StringLike": {"aws:TagKeys": [{"key": "value"}]}, "IpAddress": "10.0.0.0/8"}}}`,
+			expected: []Finding{
+				{Severity: diag.Error, Path: "Statement.Condition.ForAllValues:StringLike.aws:TagKeys", Message: "must be a string, number, boolean or list of those"},
+				{Severity: diag.Error, Path: "Statement.Condition.IpAddress", Message: "must be a JSON object"},
+				{Severity: diag.Error, Path: "Statement.Condition.NullIfExists", Message: `unknown condition operator "NullIfExists"`},
+				{Severity: diag.Error, Path: "Statement.Condition.StringEqualz", Message: `unknown condition operator "StringEqualz"`},
+			},
+		},
+		{
+			name:     "full access",
+			document: `{"Statement": [{"Effect": "Allow", "Action": "*:*", "Resource": "*"}, {"Effect": "Deny", "Action": "*", "Resource": "*"}, {"Effect": "Allow", "Action": "*", "Resource": "arn:aws:s3:::example"}]}`,
+			options:  Options{WarnFullAccess: true},
+			expected: []Finding{
+				{Severity: diag.Warning, Path: "Statement[0]", Message: "allows all actions (*:*) on all resources"},
+			},
+		},
+		{
+			name:     "full access not enabled",
+			document: `{"Statement": [{"Effect": "Allow", "Action": "*", "Resource": "*"}]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Analyze(testCase.document, testCase.options)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
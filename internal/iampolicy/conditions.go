@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"strings"
+)
+
+// conditionOperators are the IAM condition operators.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html.
+var conditionOperators = []string{
+	"ArnEquals",
+	"ArnLike",
+	"ArnNotEquals",
+	"ArnNotLike",
+	"BinaryEquals",
+	"Bool",
+	"DateEquals",
+	"DateGreaterThan",
+	"DateGreaterThanEquals",
+	"DateLessThan",
+	"DateLessThanEquals",
+	"DateNotEquals",
+	"IpAddress",
+	"NotIpAddress",
+	"Null",
+	"NumericEquals",
+	"NumericGreaterThan",
+	"NumericGreaterThanEquals",
+	"NumericLessThan",
+	"NumericLessThanEquals",
+	"NumericNotEquals",
+	"StringEquals",
+	"StringEqualsIgnoreCase",
+	"StringLike",
+	"StringNotEquals",
+	"StringNotEqualsIgnoreCase",
+	"StringNotLike",
+}
+
+// isConditionOperator returns whether the specified value is a condition operator,
+// optionally qualified by a set operator ("ForAllValues:" or "ForAnyValue:") and an "IfExists" suffix.
+func isConditionOperator(s string) bool {
+	for _, prefix := range []string{"ForAllValues:", "ForAnyValue:"} {
+		if len(s) > len(prefix) && strings.EqualFold(s[:len(prefix)], prefix) {
+			s = s[len(prefix):]
+			break
+		}
+	}
+
+	if _, ok := containsFold(conditionOperators, s); ok {
+		return true
+	}
+
+	// The Null operator can't be combined with IfExists.
+	if len(s) > len("IfExists") && strings.EqualFold(s[len(s)-len("IfExists"):], "IfExists") {
+		if operator, ok := containsFold(conditionOperators, s[:len(s)-len("IfExists")]); ok && operator != "Null" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// servicePrefixes are IAM action service prefixes which don't correspond to any service name in names_data.csv.
+// See https://docs.aws.amazon.com/service-authorization/latest/reference/reference_policies_actions-resources-contextkeys.html.
+var servicePrefixes = []string{
+	"a4b",
+	"access-analyzer",
+	"airflow",
+	"aoss",
+	"app-integrations",
+	"aps",
+	"aws-marketplace",
+	"aws-marketplace-management",
+	"aws-portal",
+	"billing",
+	"cassandra",
+	"cloudshell",
+	"codeguru-profiler",
+	"codeguru-reviewer",
+	"consolidatedbilling",
+	"ec2messages",
+	"elasticfilesystem",
+	"elasticmapreduce",
+	"execute-api",
+	"freertos",
+	"freetier",
+	"geo",
+	"identity-sync",
+	"invoicing",
+	"iq",
+	"iq-permission",
+	"kafka-cluster",
+	"mobiletargeting",
+	"payments",
+	"profile",
+	"purchase-orders",
+	"rds-db",
+	"s3-object-lambda",
+	"s3express",
+	"sms-voice",
+	"sqlworkbench",
+	"ssmmessages",
+	"sso-directory",
+	"sso-oauth",
+	"states",
+	"tag",
+	"tax",
+	"timestream",
+	"trustedadvisor",
+	"vpc-lattice-svcs",
+}
+
+var (
+	knownServicePrefixes     map[string]struct{}
+	knownServicePrefixesOnce sync.Once
+)
+
+// IsKnownServicePrefix returns whether the specified lowercase IAM action service prefix, e.g. "s3", is known.
+// The service names in names_data.csv are augmented with prefixes which don't correspond to any service name.
+func IsKnownServicePrefix(prefix string) bool {
+	knownServicePrefixesOnce.Do(func() {
+		knownServicePrefixes = make(map[string]struct{})
+
+		for _, v := range names.ServiceNames() {
+			knownServicePrefixes[v] = struct{}{}
+		}
+		for _, v := range servicePrefixes {
+			knownServicePrefixes[v] = struct{}{}
+		}
+	})
+
+	_, ok := knownServicePrefixes[prefix]
+
+	return ok
+}
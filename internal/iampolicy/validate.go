@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ValidDocument returns a validator for a policy document attribute.
+// Problems found by Analyze are reported at plan time as diagnostics against the attribute's path.
+// Empty values are not validated.
+func ValidDocument(options Options) schema.SchemaValidateDiagFunc {
+	return func(v any, path cty.Path) diag.Diagnostics {
+		document, ok := v.(string)
+
+		if !ok {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "Invalid policy document",
+					Detail:        fmt.Sprintf("expected type to be string, got %T", v),
+					AttributePath: path,
+				},
+			}
+		}
+
+		if document == "" {
+			return nil
+		}
+
+		var diags diag.Diagnostics
+
+		for _, finding := range Analyze(document, options) {
+			summary := "Invalid policy document"
+			if finding.Severity == diag.Warning {
+				summary = "Policy document warning"
+			}
+
+			diags = append(diags, diag.Diagnostic{
+				Severity:      finding.Severity,
+				Summary:       summary,
+				Detail:        finding.String(),
+				AttributePath: path,
+			})
+		}
+
+		return diags
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// newIdentitySchema returns the Plugin Framework identity schema for the specified service package resource identity.
+func newIdentitySchema(v *types.ServicePackageResourceIdentity) identityschema.Schema {
+	attributes := map[string]identityschema.Attribute{
+		names.AttrAccountID: identityschema.StringAttribute{
+			OptionalForImport: true,
+		},
+	}
+
+	if !v.Global {
+		attributes[names.AttrRegion] = identityschema.StringAttribute{
+			OptionalForImport: true,
+		}
+	}
+
+	for _, attr := range v.Attributes {
+		attributes[attr] = identityschema.StringAttribute{
+			RequiredForImport: true,
+		}
+	}
+
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// identityInterceptor sets a resource's identity after it has been created, read or updated.
+type identityInterceptor struct {
+	identity *types.ServicePackageResourceIdentity
+}
+
+func (r identityInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == After {
+		diags.Append(setIdentity(ctx, meta, response.State, response.Identity, r.identity)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == After {
+		diags.Append(setIdentity(ctx, meta, response.State, response.Identity, r.identity)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == After {
+		diags.Append(setIdentity(ctx, meta, response.State, response.Identity, r.identity)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+// setIdentity sets the identity attributes from the specified resource state.
+func setIdentity(ctx context.Context, meta *conns.AWSClient, state tfsdk.State, identity *tfsdk.ResourceIdentity, v *types.ServicePackageResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+	if identity == nil || state.Raw.IsNull() {
+		return diags
+	}
+
+	diags.Append(identity.SetAttribute(ctx, path.Root(names.AttrAccountID), meta.AccountID)...)
+	if !v.Global {
+		diags.Append(identity.SetAttribute(ctx, path.Root(names.AttrRegion), meta.RegionForContext(ctx))...)
+	}
+	for _, attr := range v.Attributes {
+		var value fwtypes.String
+		diags.Append(state.GetAttribute(ctx, path.Root(attr), &value)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(identity.SetAttribute(ctx, path.Root(attr), value)...)
+	}
+
+	return diags
+}
+
+// wrappedResourceWithIdentity represents an interceptor dispatcher for a Plugin Framework resource with a structured identity.
+type wrappedResourceWithIdentity struct {
+	*wrappedResource
+	identity *types.ServicePackageResourceIdentity
+}
+
+var _ resource.ResourceWithIdentity = &wrappedResourceWithIdentity{}
+
+func (w *wrappedResourceWithIdentity) IdentitySchema(ctx context.Context, request resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = newIdentitySchema(w.identity)
+}
+
+func (w *wrappedResourceWithIdentity) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	// Import by identity.
+	if request.ID == "" && request.Identity != nil {
+		id, diags := w.importID(ctx, request.Identity)
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		request.ID = id
+	}
+
+	w.wrappedResource.ImportState(ctx, request, response)
+}
+
+// importID returns the import ID corresponding to the practitioner-supplied identity.
+// A Region other than the provider's Region is appended to the ID as `id@region`.
+func (w *wrappedResourceWithIdentity) importID(ctx context.Context, identity *tfsdk.ResourceIdentity) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(w.identity.Attributes) != 1 {
+		diags.AddError("Import By Identity Not Supported", "Import by identity is not supported for this resource, import by ID instead.")
+
+		return "", diags
+	}
+
+	var accountID, region, id fwtypes.String
+	diags.Append(identity.GetAttribute(ctx, path.Root(names.AttrAccountID), &accountID)...)
+	if !w.identity.Global {
+		diags.Append(identity.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+	}
+	diags.Append(identity.GetAttribute(ctx, path.Root(w.identity.Attributes[0]), &id)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if v := accountID.ValueString(); v != "" && v != w.meta.AccountID {
+		diags.AddError("Invalid Identity", fmt.Sprintf("identity %s (%s) does not match provider account ID (%s)", names.AttrAccountID, v, w.meta.AccountID))
+
+		return "", diags
+	}
+
+	if v := region.ValueString(); v != "" && v != w.meta.Region {
+		if w.regionSchemas == nil {
+			diags.AddError("Invalid Identity", fmt.Sprintf("identity %s (%s) does not match provider Region (%s)", names.AttrRegion, v, w.meta.Region))
+
+			return "", diags
+		}
+
+		return id.ValueString() + "@" + v, diags
+	}
+
+	return id.ValueString(), diags
+}
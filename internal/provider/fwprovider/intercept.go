@@ -8,9 +8,13 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -43,38 +47,93 @@ type resourceInterceptor interface {
 	delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 }
 
-type resourceInterceptors []resourceInterceptor
+// A resource plan interceptor is functionality invoked after the resource's own plan modification, if any.
+type resourcePlanInterceptor interface {
+	// modifyPlan is invoked for a ModifyPlan call.
+	modifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, *conns.AWSClient, diag.Diagnostics) diag.Diagnostics
+}
 
-type resourceInterceptorFunc[Request resourceCRUDRequest, Response resourceCRUDResponse] func(context.Context, Request, *Response, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+type resourceInterceptors []resourceInterceptor
 
 // create returns a slice of interceptors that run on resource Create.
-func (s resourceInterceptors) create() []resourceInterceptorFunc[resource.CreateRequest, resource.CreateResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.CreateRequest, resource.CreateResponse] {
+func (s resourceInterceptors) create() []interceptorFunc[resource.CreateRequest, resource.CreateResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) interceptorFunc[resource.CreateRequest, resource.CreateResponse] {
 		return e.create
 	})
 }
 
 // read returns a slice of interceptors that run on resource Read.
-func (s resourceInterceptors) read() []resourceInterceptorFunc[resource.ReadRequest, resource.ReadResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.ReadRequest, resource.ReadResponse] {
+func (s resourceInterceptors) read() []interceptorFunc[resource.ReadRequest, resource.ReadResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) interceptorFunc[resource.ReadRequest, resource.ReadResponse] {
 		return e.read
 	})
 }
 
 // update returns a slice of interceptors that run on resource Update.
-func (s resourceInterceptors) update() []resourceInterceptorFunc[resource.UpdateRequest, resource.UpdateResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.UpdateRequest, resource.UpdateResponse] {
+func (s resourceInterceptors) update() []interceptorFunc[resource.UpdateRequest, resource.UpdateResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) interceptorFunc[resource.UpdateRequest, resource.UpdateResponse] {
 		return e.update
 	})
 }
 
 // delete returns a slice of interceptors that run on resource Delete.
-func (s resourceInterceptors) delete() []resourceInterceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
+func (s resourceInterceptors) delete() []interceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) interceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
 		return e.delete
 	})
 }
 
+type ephemeralResourceORCRequest interface {
+	ephemeral.OpenRequest | ephemeral.RenewRequest | ephemeral.CloseRequest
+}
+type ephemeralResourceORCResponse interface {
+	ephemeral.OpenResponse | ephemeral.RenewResponse | ephemeral.CloseResponse
+}
+
+// An ephemeral resource interceptor is functionality invoked during the ephemeral resource's Open, Renew and Close request lifecycle.
+// The same rules as for resource interceptors apply.
+type ephemeralResourceInterceptor interface {
+	// open is invoked for an Open call.
+	open(context.Context, ephemeral.OpenRequest, *ephemeral.OpenResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+	// renew is invoked for a Renew call.
+	renew(context.Context, ephemeral.RenewRequest, *ephemeral.RenewResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+	// close is invoked for a Close call.
+	close(context.Context, ephemeral.CloseRequest, *ephemeral.CloseResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+}
+
+type ephemeralResourceInterceptors []ephemeralResourceInterceptor
+
+// open returns a slice of interceptors that run on ephemeral resource Open.
+func (s ephemeralResourceInterceptors) open() []interceptorFunc[ephemeral.OpenRequest, ephemeral.OpenResponse] {
+	return slices.ApplyToAll(s, func(e ephemeralResourceInterceptor) interceptorFunc[ephemeral.OpenRequest, ephemeral.OpenResponse] {
+		return e.open
+	})
+}
+
+// renew returns a slice of interceptors that run on ephemeral resource Renew.
+func (s ephemeralResourceInterceptors) renew() []interceptorFunc[ephemeral.RenewRequest, ephemeral.RenewResponse] {
+	return slices.ApplyToAll(s, func(e ephemeralResourceInterceptor) interceptorFunc[ephemeral.RenewRequest, ephemeral.RenewResponse] {
+		return e.renew
+	})
+}
+
+// close returns a slice of interceptors that run on ephemeral resource Close.
+func (s ephemeralResourceInterceptors) close() []interceptorFunc[ephemeral.CloseRequest, ephemeral.CloseResponse] {
+	return slices.ApplyToAll(s, func(e ephemeralResourceInterceptor) interceptorFunc[ephemeral.CloseRequest, ephemeral.CloseResponse] {
+		return e.close
+	})
+}
+
+// interceptorRequest and interceptorResponse are the request and response types of all intercepted methods.
+type interceptorRequest interface {
+	resourceCRUDRequest | ephemeralResourceORCRequest
+}
+type interceptorResponse interface {
+	resourceCRUDResponse | ephemeralResourceORCResponse
+}
+
+type interceptorFunc[Request interceptorRequest, Response interceptorResponse] func(context.Context, Request, *Response, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+
 // when represents the point in the CRUD request lifecycle that an interceptor is run.
 // Multiple values can be ORed together.
 type when uint16
@@ -86,8 +145,8 @@ const (
 	Finally                  // Interceptor is invoked after After or OnError
 )
 
-// interceptedHandler returns a handler that invokes the specified CRUD handler, running any interceptors.
-func interceptedHandler[Request resourceCRUDRequest, Response resourceCRUDResponse](interceptors []resourceInterceptorFunc[Request, Response], f func(context.Context, Request, *Response) diag.Diagnostics, meta *conns.AWSClient) func(context.Context, Request, *Response) diag.Diagnostics {
+// interceptedHandler returns a handler that invokes the specified CRUD (or ORC) handler, running any interceptors.
+func interceptedHandler[Request interceptorRequest, Response interceptorResponse](interceptors []interceptorFunc[Request, Response], f func(context.Context, Request, *Response) diag.Diagnostics, meta *conns.AWSClient) func(context.Context, Request, *Response) diag.Diagnostics {
 	return func(ctx context.Context, request Request, response *Response) diag.Diagnostics {
 		var diags diag.Diagnostics
 		// Before interceptors are run first to last.
@@ -134,13 +193,23 @@ type wrappedDataSource struct {
 	bootstrapContext contextFunc
 	inner            datasource.DataSourceWithConfigure
 	meta             *conns.AWSClient
+	// regionSchemas is non-nil if the data source supports per-data source Region override.
+	regionSchemas *dataSourceRegionSchemas
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure) datasource.DataSourceWithConfigure {
-	return &wrappedDataSource{
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, innerSchema *dsschema.Schema) datasource.DataSourceWithConfigure {
+	w := &wrappedDataSource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 	}
+
+	if innerSchema != nil {
+		if v, ok := newDataSourceRegionSchemas(*innerSchema); ok {
+			w.regionSchemas = v
+		}
+	}
+
+	return w
 }
 
 func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
@@ -149,16 +218,32 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 }
 
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	if w.regionSchemas != nil {
+		response.Schema = w.regionSchemas.with
+
+		return
+	}
+
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
+
+	if w.regionSchemas != nil {
+		w.readWithoutRegion(ctx, request, response)
+
+		return
+	}
+
 	w.inner.Read(ctx, request, response)
 }
 
 func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Configure(ctx, request, response)
 }
@@ -170,14 +255,35 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	// regionSchemas is non-nil if the resource supports per-resource Region override.
+	regionSchemas *resourceRegionSchemas
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
-	return &wrappedResource{
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, innerSchema *schema.Schema, identity *types.ServicePackageResourceIdentity) resource.ResourceWithConfigure {
+	w := &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
 	}
+
+	if innerSchema != nil {
+		if v, ok := newResourceRegionSchemas(*innerSchema, func() *conns.AWSClient { return w.meta }); ok {
+			w.regionSchemas = v
+			w.interceptors = append(resourceInterceptors{regionInterceptor{}}, w.interceptors...)
+		}
+	}
+
+	if identity != nil {
+		// Don't append to the caller's slice's backing array.
+		w.interceptors = append(w.interceptors[:len(w.interceptors):len(w.interceptors)], identityInterceptor{identity: identity})
+
+		return &wrappedResourceWithIdentity{
+			wrappedResource: w,
+			identity:        identity,
+		}
+	}
+
+	return w
 }
 
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -186,13 +292,23 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 }
 
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	if w.regionSchemas != nil {
+		response.Schema = w.regionSchemas.with
+
+		return
+	}
+
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
-		w.inner.Create(ctx, request, response)
+		if w.regionSchemas != nil {
+			w.createWithoutRegion(ctx, request, response)
+		} else {
+			w.inner.Create(ctx, request, response)
+		}
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -202,7 +318,11 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		if w.regionSchemas != nil {
+			w.readWithoutRegion(ctx, request, response)
+		} else {
+			w.inner.Read(ctx, request, response)
+		}
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -212,7 +332,11 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
-		w.inner.Update(ctx, request, response)
+		if w.regionSchemas != nil {
+			w.updateWithoutRegion(ctx, request, response)
+		} else {
+			w.inner.Update(ctx, request, response)
+		}
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -222,7 +346,11 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
-		w.inner.Delete(ctx, request, response)
+		if w.regionSchemas != nil {
+			w.deleteWithoutRegion(ctx, request, response)
+		} else {
+			w.inner.Delete(ctx, request, response)
+		}
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -241,7 +369,11 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ImportState(ctx, request, response)
+		if w.regionSchemas != nil {
+			w.importStateWithoutRegion(ctx, v, request, response)
+		} else {
+			v.ImportState(ctx, request, response)
+		}
 
 		return
 	}
@@ -253,12 +385,25 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ModifyPlan(ctx, request, response)
+		if w.regionSchemas != nil {
+			w.modifyPlanWithoutRegion(ctx, v, request, response)
+		} else {
+			v.ModifyPlan(ctx, request, response)
+		}
+	}
 
+	if response.Diagnostics.HasError() {
 		return
 	}
+
+	for _, v := range w.interceptors {
+		if v, ok := v.(resourcePlanInterceptor); ok {
+			response.Diagnostics = v.modifyPlan(ctx, request, response, w.meta, response.Diagnostics)
+		}
+	}
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -273,13 +418,20 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ValidateConfig(ctx, request, response)
+		if w.regionSchemas != nil {
+			w.validateConfigWithoutRegion(ctx, v, request, response)
+		} else {
+			v.ValidateConfig(ctx, request, response)
+		}
 	}
 }
 
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.regionSchemas != nil {
+			return w.upgradeStateWithoutRegion(v.UpgradeState(ctx))
+		}
 
 		return v.UpgradeState(ctx)
 	}
@@ -287,6 +439,142 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 	return nil
 }
 
+// wrappedListResource represents an interceptor dispatcher for a Plugin Framework list resource.
+type wrappedListResource struct {
+	// bootstrapContext is run on all wrapped methods before any interceptors.
+	bootstrapContext contextFunc
+	inner            list.ListResourceWithConfigure
+	meta             *conns.AWSClient
+}
+
+func newWrappedListResource(bootstrapContext contextFunc, inner list.ListResourceWithConfigure) list.ListResourceWithConfigure {
+	return &wrappedListResource{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+	}
+}
+
+func (w *wrappedListResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.ListResourceConfigSchema(ctx, request, response)
+}
+
+func (w *wrappedListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.List(ctx, request, stream)
+}
+
+func (w *wrappedListResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedListResource) RawV5Schemas(ctx context.Context, request list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	if v, ok := w.inner.(list.ListResourceWithRawV5Schemas); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.RawV5Schemas(ctx, request, response)
+	}
+}
+
+// wrappedEphemeralResource represents an interceptor dispatcher for a Plugin Framework ephemeral resource.
+type wrappedEphemeralResource struct {
+	// bootstrapContext is run on all wrapped methods before any interceptors.
+	bootstrapContext contextFunc
+	inner            ephemeral.EphemeralResourceWithConfigure
+	interceptors     ephemeralResourceInterceptors
+	meta             *conns.AWSClient
+}
+
+func newWrappedEphemeralResource(bootstrapContext contextFunc, inner ephemeral.EphemeralResourceWithConfigure, interceptors ephemeralResourceInterceptors) ephemeral.EphemeralResourceWithConfigure {
+	return &wrappedEphemeralResource{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+		interceptors:     interceptors,
+	}
+}
+
+func (w *wrappedEphemeralResource) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Schema(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	f := func(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) diag.Diagnostics {
+		w.inner.Open(ctx, request, response)
+		return response.Diagnostics
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	diags := interceptedHandler(w.interceptors.open(), f, w.meta)(ctx, request, response)
+	response.Diagnostics = diags
+}
+
+func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
+	v, ok := w.inner.(ephemeral.EphemeralResourceWithRenew)
+	if !ok {
+		return
+	}
+
+	f := func(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) diag.Diagnostics {
+		v.Renew(ctx, request, response)
+		return response.Diagnostics
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	diags := interceptedHandler(w.interceptors.renew(), f, w.meta)(ctx, request, response)
+	response.Diagnostics = diags
+}
+
+func (w *wrappedEphemeralResource) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
+	v, ok := w.inner.(ephemeral.EphemeralResourceWithClose)
+	if !ok {
+		return
+	}
+
+	f := func(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) diag.Diagnostics {
+		v.Close(ctx, request, response)
+		return response.Diagnostics
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	diags := interceptedHandler(w.interceptors.close(), f, w.meta)(ctx, request, response)
+	response.Diagnostics = diags
+}
+
+func (w *wrappedEphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithConfigValidators); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		return v.ConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithValidateConfig); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.ValidateConfig(ctx, request, response)
+	}
+}
+
 // tagsInterceptor implements transparent tagging.
 type tagsInterceptor struct {
 	tags *types.ServicePackageResourceTags
@@ -337,6 +625,64 @@ func (r tagsInterceptor) create(ctx context.Context, request resource.CreateRequ
 	return ctx, diags
 }
 
+// modifyPlan checks that the resource's planned tags_all comply with the account's effective tag policy.
+func (r tagsInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, diags diag.Diagnostics) diag.Diagnostics {
+	if r.tags == nil || meta == nil {
+		return diags
+	}
+
+	if meta.TagPolicyCompliance == "" || meta.TagPolicyCompliance == tftags.TagPolicyComplianceDisabled {
+		return diags
+	}
+
+	// Destroy.
+	if response.Plan.Raw.IsNull() {
+		return diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	var planTagsAll fwtypes.Map
+	diags.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrTagsAll), &planTagsAll)...)
+
+	if diags.HasError() || planTagsAll.IsUnknown() {
+		return diags
+	}
+
+	serviceName, err := names.HumanFriendly(inContext.ServicePackageName)
+	if err != nil {
+		serviceName = "<service>"
+	}
+
+	resourceName := inContext.ResourceName
+	if resourceName == "" {
+		resourceName = "<thing>"
+	}
+
+	addDiag := diags.AddWarning
+	if meta.TagPolicyCompliance == tftags.TagPolicyComplianceError {
+		addDiag = diags.AddError
+	}
+
+	tags := tftags.New(ctx, planTagsAll).IgnoreSystem(inContext.ServicePackageName)
+	violations, err := meta.TagPolicyViolations(ctx, tags, tftags.TagPolicyResourceType(inContext.ServicePackageName, inContext.ResourceName))
+
+	if err != nil {
+		addDiag("checking tag policy compliance", err.Error())
+
+		return diags
+	}
+
+	for _, v := range violations {
+		addDiag(fmt.Sprintf("%s %s tags do not comply with tag policy", serviceName, resourceName), v)
+	}
+
+	return diags
+}
+
 func (r tagsInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	if r.tags == nil {
 		return ctx, diags
@@ -10,15 +10,21 @@ import (
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -30,6 +36,12 @@ func New(primary interface{ Meta() interface{} }) provider.Provider {
 	}
 }
 
+var (
+	_ provider.ProviderWithEphemeralResources = &fwprovider{}
+	_ provider.ProviderWithFunctions          = &fwprovider{}
+	_ provider.ProviderWithListResources      = &fwprovider{}
+)
+
 type fwprovider struct {
 	Primary interface{ Meta() interface{} }
 }
@@ -63,6 +75,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Protocol to use with EC2 metadata service endpoint.Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"endpoint_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "Base endpoint URL to use for all AWS API calls, e.g. a local AWS API emulator. Can also be configured using the `AWS_ENDPOINT_URL` environment variable. Service endpoints configured in the `endpoints` block or using the `AWS_ENDPOINT_URL_<SERVICE>` environment variables take precedence.",
+			},
 			"forbidden_account_ids": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -129,6 +145,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The region where AWS STS operations will take place. Examples\nare us-east-1 and us-west-2.", // lintignore:AWSAT003
 			},
+			"tag_policy_compliance": schema.StringAttribute{
+				Optional:    true,
+				Description: "The action taken when a resource's tags do not comply with the account's effective AWS Organizations tag policy. Valid values are `disabled`, `error` and `warning`.",
+			},
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Description: "session token. A session token is only required if you are\nusing temporary security credentials.",
@@ -143,10 +163,27 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 			},
 		},
 		Blocks: map[string]schema.Block{
-			"assume_role": schema.ListNestedBlock{
+			"api_rate_limits": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
 				},
+				Description: "Configuration block with settings for client-side rate limiting of AWS API calls.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"adaptive": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Reduce the rate of AWS API calls to all services when throttling errors are returned.",
+						},
+						"requests_per_second": schema.MapAttribute{
+							ElementType: types.Float64Type,
+							Optional:    true,
+							Description: "Maximum rate of AWS API calls per second, keyed by service name as used in the `endpoints` block.",
+						},
+					},
+				},
+			},
+			"assume_role": schema.ListNestedBlock{
+				Description: "IAM Roles to assume, in order, each using the credentials of the previous role.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -242,6 +279,30 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 							Description: "Resource tags to default across all resources",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"rule": schema.ListNestedBlock{
+							Description: "Resource tags to default across matching resources. Later rules take precedence over earlier rules.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource type glob patterns, e.g. `aws_ec2_*`, matched by the rule.",
+									},
+									"services": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Service names, as used in the `endpoints` block, matched by the rule.",
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Resource tags to default across matching resources",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -276,6 +337,8 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 	// Provider's parsed configuration (its instance state) is available through the primary provider's Meta() method.
 	v := p.Primary.Meta()
 	response.DataSourceData = v
+	response.EphemeralResourceData = v
+	response.ListResourceData = v
 	response.ResourceData = v
 }
 
@@ -303,18 +366,26 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				continue
 			}
 
+			metadataResponse := datasource.MetadataResponse{}
+			inner.Metadata(ctx, datasource.MetadataRequest{}, &metadataResponse)
+			typeName := metadataResponse.TypeName
+
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx).ForResource(servicePackageName, typeName), meta.IgnoreTagsConfig)
 				}
 
 				return ctx
 			}
 
+			// Data sources support per-data source Region override.
+			schemaResponse := datasource.SchemaResponse{}
+			inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner)
+				return newWrappedDataSource(bootstrapContext, inner, &schemaResponse.Schema)
 			})
 		}
 	}
@@ -322,6 +393,143 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 	return dataSources
 }
 
+// EphemeralResources returns a slice of functions to instantiate each EphemeralResource
+// implementation.
+//
+// The ephemeral resource type name is determined by the EphemeralResource implementing
+// the Metadata method. All ephemeral resources must have unique names.
+func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	var ephemeralResources []func() ephemeral.EphemeralResource
+
+	for n, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+		servicePackageName := sp.ServicePackageName()
+
+		for _, v := range sp.FrameworkEphemeralResources(ctx) {
+			v := v
+			inner, err := v.Factory(ctx)
+
+			if err != nil {
+				tflog.Warn(ctx, "creating ephemeral resource", map[string]interface{}{
+					"service_package_name": n,
+					"error":                err.Error(),
+				})
+
+				continue
+			}
+
+			metadataResponse := ephemeral.MetadataResponse{}
+			inner.Metadata(ctx, ephemeral.MetadataRequest{}, &metadataResponse)
+			typeName := metadataResponse.TypeName
+
+			// bootstrapContext is run on all wrapped methods before any interceptors.
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				if meta != nil {
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx).ForResource(servicePackageName, typeName), meta.IgnoreTagsConfig)
+				}
+
+				return ctx
+			}
+			interceptors := ephemeralResourceInterceptors{}
+
+			ephemeralResources = append(ephemeralResources, func() ephemeral.EphemeralResource {
+				return newWrappedEphemeralResource(bootstrapContext, inner, interceptors)
+			})
+		}
+	}
+
+	return ephemeralResources
+}
+
+// Functions returns a slice of functions to instantiate each provider-defined Function
+// implementation.
+func (p *fwprovider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		tffunction.NewARNBuildFunction,
+		tffunction.NewARNParseFunction,
+		tffunction.NewTrimIAMRolePathFunction,
+	}
+}
+
+// ListResources returns a slice of functions to instantiate each ListResource
+// implementation.
+//
+// The list resource type name is determined by the ListResource implementing
+// the Metadata method. All list resources must have unique names.
+func (p *fwprovider) ListResources(ctx context.Context) []func() list.ListResource {
+	var errs *multierror.Error
+	var listResources []func() list.ListResource
+
+	// List resources for Plugin SDK resources use the primary provider's resource definitions.
+	primary, ok := p.Primary.(*sdkschema.Provider)
+	if !ok {
+		return listResources
+	}
+
+	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+		servicePackageName := sp.ServicePackageName()
+		identities := make(map[string]*itypes.ServicePackageResourceIdentity)
+		for _, v := range sp.SDKResources(ctx) {
+			identities[v.TypeName] = v.Identity
+		}
+
+		for _, v := range sp.SDKListResources(ctx) {
+			v := v
+			typeName := v.TypeName
+
+			r, ok := primary.ResourcesMap[typeName]
+			if !ok {
+				errs = multierror.Append(errs, fmt.Errorf("no resource for list resource: %s", typeName))
+				continue
+			}
+
+			identity := identities[typeName]
+			if identity == nil {
+				errs = multierror.Append(errs, fmt.Errorf("no resource identity for list resource: %s", typeName))
+				continue
+			}
+
+			inner, err := v.Factory(ctx)
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("creating list resource: %w", err))
+				continue
+			}
+
+			if v, ok := inner.(interface {
+				SetSDKv2Resource(*sdkschema.Resource, *itypes.ServicePackageResourceIdentity)
+			}); ok {
+				v.SetSDKv2Resource(r, identity)
+			} else {
+				errs = multierror.Append(errs, fmt.Errorf("list resource does not support Plugin SDK resources: %s", typeName))
+				continue
+			}
+
+			// bootstrapContext is run on all wrapped methods before any interceptors.
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				if meta != nil {
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx).ForResource(servicePackageName, typeName), meta.IgnoreTagsConfig)
+				}
+
+				return ctx
+			}
+
+			listResources = append(listResources, func() list.ListResource {
+				return newWrappedListResource(bootstrapContext, inner)
+			})
+		}
+	}
+
+	if err := errs.ErrorOrNil(); err != nil {
+		tflog.Warn(ctx, "registering list resources", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return listResources
+}
+
 // Resources returns a slice of functions to instantiate each Resource
 // implementation.
 //
@@ -351,19 +559,18 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx).ForResource(servicePackageName, typeName), meta.IgnoreTagsConfig)
 				}
 
 				return ctx
 			}
 			interceptors := resourceInterceptors{}
+			schemaResponse := resource.SchemaResponse{}
+			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := resource.SchemaResponse{}
-				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if v.IsComputed() {
 						errs = multierror.Append(errs, fmt.Errorf("`%s` attribute cannot be Computed: %s", names.AttrTags, typeName))
@@ -386,8 +593,10 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsInterceptor{tags: v.Tags})
 			}
 
+			// Resources support per-resource Region override.
+			// Resources with a structured identity support import by identity.
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, inner, interceptors, &schemaResponse.Schema, v.Identity)
 			})
 		}
 	}
@@ -411,6 +620,14 @@ func endpointsBlock() schema.SetNestedBlock {
 		}
 	}
 
+	for serviceKey, humanFriendly := range names.RetiredServices() {
+		endpointsAttributes[serviceKey] = schema.StringAttribute{
+			Optional:           true,
+			Description:        "Use this to override the default service endpoint URL",
+			DeprecationMessage: fmt.Sprintf("%s has been retired by AWS. The %s endpoint is ignored and will be removed in a future major version.", humanFriendly, serviceKey),
+		}
+	}
+
 	return schema.SetNestedBlock{
 		NestedObject: schema.NestedBlockObject{
 			Attributes: endpointsAttributes,
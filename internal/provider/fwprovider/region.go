@@ -0,0 +1,605 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var importIDRegionRegexp = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+
+const regionAttributeDescription = "The AWS Region in which this resource is managed. Defaults to the Region set in the provider configuration."
+
+// regionPlanModifier plans the value of the injected `region` attribute.
+// If not configured the provider's Region is used. A change of Region requires replacement.
+type regionPlanModifier struct {
+	meta func() *conns.AWSClient
+}
+
+func (m regionPlanModifier) Description(context.Context) string {
+	return "Defaults to the provider Region. Changing the Region requires resource replacement."
+}
+
+func (m regionPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m regionPlanModifier) PlanModifyString(ctx context.Context, request planmodifier.StringRequest, response *planmodifier.StringResponse) {
+	meta := m.meta()
+	if meta == nil {
+		return
+	}
+
+	if request.ConfigValue.IsNull() {
+		response.PlanValue = fwtypes.StringValue(meta.Region)
+	}
+
+	// Create.
+	if request.State.Raw.IsNull() {
+		return
+	}
+
+	// Destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	if response.PlanValue.IsUnknown() {
+		response.RequiresReplace = true
+		return
+	}
+
+	// Resources in state from before per-resource Region override was available are in the provider's Region.
+	stateRegion := request.StateValue.ValueString()
+	if stateRegion == "" {
+		stateRegion = meta.Region
+	}
+
+	if response.PlanValue.ValueString() != stateRegion {
+		response.RequiresReplace = true
+	}
+}
+
+// removeRegion returns a copy of the specified value with any top-level `region` attribute removed.
+func removeRegion(ctx context.Context, raw tftypes.Value, without attr.Type) (tftypes.Value, error) {
+	typ := without.TerraformType(ctx)
+
+	if raw.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if !raw.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var m map[string]tftypes.Value
+	if err := raw.As(&m); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	delete(m, names.AttrRegion)
+
+	return tftypes.NewValue(typ, m), nil
+}
+
+// addRegion returns a copy of the specified value with a top-level `region` attribute added.
+func addRegion(ctx context.Context, raw tftypes.Value, with attr.Type, region tftypes.Value) (tftypes.Value, error) {
+	typ := with.TerraformType(ctx)
+
+	if raw.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if !raw.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var m map[string]tftypes.Value
+	if err := raw.As(&m); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	m[names.AttrRegion] = region
+
+	return tftypes.NewValue(typ, m), nil
+}
+
+// regionValue returns the value of the top-level `region` attribute in the specified value.
+func regionValue(raw tftypes.Value) tftypes.Value {
+	if raw.IsNull() || !raw.IsKnown() {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	var m map[string]tftypes.Value
+	if err := raw.As(&m); err != nil {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+
+	if v, ok := m[names.AttrRegion]; ok {
+		return v
+	}
+
+	return tftypes.NewValue(tftypes.String, nil)
+}
+
+func regionDiagnostic(err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic("Per-resource Region Override", err.Error())
+}
+
+// resourceRegionSchemas holds a resource's schema with and without the injected top-level `region` attribute.
+// The wrapped resource only ever sees values conforming to the schema without `region`
+// so that its model structures need not declare the attribute.
+type resourceRegionSchemas struct {
+	with, without schema.Schema
+}
+
+// newResourceRegionSchemas returns the schemas used for the resource's per-resource Region override.
+// It returns false if the resource's own schema declares a `region` attribute.
+func newResourceRegionSchemas(inner schema.Schema, meta func() *conns.AWSClient) (*resourceRegionSchemas, bool) {
+	if _, ok := inner.Attributes[names.AttrRegion]; ok {
+		return nil, false
+	}
+
+	with := inner
+	with.Attributes = make(map[string]schema.Attribute, len(inner.Attributes)+1)
+	for k, v := range inner.Attributes {
+		with.Attributes[k] = v
+	}
+	with.Attributes[names.AttrRegion] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: regionAttributeDescription,
+		PlanModifiers: []planmodifier.String{
+			regionPlanModifier{meta: meta},
+		},
+		Validators: []validator.String{
+			fwvalidators.RegionName(),
+		},
+	}
+
+	return &resourceRegionSchemas{with: with, without: inner}, true
+}
+
+// config returns the configuration without the `region` attribute.
+func (s *resourceRegionSchemas) config(ctx context.Context, v tfsdk.Config) (tfsdk.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := removeRegion(ctx, v.Raw, s.without.Type())
+	if err != nil {
+		diags.Append(regionDiagnostic(err))
+		return v, diags
+	}
+
+	return tfsdk.Config{Raw: raw, Schema: s.without}, diags
+}
+
+// plan returns the plan without the `region` attribute.
+func (s *resourceRegionSchemas) plan(ctx context.Context, v tfsdk.Plan) (tfsdk.Plan, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := removeRegion(ctx, v.Raw, s.without.Type())
+	if err != nil {
+		diags.Append(regionDiagnostic(err))
+		return v, diags
+	}
+
+	return tfsdk.Plan{Raw: raw, Schema: s.without}, diags
+}
+
+// state returns the state without the `region` attribute.
+func (s *resourceRegionSchemas) state(ctx context.Context, v tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := removeRegion(ctx, v.Raw, s.without.Type())
+	if err != nil {
+		diags.Append(regionDiagnostic(err))
+		return v, diags
+	}
+
+	return tfsdk.State{Raw: raw, Schema: s.without}, diags
+}
+
+// withRegionState returns the state returned by the wrapped resource with the `region` attribute restored.
+func (s *resourceRegionSchemas) withRegionState(ctx context.Context, v tfsdk.State, region tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := addRegion(ctx, v.Raw, s.with.Type(), region)
+	if err != nil {
+		diags.Append(regionDiagnostic(err))
+		return v, diags
+	}
+
+	return tfsdk.State{Raw: raw, Schema: s.with}, diags
+}
+
+// withRegionPlan returns the plan returned by the wrapped resource with the `region` attribute restored.
+func (s *resourceRegionSchemas) withRegionPlan(ctx context.Context, v tfsdk.Plan, region tftypes.Value) (tfsdk.Plan, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := addRegion(ctx, v.Raw, s.with.Type(), region)
+	if err != nil {
+		diags.Append(regionDiagnostic(err))
+		return v, diags
+	}
+
+	return tfsdk.Plan{Raw: raw, Schema: s.with}, diags
+}
+
+func (w *wrappedResource) createWithoutRegion(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	s := w.regionSchemas
+	region := regionValue(request.Plan.Raw)
+	innerRequest, innerResponse := request, *response
+
+	var diags diag.Diagnostics
+	innerRequest.Config, diags = s.config(ctx, request.Config)
+	response.Diagnostics.Append(diags...)
+	innerRequest.Plan, diags = s.plan(ctx, request.Plan)
+	response.Diagnostics.Append(diags...)
+	innerResponse.State, diags = s.state(ctx, response.State)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Create(ctx, innerRequest, &innerResponse)
+
+	response.Private = innerResponse.Private
+	response.Diagnostics = innerResponse.Diagnostics
+	response.State, diags = s.withRegionState(ctx, innerResponse.State, region)
+	response.Diagnostics.Append(diags...)
+}
+
+func (w *wrappedResource) readWithoutRegion(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	s := w.regionSchemas
+	region := regionValue(request.State.Raw)
+	innerRequest, innerResponse := request, *response
+
+	var diags diag.Diagnostics
+	innerRequest.State, diags = s.state(ctx, request.State)
+	response.Diagnostics.Append(diags...)
+	innerResponse.State, diags = s.state(ctx, response.State)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Read(ctx, innerRequest, &innerResponse)
+
+	response.Private = innerResponse.Private
+	response.Diagnostics = innerResponse.Diagnostics
+	response.State, diags = s.withRegionState(ctx, innerResponse.State, region)
+	response.Diagnostics.Append(diags...)
+}
+
+func (w *wrappedResource) updateWithoutRegion(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	s := w.regionSchemas
+	region := regionValue(request.Plan.Raw)
+	innerRequest, innerResponse := request, *response
+
+	var diags diag.Diagnostics
+	innerRequest.Config, diags = s.config(ctx, request.Config)
+	response.Diagnostics.Append(diags...)
+	innerRequest.Plan, diags = s.plan(ctx, request.Plan)
+	response.Diagnostics.Append(diags...)
+	innerRequest.State, diags = s.state(ctx, request.State)
+	response.Diagnostics.Append(diags...)
+	innerResponse.State, diags = s.state(ctx, response.State)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Update(ctx, innerRequest, &innerResponse)
+
+	response.Private = innerResponse.Private
+	response.Diagnostics = innerResponse.Diagnostics
+	response.State, diags = s.withRegionState(ctx, innerResponse.State, region)
+	response.Diagnostics.Append(diags...)
+}
+
+func (w *wrappedResource) deleteWithoutRegion(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	s := w.regionSchemas
+	region := regionValue(request.State.Raw)
+	innerRequest, innerResponse := request, *response
+
+	var diags diag.Diagnostics
+	innerRequest.State, diags = s.state(ctx, request.State)
+	response.Diagnostics.Append(diags...)
+	innerResponse.State, diags = s.state(ctx, response.State)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Delete(ctx, innerRequest, &innerResponse)
+
+	response.Diagnostics = innerResponse.Diagnostics
+	response.State, diags = s.withRegionState(ctx, innerResponse.State, region)
+	response.Diagnostics.Append(diags...)
+}
+
+func (w *wrappedResource) modifyPlanWithoutRegion(ctx context.Context, inner resource.ResourceWithModifyPlan, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	s := w.regionSchemas
+	region := regionValue(response.Plan.Raw)
+	innerRequest, innerResponse := request, *response
+
+	var diags diag.Diagnostics
+	innerRequest.Config, diags = s.config(ctx, request.Config)
+	response.Diagnostics.Append(diags...)
+	innerRequest.Plan, diags = s.plan(ctx, request.Plan)
+	response.Diagnostics.Append(diags...)
+	innerRequest.State, diags = s.state(ctx, request.State)
+	response.Diagnostics.Append(diags...)
+	innerResponse.Plan, diags = s.plan(ctx, response.Plan)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ModifyPlan(ctx, innerRequest, &innerResponse)
+
+	response.Private = innerResponse.Private
+	response.RequiresReplace = innerResponse.RequiresReplace
+	response.Diagnostics = innerResponse.Diagnostics
+	response.Plan, diags = s.withRegionPlan(ctx, innerResponse.Plan, region)
+	response.Diagnostics.Append(diags...)
+}
+
+func (w *wrappedResource) validateConfigWithoutRegion(ctx context.Context, inner resource.ResourceWithValidateConfig, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	s := w.regionSchemas
+	innerRequest := request
+
+	var diags diag.Diagnostics
+	innerRequest.Config, diags = s.config(ctx, request.Config)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ValidateConfig(ctx, innerRequest, response)
+}
+
+func (w *wrappedResource) importStateWithoutRegion(ctx context.Context, inner resource.ResourceWithImportState, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	s := w.regionSchemas
+	region := tftypes.NewValue(tftypes.String, nil)
+
+	// Handle an import ID of the form `id@region`.
+	if i := strings.LastIndex(request.ID, "@"); i > 0 {
+		if v := request.ID[i+1:]; importIDRegionRegexp.MatchString(v) {
+			request.ID = request.ID[:i]
+			region = tftypes.NewValue(tftypes.String, v)
+
+			if inContext, ok := conns.FromContext(ctx); ok {
+				inContext.OverrideRegion = v
+			}
+		}
+	}
+
+	innerResponse := *response
+
+	var diags diag.Diagnostics
+	innerResponse.State, diags = s.state(ctx, response.State)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ImportState(ctx, request, &innerResponse)
+
+	response.Private = innerResponse.Private
+	response.Diagnostics = innerResponse.Diagnostics
+	response.State, diags = s.withRegionState(ctx, innerResponse.State, region)
+	response.Diagnostics.Append(diags...)
+}
+
+func (w *wrappedResource) upgradeStateWithoutRegion(upgraders map[int64]resource.StateUpgrader) map[int64]resource.StateUpgrader {
+	s := w.regionSchemas
+	m := make(map[int64]resource.StateUpgrader, len(upgraders))
+
+	for k, v := range upgraders {
+		f := v.StateUpgrader
+		v.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+			innerResponse := *response
+
+			var diags diag.Diagnostics
+			innerResponse.State, diags = s.state(ctx, response.State)
+			response.Diagnostics.Append(diags...)
+
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			f(ctx, request, &innerResponse)
+
+			response.DynamicValue = innerResponse.DynamicValue
+			response.Diagnostics = innerResponse.Diagnostics
+			response.State, diags = s.withRegionState(ctx, innerResponse.State, tftypes.NewValue(tftypes.String, nil))
+			response.Diagnostics.Append(diags...)
+		}
+		m[k] = v
+	}
+
+	return m
+}
+
+// regionInterceptor implements per-resource Region override.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		diags.Append(overrideRegion(ctx, request.Plan.GetAttribute)...)
+	case After:
+		diags.Append(setRegion(ctx, meta, &response.State)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		diags.Append(overrideRegion(ctx, request.State.GetAttribute)...)
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.State.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		diags.Append(setRegion(ctx, meta, &response.State)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		diags.Append(overrideRegion(ctx, request.Plan.GetAttribute)...)
+	case After:
+		diags.Append(setRegion(ctx, meta, &response.State)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		diags.Append(overrideRegion(ctx, request.State.GetAttribute)...)
+	}
+
+	return ctx, diags
+}
+
+// overrideRegion sets any per-resource Region override in Context so that AWS API clients target it.
+func overrideRegion(ctx context.Context, getAttribute func(context.Context, path.Path, any) diag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	var region fwtypes.String
+	diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := region.ValueString(); v != "" {
+		inContext.OverrideRegion = v
+	}
+
+	return diags
+}
+
+// setRegion sets the `region` attribute in state to the Region that the resource is managed in.
+func setRegion(ctx context.Context, meta *conns.AWSClient, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if meta == nil || state.Raw.IsNull() {
+		return diags
+	}
+
+	diags.Append(state.SetAttribute(ctx, path.Root(names.AttrRegion), meta.RegionForContext(ctx))...)
+
+	return diags
+}
+
+// dataSourceRegionSchemas holds a data source's schema with and without the injected top-level `region` attribute.
+type dataSourceRegionSchemas struct {
+	with, without dsschema.Schema
+}
+
+// newDataSourceRegionSchemas returns the schemas used for the data source's per-data source Region override.
+// It returns false if the data source's own schema declares a `region` attribute.
+func newDataSourceRegionSchemas(inner dsschema.Schema) (*dataSourceRegionSchemas, bool) {
+	if _, ok := inner.Attributes[names.AttrRegion]; ok {
+		return nil, false
+	}
+
+	with := inner
+	with.Attributes = make(map[string]dsschema.Attribute, len(inner.Attributes)+1)
+	for k, v := range inner.Attributes {
+		with.Attributes[k] = v
+	}
+	with.Attributes[names.AttrRegion] = dsschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region in which to read this data source. Defaults to the Region set in the provider configuration.",
+		Validators: []validator.String{
+			fwvalidators.RegionName(),
+		},
+	}
+
+	return &dataSourceRegionSchemas{with: with, without: inner}, true
+}
+
+func (w *wrappedDataSource) readWithoutRegion(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	s := w.regionSchemas
+	innerRequest, innerResponse := request, *response
+
+	diags := overrideRegion(ctx, request.Config.GetAttribute)
+	response.Diagnostics.Append(diags...)
+
+	raw, err := removeRegion(ctx, request.Config.Raw, s.without.Type())
+	if err != nil {
+		response.Diagnostics.Append(regionDiagnostic(err))
+	}
+	innerRequest.Config = tfsdk.Config{Raw: raw, Schema: s.without}
+
+	raw, err = removeRegion(ctx, response.State.Raw, s.without.Type())
+	if err != nil {
+		response.Diagnostics.Append(regionDiagnostic(err))
+	}
+	innerResponse.State = tfsdk.State{Raw: raw, Schema: s.without}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Read(ctx, innerRequest, &innerResponse)
+
+	response.Diagnostics = innerResponse.Diagnostics
+
+	raw, err = addRegion(ctx, innerResponse.State.Raw, s.with.Type(), tftypes.NewValue(tftypes.String, nil))
+	if err != nil {
+		response.Diagnostics.Append(regionDiagnostic(err))
+		return
+	}
+	response.State = tfsdk.State{Raw: raw, Schema: s.with}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(setRegion(ctx, w.meta, &response.State)...)
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// newResourceIdentity returns the Plugin SDK resource identity for the specified service package resource identity.
+func newResourceIdentity(v *types.ServicePackageResourceIdentity) *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			m := map[string]*schema.Schema{
+				names.AttrAccountID: {
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+				},
+			}
+
+			if !v.Global {
+				m[names.AttrRegion] = &schema.Schema{
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+				}
+			}
+
+			for _, attr := range v.Attributes {
+				m[attr] = &schema.Schema{
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+				}
+			}
+
+			return m
+		},
+	}
+}
+
+// identityData is implemented by schema.ResourceData for resources with an identity schema.
+type identityData interface {
+	Identity() (*schema.IdentityData, error)
+}
+
+// identityInterceptor sets a resource's identity after it has been created, read or updated.
+type identityInterceptor struct {
+	identity *types.ServicePackageResourceIdentity
+}
+
+func (r identityInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when != After {
+		return ctx, diags
+	}
+
+	switch why {
+	case Create, Read, Update:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if d.Id() == "" {
+			return ctx, diags
+		}
+
+		v, ok := d.(identityData)
+		if !ok {
+			return ctx, diags
+		}
+
+		identity, err := v.Identity()
+		if err != nil {
+			return ctx, sdkdiag.AppendErrorf(diags, "getting identity: %s", err)
+		}
+
+		if err := setIdentity(ctx, identity, d, meta.(*conns.AWSClient), r.identity); err != nil {
+			return ctx, sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return ctx, diags
+}
+
+// setIdentity sets the identity attributes for the specified resource.
+func setIdentity(ctx context.Context, identity *schema.IdentityData, d schemaResourceData, meta *conns.AWSClient, v *types.ServicePackageResourceIdentity) error {
+	if err := identity.Set(names.AttrAccountID, meta.AccountID); err != nil {
+		return fmt.Errorf("setting identity %s: %w", names.AttrAccountID, err)
+	}
+
+	if !v.Global {
+		if err := identity.Set(names.AttrRegion, meta.RegionForContext(ctx)); err != nil {
+			return fmt.Errorf("setting identity %s: %w", names.AttrRegion, err)
+		}
+	}
+
+	for _, attr := range v.Attributes {
+		var value any
+		if len(v.Attributes) == 1 {
+			value = d.Id()
+		} else {
+			value = d.Get(attr)
+		}
+
+		if err := identity.Set(attr, value); err != nil {
+			return fmt.Errorf("setting identity %s: %w", attr, err)
+		}
+	}
+
+	return nil
+}
+
+// importIdentity handles import by identity.
+// The resource ID and any Region override are set from the practitioner-supplied identity.
+func importIdentity(ctx context.Context, d *schema.ResourceData, meta *conns.AWSClient, v *types.ServicePackageResourceIdentity, regionOverride bool) (context.Context, error) {
+	// Imported by ID.
+	if d.Id() != "" {
+		return ctx, nil
+	}
+
+	if len(v.Attributes) != 1 {
+		return ctx, fmt.Errorf("import by identity is not supported for this resource, import by ID instead")
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		return ctx, fmt.Errorf("getting identity: %w", err)
+	}
+
+	if v, ok := identity.GetOk(names.AttrAccountID); ok {
+		if accountID := v.(string); accountID != meta.AccountID {
+			return ctx, fmt.Errorf("identity %s (%s) does not match provider account ID (%s)", names.AttrAccountID, accountID, meta.AccountID)
+		}
+	}
+
+	attr := v.Attributes[0]
+	id, ok := identity.GetOk(attr)
+	if !ok {
+		return ctx, fmt.Errorf("expected identity to contain %s", attr)
+	}
+	d.SetId(id.(string))
+
+	if v.Global {
+		return ctx, nil
+	}
+
+	if v, ok := identity.GetOk(names.AttrRegion); ok {
+		if region := v.(string); region != meta.Region {
+			if !regionOverride {
+				return ctx, fmt.Errorf("identity %s (%s) does not match provider Region (%s)", names.AttrRegion, region, meta.Region)
+			}
+
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return ctx, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+			}
+
+			if inContext, ok := conns.FromContext(ctx); ok {
+				inContext.OverrideRegion = region
+			}
+		}
+	}
+
+	return ctx, nil
+}
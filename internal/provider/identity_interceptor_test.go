@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestNewResourceIdentity(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		identity       *types.ServicePackageResourceIdentity
+		expectedRegion bool
+	}{
+		{
+			name: "regional",
+			identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrName},
+			},
+			expectedRegion: true,
+		},
+		{
+			name: "global",
+			identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrName},
+				Global:     true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			v := newResourceIdentity(testCase.identity)
+
+			if err := v.InternalIdentityValidate(); err != nil {
+				t.Fatalf("InternalIdentityValidate: %s", err)
+			}
+
+			m := v.SchemaMap()
+			if _, ok := m[names.AttrAccountID]; !ok {
+				t.Errorf("no %s identity attribute", names.AttrAccountID)
+			}
+			if _, ok := m[names.AttrRegion]; ok != testCase.expectedRegion {
+				t.Errorf("%s identity attribute = %v, want %v", names.AttrRegion, ok, testCase.expectedRegion)
+			}
+			if v, ok := m[names.AttrName]; !ok {
+				t.Errorf("no %s identity attribute", names.AttrName)
+			} else if !v.RequiredForImport {
+				t.Errorf("%s identity attribute is not RequiredForImport", names.AttrName)
+			}
+		})
+	}
+}
+
+func TestIdentityInterceptor(t *testing.T) {
+	t.Parallel()
+
+	identity := &types.ServicePackageResourceIdentity{
+		Attributes: []string{names.AttrName},
+	}
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		Identity: newResourceIdentity(identity),
+	}
+	d := r.TestResourceData()
+	d.SetId("test")
+	meta := &conns.AWSClient{
+		AccountID: "123456789012",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+	ctx := conns.NewResourceContext(context.Background(), "test", "Test")
+
+	_, diags := identityInterceptor{identity: identity}.run(ctx, d, meta, After, Create, nil)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	v, err := d.Identity()
+	if err != nil {
+		t.Fatalf("getting identity: %s", err)
+	}
+
+	for k, want := range map[string]string{
+		names.AttrAccountID: "123456789012",
+		names.AttrRegion:    "us-west-2", //lintignore:AWSAT003
+		names.AttrName:      "test",
+	} {
+		if got := v.Get(k).(string); got != want {
+			t.Errorf("identity %s = %v, want %v", k, got, want)
+		}
+	}
+}
+
+func TestImportIdentity(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		importID       string
+		identity       map[string]string
+		regionOverride bool
+		expectedID     string
+		expectedRegion string
+		expectError    bool
+	}{
+		{
+			name:       "import by ID",
+			importID:   "test",
+			expectedID: "test",
+		},
+		{
+			name: "import by identity",
+			identity: map[string]string{
+				names.AttrName: "test",
+			},
+			expectedID: "test",
+		},
+		{
+			name: "provider account and Region",
+			identity: map[string]string{
+				names.AttrAccountID: "123456789012",
+				names.AttrRegion:    "us-west-2", //lintignore:AWSAT003
+				names.AttrName:      "test",
+			},
+			expectedID: "test",
+		},
+		{
+			name: "other account",
+			identity: map[string]string{
+				names.AttrAccountID: "210987654321",
+				names.AttrName:      "test",
+			},
+			expectError: true,
+		},
+		{
+			name: "other Region",
+			identity: map[string]string{
+				names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+				names.AttrName:   "test",
+			},
+			regionOverride: true,
+			expectedID:     "test",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			name: "other Region no override",
+			identity: map[string]string{
+				names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+				names.AttrName:   "test",
+			},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			identity := &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrName},
+			}
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrName: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+				Identity: newResourceIdentity(identity),
+			}
+			injectRegionAttribute(r, false)
+			d := schema.TestResourceDataWithIdentityRaw(t, r.SchemaMap(), r.Identity.SchemaMap(), testCase.identity)
+			d.SetId(testCase.importID)
+			meta := &conns.AWSClient{
+				AccountID: "123456789012",
+				Region:    "us-west-2", //lintignore:AWSAT003
+			}
+			ctx := conns.NewResourceContext(context.Background(), "test", "Test")
+
+			ctx, err := importIdentity(ctx, d, meta, identity, testCase.regionOverride)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("importIdentity error = %v, expectError %v", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := d.Id(), testCase.expectedID; got != want {
+				t.Errorf("ID = %v, want %v", got, want)
+			}
+			if got, want := d.Get(names.AttrRegion).(string), testCase.expectedRegion; got != want {
+				t.Errorf("%s = %v, want %v", names.AttrRegion, got, want)
+			}
+			inContext, _ := conns.FromContext(ctx)
+			if got, want := inContext.OverrideRegion, testCase.expectedRegion; got != want {
+				t.Errorf("OverrideRegion = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -106,6 +106,9 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 			}
 		}
 
+		// Values derived from the provider's Region, e.g. ARNs, follow any per-resource Region override.
+		meta = regionalMeta(ctx, meta)
+
 		// All other interceptors are run last to first.
 		reverse := slices.Reverse(forward)
 		diags = f(ctx, d, meta)
@@ -151,6 +154,10 @@ type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     interceptorItems
+	// regionOverride is true if the resource supports per-resource Region override.
+	regionOverride bool
+	// identity is non-nil if the resource has a structured identity.
+	identity *types.ServicePackageResourceIdentity
 }
 
 func (r *wrappedResource) Create(f schema.CreateContextFunc) schema.CreateContextFunc {
@@ -173,6 +180,26 @@ func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFu
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = r.bootstrapContext(ctx, meta)
 
+		if r.identity != nil {
+			var err error
+			ctx, err = importIdentity(ctx, d, meta.(*conns.AWSClient), r.identity, r.regionOverride)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if r.regionOverride {
+			var err error
+			ctx, err = importRegion(ctx, d)
+
+			if err != nil {
+				return nil, err
+			}
+
+			meta = regionalMeta(ctx, meta)
+		}
+
 		return f(ctx, d, meta)
 	}
 }
@@ -243,6 +270,12 @@ func (r tagsInterceptor) run(ctx context.Context, d schemaResourceData, meta any
 
 			tagsInContext.TagsIn = types.Some(tags)
 
+			// Check the merged tags against any effective tag policy.
+			diags = append(diags, tagPolicyDiags(ctx, meta.(*conns.AWSClient), tags, inContext.ServicePackageName, inContext.ResourceName)...)
+			if diags.HasError() {
+				return ctx, diags
+			}
+
 			if why == Create {
 				break
 			}
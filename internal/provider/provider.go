@@ -17,9 +17,11 @@ import (
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
@@ -46,6 +48,27 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"api_rate_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings for client-side rate limiting of AWS API calls.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"adaptive": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Reduce the rate of AWS API calls to all services when throttling errors are returned.",
+						},
+						"requests_per_second": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeFloat},
+							Description: "Maximum rate of AWS API calls per second, keyed by service name as used in the `endpoints` block.",
+						},
+					},
+				},
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 			"custom_ca_bundle": {
@@ -62,6 +85,33 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Resource tags to default across matching resources. Later rules take precedence over earlier rules.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource type glob patterns, e.g. `aws_ec2_*`, matched by the rule.",
+									},
+									"services": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Service names, as used in the `endpoints` block, matched by the rule.",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tags to default across matching resources",
+									},
+								},
+							},
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -83,6 +133,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Protocol to use with EC2 metadata service endpoint." +
 					"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"endpoint_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Base endpoint URL to use for all AWS API calls, e.g. a local AWS API emulator. " +
+					"Can also be configured using the `AWS_ENDPOINT_URL` environment variable. " +
+					"Service endpoints configured in the `endpoints` block or using the `AWS_ENDPOINT_URL_<SERVICE>` environment variables take precedence.",
+			},
 			"endpoints": endpointsSchema(),
 			"forbidden_account_ids": {
 				Type:          schema.TypeSet,
@@ -206,6 +263,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy_compliance": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The action taken when a resource's tags do not comply with the account's effective AWS Organizations tag policy. Valid values are `disabled`, `error` and `warning`.",
+				ValidateDiagFunc: enum.Validate[tftags.TagPolicyCompliance](),
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -263,12 +326,26 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx).ForResource(servicePackageName, typeName), v.IgnoreTagsConfig)
 				}
 
 				return ctx
 			}
 			interceptors := interceptorItems{}
+
+			// Data sources support per-data source Region override.
+			if injectRegionAttribute(r, true) {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			} else {
+				tflog.Debug(ctx, "data source defines its own region attribute, per-data source Region override not supported", map[string]any{
+					"data_source": typeName,
+				})
+			}
+
 			ds := &wrappedDataSource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -314,13 +391,34 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx).ForResource(servicePackageName, typeName), v.IgnoreTagsConfig)
 				}
 
 				return ctx
 			}
 			interceptors := interceptorItems{}
 
+			// Resources support per-resource Region override.
+			// The region interceptor must run before any other interceptors that make AWS API calls.
+			regionOverride := injectRegionAttribute(r, false)
+			if regionOverride {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionInterceptor{},
+				})
+
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(regionCustomizeDiff, v)
+				} else {
+					r.CustomizeDiff = regionCustomizeDiff
+				}
+			} else {
+				tflog.Debug(ctx, "resource defines its own region attribute, per-resource Region override not supported", map[string]any{
+					"resource": typeName,
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -354,11 +452,35 @@ func New(ctx context.Context) (*schema.Provider, error) {
 						readFunc:   tagsReadFunc,
 					},
 				})
+
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(v, tagPolicyCustomizeDiff)
+				} else {
+					r.CustomizeDiff = tagPolicyCustomizeDiff
+				}
+			}
+
+			if v.Identity != nil {
+				// The resource has a structured identity.
+				if r.Identity != nil {
+					errs = multierror.Append(errs, fmt.Errorf("resource identity already defined: %s", typeName))
+					continue
+				}
+
+				r.Identity = newResourceIdentity(v.Identity)
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Read | Update,
+					interceptor: identityInterceptor{identity: v.Identity},
+				})
 			}
 
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
+				regionOverride:   regionOverride,
+				identity:         v.Identity,
 			}
 
 			if v := r.CreateWithoutTimeout; v != nil {
@@ -424,6 +546,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
+		EndpointURL:                    d.Get("endpoint_url").(string),
 		Endpoints:                      make(map[string]string),
 		HTTPProxy:                      d.Get("http_proxy").(string),
 		Insecure:                       d.Get("insecure").(bool),
@@ -436,6 +559,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
 		STSRegion:                      d.Get("sts_region").(string),
+		TagPolicyCompliance:            tftags.TagPolicyCompliance(d.Get("tag_policy_compliance").(string)),
 		TerraformVersion:               terraformVersion,
 		Token:                          d.Get("token").(string),
 		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
@@ -454,14 +578,34 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("api_rate_limits"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiRateLimits, err := expandAPIRateLimits(ctx, v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.APIRateLimits = apiRateLimits
+	}
+
+	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 {
+		assumeRoles, err := expandAssumeRoles(ctx, v.([]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		for i, assumeRole := range assumeRoles {
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.hop":             i + 1,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+		}
+
+		config.AssumeRole = assumeRoles
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -486,6 +630,12 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.Endpoints = endpoints
 	}
 
+	expandEndpointsFromEnv(ctx, config.Endpoints)
+
+	if config.EndpointURL == "" {
+		config.EndpointURL = os.Getenv(names.AWSEndpointURLEnvVar)
+	}
+
 	if v, ok := d.GetOk("forbidden_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -531,9 +681,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "IAM Roles to assume, in order, each using the credentials of the previous role.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -669,6 +819,16 @@ func endpointsSchema() *schema.Schema {
 		}
 	}
 
+	for serviceKey, humanFriendly := range names.RetiredServices() {
+		endpointsAttributes[serviceKey] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "Use this to override the default service endpoint URL",
+			Deprecated:  fmt.Sprintf("%s has been retired by AWS. The %s endpoint is ignored and will be removed in a future major version.", humanFriendly, serviceKey),
+		}
+	}
+
 	return &schema.Schema{
 		Type:     schema.TypeSet,
 		Optional: true,
@@ -678,6 +838,63 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func expandAPIRateLimits(_ context.Context, tfMap map[string]interface{}) (*conns.APIRateLimitsConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	apiRateLimits := &conns.APIRateLimitsConfig{}
+
+	if v, ok := tfMap["adaptive"].(bool); ok {
+		apiRateLimits.Adaptive = v
+	}
+
+	if v, ok := tfMap["requests_per_second"].(map[string]interface{}); ok && len(v) > 0 {
+		apiRateLimits.RequestsPerSecond = make(map[string]float64, len(v))
+
+		for alias, v := range v {
+			pkg, err := names.ProviderPackageForAlias(alias)
+
+			if err != nil {
+				return nil, fmt.Errorf("api_rate_limits (%s): %w", alias, err)
+			}
+
+			rate, ok := v.(float64)
+
+			if !ok || rate <= 0 {
+				return nil, fmt.Errorf("api_rate_limits (%s): requests per second must be greater than 0", alias)
+			}
+
+			apiRateLimits.RequestsPerSecond[pkg] = rate
+		}
+	}
+
+	return apiRateLimits, nil
+}
+
+// expandAssumeRoles expands an ordered chain of `assume_role` blocks.
+// A single block without a role ARN is ignored, but every block in a chain must specify a role ARN.
+func expandAssumeRoles(ctx context.Context, tfList []interface{}) ([]*awsbase.AssumeRole, error) {
+	var assumeRoles []*awsbase.AssumeRole
+
+	for i, tfMapRaw := range tfList {
+		tfMap, _ := tfMapRaw.(map[string]interface{})
+		assumeRole := expandAssumeRole(ctx, tfMap)
+
+		if assumeRole == nil || assumeRole.RoleARN == "" {
+			if len(tfList) == 1 {
+				return nil, nil
+			}
+
+			return nil, fmt.Errorf("assume_role (%d of %d): role_arn must be set when assuming a chain of IAM Roles", i+1, len(tfList))
+		}
+
+		assumeRoles = append(assumeRoles, assumeRole)
+	}
+
+	return assumeRoles, nil
+}
+
 func expandAssumeRole(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -775,9 +992,44 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
 
+	if v, ok := tfMap["rule"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			defaultConfig.Rules = append(defaultConfig.Rules, expandDefaultTagsRule(ctx, tfMap))
+		}
+	}
+
 	return defaultConfig
 }
 
+func expandDefaultTagsRule(ctx context.Context, tfMap map[string]interface{}) tftags.DefaultRule {
+	rule := tftags.DefaultRule{}
+
+	if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		rule.ResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["services"].(*schema.Set); ok && v.Len() > 0 {
+		for _, v := range flex.ExpandStringValueSet(v) {
+			// Accept any service alias, as in the endpoints block.
+			if pkg, err := names.ProviderPackageForAlias(v); err == nil {
+				v = pkg
+			}
+			rule.Services = append(rule.Services, v)
+		}
+	}
+
+	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
+		rule.Tags = tftags.New(ctx, v)
+	}
+
+	return rule
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	if tfMap == nil {
 		return nil
@@ -849,3 +1101,19 @@ func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string
 
 	return endpoints, nil
 }
+
+// expandEndpointsFromEnv sets the endpoints of any services not configured in the `endpoints` block
+// from the service-specific AWS_ENDPOINT_URL_<SERVICE> environment variables.
+func expandEndpointsFromEnv(_ context.Context, endpoints map[string]string) {
+	for _, pkg := range names.ProviderPackages() {
+		if endpoints[pkg] != "" {
+			continue
+		}
+
+		if envVar := names.AWSServiceEnvVar(pkg); envVar != "" {
+			if v := os.Getenv(envVar); v != "" {
+				endpoints[pkg] = v
+			}
+		}
+	}
+}
@@ -9,6 +9,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -187,6 +191,296 @@ func TestEndpointEnvVarPrecedence(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandEndpointsFromEnv(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+	testcases := []struct {
+		endpoints        map[string]string
+		envvars          map[string]string
+		expectedService  string
+		expectedEndpoint string
+	}{
+		{
+			endpoints: map[string]string{},
+			envvars: map[string]string{
+				"AWS_ENDPOINT_URL_SECRETS_MANAGER": "https://secretsmanager.fake.test",
+			},
+			expectedService:  names.SecretsManager,
+			expectedEndpoint: "https://secretsmanager.fake.test",
+		},
+		{
+			endpoints: map[string]string{
+				names.S3: "https://s3-config.fake.test",
+			},
+			envvars: map[string]string{
+				"AWS_ENDPOINT_URL_S3": "https://s3-env.fake.test",
+			},
+			expectedService:  names.S3,
+			expectedEndpoint: "https://s3-config.fake.test",
+		},
+		{
+			endpoints: map[string]string{},
+			envvars: map[string]string{
+				"AWS_ENDPOINT_URL": "https://fake.test",
+			},
+			expectedService:  names.STS,
+			expectedEndpoint: "",
+		},
+	}
+
+	for _, testcase := range testcases {
+		oldEnv := stashEnv()
+		defer popEnv(oldEnv)
+
+		for k, v := range testcase.envvars {
+			os.Setenv(k, v)
+		}
+
+		endpoints := make(map[string]string)
+		for k, v := range testcase.endpoints {
+			endpoints[k] = v
+		}
+
+		expandEndpointsFromEnv(ctx, endpoints)
+
+		if v := endpoints[testcase.expectedService]; v != testcase.expectedEndpoint {
+			t.Errorf("Expected endpoint[%s] to be %q, got %v", testcase.expectedService, testcase.expectedEndpoint, endpoints)
+		}
+	}
+}
+
+func TestExpandAPIRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := []struct {
+		name        string
+		tfMap       map[string]interface{}
+		expected    *conns.APIRateLimitsConfig
+		expectError bool
+	}{
+		{
+			name: "adaptive",
+			tfMap: map[string]interface{}{
+				"adaptive":            true,
+				"requests_per_second": map[string]interface{}{},
+			},
+			expected: &conns.APIRateLimitsConfig{
+				Adaptive: true,
+			},
+		},
+		{
+			name: "services",
+			tfMap: map[string]interface{}{
+				"adaptive": false,
+				"requests_per_second": map[string]interface{}{
+					"iam":     5.0,
+					"route53": 2.5,
+				},
+			},
+			expected: &conns.APIRateLimitsConfig{
+				RequestsPerSecond: map[string]float64{
+					names.IAM:     5,
+					names.Route53: 2.5,
+				},
+			},
+		},
+		{
+			name: "alias",
+			tfMap: map[string]interface{}{
+				"requests_per_second": map[string]interface{}{
+					"cloudwatchlog": 10.0,
+				},
+			},
+			expected: &conns.APIRateLimitsConfig{
+				RequestsPerSecond: map[string]float64{
+					names.Logs: 10,
+				},
+			},
+		},
+		{
+			name: "unknown service",
+			tfMap: map[string]interface{}{
+				"requests_per_second": map[string]interface{}{
+					"doesnotexist": 1.0,
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "zero rate",
+			tfMap: map[string]interface{}{
+				"requests_per_second": map[string]interface{}{
+					"iam": 0.0,
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandAPIRateLimits(ctx, testcase.tfMap)
+
+			if got, want := err != nil, testcase.expectError; got != want {
+				t.Fatalf("expandAPIRateLimits error = %v, expectError %v", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandAssumeRoles(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := []struct {
+		name        string
+		tfList      []interface{}
+		expected    []*awsbase.AssumeRole
+		expectError bool
+	}{
+		{
+			name:   "empty block",
+			tfList: []interface{}{nil},
+		},
+		{
+			name: "no role ARN",
+			tfList: []interface{}{
+				map[string]interface{}{
+					"session_name": "session",
+				},
+			},
+		},
+		{
+			name: "single",
+			tfList: []interface{}{
+				map[string]interface{}{
+					"role_arn":     "arn:aws:iam::123456789012:role/hub", //lintignore:AWSAT005
+					"session_name": "hub",
+				},
+			},
+			expected: []*awsbase.AssumeRole{
+				{
+					RoleARN:     "arn:aws:iam::123456789012:role/hub", //lintignore:AWSAT005
+					SessionName: "hub",
+				},
+			},
+		},
+		{
+			name: "chain",
+			tfList: []interface{}{
+				map[string]interface{}{
+					"role_arn":     "arn:aws:iam::123456789012:role/hub", //lintignore:AWSAT005
+					"session_name": "hub",
+				},
+				map[string]interface{}{
+					"external_id":  "external-id",
+					"role_arn":     "arn:aws:iam::210987654321:role/workload", //lintignore:AWSAT005
+					"session_name": "workload",
+					"tags": map[string]interface{}{
+						"team": "platform",
+					},
+				},
+			},
+			expected: []*awsbase.AssumeRole{
+				{
+					RoleARN:     "arn:aws:iam::123456789012:role/hub", //lintignore:AWSAT005
+					SessionName: "hub",
+				},
+				{
+					ExternalID:  "external-id",
+					RoleARN:     "arn:aws:iam::210987654321:role/workload", //lintignore:AWSAT005
+					SessionName: "workload",
+					Tags: map[string]string{
+						"team": "platform",
+					},
+				},
+			},
+		},
+		{
+			name: "chain without role ARN",
+			tfList: []interface{}{
+				map[string]interface{}{
+					"role_arn": "arn:aws:iam::123456789012:role/hub", //lintignore:AWSAT005
+				},
+				map[string]interface{}{
+					"session_name": "workload",
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandAssumeRoles(ctx, testcase.tfList)
+
+			if got, want := err != nil, testcase.expectError; got != want {
+				t.Fatalf("expandAssumeRoles error = %v, expectError %v", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testcase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandDefaultTagsRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	got := expandDefaultTags(ctx, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"Owner": "platform",
+		},
+		"rule": []interface{}{
+			map[string]interface{}{
+				"resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_ec2_*"}),
+				"services":       schema.NewSet(schema.HashString, []interface{}{}),
+				"tags": map[string]interface{}{
+					"CostCenter": "100",
+				},
+			},
+			map[string]interface{}{
+				"resource_types": schema.NewSet(schema.HashString, []interface{}{}),
+				"services":       schema.NewSet(schema.HashString, []interface{}{"cloudwatchlog"}),
+				"tags": map[string]interface{}{
+					"Owner": "observability",
+				},
+			},
+		},
+	})
+
+	if got, want := len(got.Rules), 2; got != want {
+		t.Fatalf("got %d rules, expected %d", got, want)
+	}
+	if diff := cmp.Diff(got.Rules[0].ResourceTypes, []string{"aws_ec2_*"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+	if diff := cmp.Diff(got.Rules[1].Services, []string{names.Logs}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+	if diff := cmp.Diff(got.ForResource(names.Logs, "aws_cloudwatch_log_group").Tags.Map(), map[string]string{"Owner": "observability"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var importIDRegionRegexp = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+
+// regionSchema returns the schema for the injected top-level `region` attribute.
+func regionSchema(isDataSource bool) *schema.Schema {
+	v := &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "The AWS Region in which this resource is managed. Defaults to the Region set in the provider configuration.",
+		ValidateFunc: verify.ValidRegionName,
+	}
+
+	if !isDataSource {
+		v.ForceNew = true
+	}
+
+	return v
+}
+
+// injectRegionAttribute adds a top-level `region` attribute to the resource's schema.
+// It returns false if the schema already defines a `region` attribute, in which case
+// the resource does not support per-resource Region override.
+func injectRegionAttribute(r *schema.Resource, isDataSource bool) bool {
+	if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+		return false
+	}
+
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			m[names.AttrRegion] = regionSchema(isDataSource)
+			return m
+		}
+	} else {
+		if r.Schema == nil {
+			r.Schema = make(map[string]*schema.Schema)
+		}
+		r.Schema[names.AttrRegion] = regionSchema(isDataSource)
+	}
+
+	return true
+}
+
+// regionInterceptor implements per-resource Region override.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		// Any AWS API clients used by the CRUD handler target the overridden Region.
+		if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+			inContext.OverrideRegion = v
+		}
+	case After:
+		switch why {
+		case Read:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			fallthrough
+		case Create, Update:
+			if err := d.Set(names.AttrRegion, meta.(*conns.AWSClient).RegionForContext(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// regionalMeta returns the provider's client rebased onto any per-resource Region override in Context.
+// Resources that read `meta.(*conns.AWSClient).Region`, e.g. to build ARNs, then see the overridden Region.
+func regionalMeta(ctx context.Context, meta any) any {
+	if v, ok := meta.(*conns.AWSClient); ok {
+		return v.ForRegion(v.RegionForContext(ctx))
+	}
+
+	return meta
+}
+
+// regionCustomizeDiff forces replacement of a resource previously managed in an overridden Region
+// when the `region` attribute is removed from configuration.
+func regionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if !d.GetRawConfig().GetAttr(names.AttrRegion).IsNull() {
+		return nil
+	}
+
+	providerRegion := meta.(*conns.AWSClient).Region
+	if v := d.Get(names.AttrRegion).(string); v == "" || v == providerRegion {
+		return nil
+	}
+
+	if err := d.SetNew(names.AttrRegion, providerRegion); err != nil {
+		return err
+	}
+
+	return d.ForceNew(names.AttrRegion)
+}
+
+// importRegion handles an import ID of the form `id@region`.
+// The Region suffix is removed from the ID and used as the resource's Region override.
+func importRegion(ctx context.Context, d *schema.ResourceData) (context.Context, error) {
+	id := d.Id()
+	i := strings.LastIndex(id, "@")
+	if i < 0 {
+		return ctx, nil
+	}
+
+	region := id[i+1:]
+	if !importIDRegionRegexp.MatchString(region) {
+		return ctx, nil
+	}
+
+	if i == 0 {
+		return ctx, fmt.Errorf("unexpected format for import ID (%s), expected <id>@<region>", id)
+	}
+
+	d.SetId(id[:i])
+	if err := d.Set(names.AttrRegion, region); err != nil {
+		return ctx, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+	}
+
+	if inContext, ok := conns.FromContext(ctx); ok {
+		inContext.OverrideRegion = region
+	}
+
+	return ctx, nil
+}
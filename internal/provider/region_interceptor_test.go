@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestInjectRegionAttribute(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+
+	if got, want := injectRegionAttribute(r, false), true; got != want {
+		t.Errorf("injectRegionAttribute = %v, want %v", got, want)
+	}
+	if v, ok := r.SchemaMap()[names.AttrRegion]; !ok {
+		t.Errorf("no %s attribute injected", names.AttrRegion)
+	} else if !v.ForceNew {
+		t.Errorf("injected %s attribute is not ForceNew", names.AttrRegion)
+	}
+
+	// Already has a `region` attribute.
+	if got, want := injectRegionAttribute(r, false), false; got != want {
+		t.Errorf("injectRegionAttribute = %v, want %v", got, want)
+	}
+}
+
+func TestImportRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		importID       string
+		expectedID     string
+		expectedRegion string
+		expectError    bool
+	}{
+		{
+			name:       "no Region",
+			importID:   "vpc-12345678",
+			expectedID: "vpc-12345678",
+		},
+		{
+			name:           "Region",
+			importID:       "vpc-12345678@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "vpc-12345678",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			name:       "not a Region",
+			importID:   "user@example.com",
+			expectedID: "user@example.com",
+		},
+		{
+			name:        "empty ID",
+			importID:    "@eu-west-1", //lintignore:AWSAT003
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{},
+			}
+			injectRegionAttribute(r, false)
+			d := r.TestResourceData()
+			d.SetId(testCase.importID)
+			ctx := conns.NewResourceContext(context.Background(), "test", "Test")
+
+			ctx, err := importRegion(ctx, d)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("importRegion error = %v, expectError %v", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := d.Id(), testCase.expectedID; got != want {
+				t.Errorf("ID = %v, want %v", got, want)
+			}
+			if got, want := d.Get(names.AttrRegion).(string), testCase.expectedRegion; got != want {
+				t.Errorf("%s = %v, want %v", names.AttrRegion, got, want)
+			}
+			inContext, _ := conns.FromContext(ctx)
+			if got, want := inContext.OverrideRegion, testCase.expectedRegion; got != want {
+				t.Errorf("OverrideRegion = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestRegionInterceptorRebasesMeta(t *testing.T) {
+	t.Parallel()
+
+	meta := &conns.AWSClient{
+		AccountID: "123456789012",
+		DNSSuffix: "amazonaws.com",
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	testCases := []struct {
+		name           string
+		region         string
+		expectedRegion string
+	}{
+		{
+			name:           "no override",
+			expectedRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			name:           "override",
+			region:         "eu-west-1", //lintignore:AWSAT003
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotRegion, gotHostname string
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{},
+			}
+			injectRegionAttribute(r, false)
+			read := func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				// Region-derived values, as a resource's Read would build them.
+				gotRegion = meta.(*conns.AWSClient).Region
+				gotHostname = meta.(*conns.AWSClient).RegionalHostname("sqs")
+
+				return nil
+			}
+			bootstrapContext := func(ctx context.Context, _ any) context.Context {
+				return conns.NewResourceContext(ctx, "test", "Test")
+			}
+			interceptors := interceptorItems{{
+				when:        Before | After,
+				why:         AllOps,
+				interceptor: regionInterceptor{},
+			}}
+
+			d := r.TestResourceData()
+			d.SetId("test")
+			if testCase.region != "" {
+				if err := d.Set(names.AttrRegion, testCase.region); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			diags := interceptedHandler(bootstrapContext, interceptors, schema.ReadContextFunc(read), Read)(context.Background(), d, meta)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got, want := gotRegion, testCase.expectedRegion; got != want {
+				t.Errorf("Region = %v, want %v", got, want)
+			}
+			if got, want := gotHostname, "sqs."+testCase.expectedRegion+".amazonaws.com"; got != want {
+				t.Errorf("RegionalHostname = %v, want %v", got, want)
+			}
+			if got, want := d.Get(names.AttrRegion).(string), testCase.expectedRegion; got != want {
+				t.Errorf("%s = %v, want %v", names.AttrRegion, got, want)
+			}
+		})
+	}
+}
+
+// TestRegionOverrideExclusions lists the resources and data sources whose own schema defines a `region` attribute,
+// and which therefore do not support per-resource Region override.
+// The list is also documented in website/docs/index.html.markdown, which must be updated when this test's expectations change.
+func TestRegionOverrideExclusions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var dataSources, resources []string
+
+	for _, sp := range servicePackages(ctx) {
+		for _, v := range sp.SDKDataSources(ctx) {
+			if _, ok := v.Factory().SchemaMap()[names.AttrRegion]; ok {
+				dataSources = append(dataSources, v.TypeName)
+			}
+		}
+
+		for _, v := range sp.SDKResources(ctx) {
+			if _, ok := v.Factory().SchemaMap()[names.AttrRegion]; ok {
+				resources = append(resources, v.TypeName)
+			}
+		}
+
+		for _, v := range sp.FrameworkDataSources(ctx) {
+			inner, err := v.Factory(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var schemaResponse datasource.SchemaResponse
+			inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+			if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+				var metadataResponse datasource.MetadataResponse
+				inner.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "aws"}, &metadataResponse)
+				dataSources = append(dataSources, metadataResponse.TypeName)
+			}
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			inner, err := v.Factory(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var schemaResponse resource.SchemaResponse
+			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+			if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+				var metadataResponse resource.MetadataResponse
+				inner.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &metadataResponse)
+				resources = append(resources, metadataResponse.TypeName)
+			}
+		}
+	}
+
+	expectedDataSources := []string{
+		"aws_arn",
+		"aws_availability_zone",
+		"aws_cloudfront_log_delivery_canonical_user_id",
+		"aws_cloudtrail_service_account",
+		"aws_elastic_beanstalk_hosted_zone",
+		"aws_elb_hosted_zone_id",
+		"aws_elb_service_account",
+		"aws_lb_hosted_zone_id",
+		"aws_redshift_service_account",
+		"aws_s3_bucket",
+		"aws_sagemaker_prebuilt_ecr_image",
+		"aws_service",
+		"aws_ssmincidents_replication_set",
+		"aws_vpc_peering_connection",
+	}
+	expectedResources := []string{
+		"aws_cloudformation_stack_set_instance",
+		"aws_config_aggregate_authorization",
+		"aws_dx_hosted_connection",
+		"aws_lightsail_bucket",
+		"aws_opsworks_stack",
+		"aws_s3_bucket",
+		"aws_sns_platform_application",
+		"aws_sns_sms_preferences",
+		"aws_sns_topic",
+		"aws_sns_topic_subscription",
+		"aws_sqs_queue",
+		"aws_ssmincidents_replication_set",
+	}
+
+	sort.Strings(dataSources)
+	sort.Strings(resources)
+
+	if diff := cmp.Diff(dataSources, expectedDataSources); diff != "" {
+		t.Errorf("data sources excluded from Region override: unexpected diff (+wanted, -got): %s", diff)
+	}
+	if diff := cmp.Diff(resources, expectedResources); diff != "" {
+		t.Errorf("resources excluded from Region override: unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -5,9 +5,11 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -160,3 +162,61 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 
 	return ctx, diags
 }
+
+// tagPolicyCustomizeDiff checks, during plan, that a resource's effective tags comply with the account's effective tag policy.
+// Plugin SDK v2 CustomizeDiff functions can't return warnings, so any warnings are reported by tagsInterceptor on Create or Update.
+func tagPolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	client := meta.(*conns.AWSClient)
+	if client.TagPolicyCompliance != tftags.TagPolicyComplianceError {
+		return nil
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	if !d.NewValueKnown(names.AttrTagsAll) {
+		return nil
+	}
+
+	tags := tftags.New(ctx, d.Get(names.AttrTagsAll).(map[string]interface{})).IgnoreSystem(inContext.ServicePackageName)
+
+	return sdkdiag.DiagnosticsError(tagPolicyDiags(ctx, client, tags, inContext.ServicePackageName, inContext.ResourceName))
+}
+
+// tagPolicyDiags returns a Diagnostic, with severity according to the provider's tag_policy_compliance setting,
+// for each way that the specified tags do not comply with the account's effective tag policy.
+func tagPolicyDiags(ctx context.Context, client *conns.AWSClient, tags tftags.KeyValueTags, servicePackageName, resourceName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	severity := diag.Warning
+	if client.TagPolicyCompliance == tftags.TagPolicyComplianceError {
+		severity = diag.Error
+	}
+
+	violations, err := client.TagPolicyViolations(ctx, tags, tftags.TagPolicyResourceType(servicePackageName, resourceName))
+
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  "checking tag policy compliance",
+			Detail:   err.Error(),
+		})
+	}
+
+	serviceName, err := names.HumanFriendly(servicePackageName)
+	if err != nil {
+		serviceName = "<service>"
+	}
+
+	for _, v := range violations {
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  fmt.Sprintf("%s %s tags do not comply with tag policy", serviceName, resourceName),
+			Detail:   v,
+		})
+	}
+
+	return diags
+}
@@ -21,6 +21,10 @@ func (t *mockService) FrameworkDataSources(ctx context.Context) []*types.Service
 	return []*types.ServicePackageFrameworkDataSource{}
 }
 
+func (t *mockService) FrameworkEphemeralResources(ctx context.Context) []*types.ServicePackageFrameworkEphemeralResource {
+	return []*types.ServicePackageFrameworkEphemeralResource{}
+}
+
 func (t *mockService) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{}
 }
@@ -29,6 +33,10 @@ func (t *mockService) SDKDataSources(ctx context.Context) []*types.ServicePackag
 	return []*types.ServicePackageSDKDataSource{}
 }
 
+func (t *mockService) SDKListResources(ctx context.Context) []*types.ServicePackageSDKListResource {
+	return []*types.ServicePackageSDKListResource{}
+}
+
 func (t *mockService) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{}
 }
@@ -77,18 +85,18 @@ func TestTagsInterceptor(t *testing.T) {
 		ServicePackages: map[string]conns.ServicePackage{
 			"Test": &mockService{},
 		},
-		DefaultTagsConfig: expandDefaultTags(context.Background(), map[string]interface{}{
-			"tag": "",
-		}),
 		IgnoreTagsConfig: expandIgnoreTags(context.Background(), map[string]interface{}{
 			"tag2": "tag",
 		}),
 	}
+	defaultTagsConfig := expandDefaultTags(context.Background(), map[string]interface{}{
+		"tag": "",
+	})
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
 		ctx = conns.NewResourceContext(ctx, "Test", "aws_test")
 		if v, ok := meta.(*conns.AWSClient); ok {
-			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+			ctx = tftags.NewContext(ctx, defaultTagsConfig, v.IgnoreTagsConfig)
 		}
 
 		return ctx
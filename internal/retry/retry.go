@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+type (
+	NotFoundError        = sdkretry.NotFoundError
+	RetryError           = sdkretry.RetryError
+	RetryFunc            = sdkretry.RetryFunc
+	StateRefreshFunc     = sdkretry.StateRefreshFunc
+	TimeoutError         = sdkretry.TimeoutError
+	UnexpectedStateError = sdkretry.UnexpectedStateError
+)
+
+// RetryableError is a helper to create a RetryError that's retryable from a given error.
+func RetryableError(err error) *RetryError {
+	return sdkretry.RetryableError(err)
+}
+
+// NonRetryableError is a helper to create a RetryError that's _not_ retryable from a given error.
+func NonRetryableError(err error) *RetryError {
+	return sdkretry.NonRetryableError(err)
+}
+
+// RetryContext is a basic wrapper around StateChangeConf that will just retry
+// a function until it no longer returns an error.
+func RetryContext(ctx context.Context, timeout time.Duration, f RetryFunc) error {
+	// These are used to pull the error out of the function; need a mutex to
+	// avoid a data race.
+	var resultErr error
+	var resultErrMu sync.Mutex
+
+	c := &StateChangeConf{
+		Pending:    []string{"retryableerror"},
+		Target:     []string{"success"},
+		Timeout:    timeout,
+		MinTimeout: 500 * time.Millisecond,
+		Refresh: func() (interface{}, string, error) {
+			rerr := f()
+
+			resultErrMu.Lock()
+			defer resultErrMu.Unlock()
+
+			if rerr == nil {
+				resultErr = nil
+				return 42, "success", nil
+			}
+
+			resultErr = rerr.Err
+
+			if rerr.Retryable {
+				return 42, "retryableerror", nil
+			}
+			return nil, "quit", rerr.Err
+		},
+	}
+
+	_, waitErr := c.WaitForStateContext(ctx)
+
+	// Need to acquire the lock here to be able to avoid race using resultErr as
+	// the return value
+	resultErrMu.Lock()
+	defer resultErrMu.Unlock()
+
+	// resultErr may be nil because the wait timed out and resultErr was never
+	// set; this is still an error
+	if resultErr == nil {
+		return waitErr
+	}
+	// resultErr takes precedence over waitErr if both are set because it is
+	// more likely to be useful
+	return resultErr
+}
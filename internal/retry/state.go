@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"time"
+
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+)
+
+// replayPollInterval is the interval between refreshes when replaying recorded AWS API interactions.
+const replayPollInterval = 1 * time.Millisecond
+
+type noDelaysKey struct{}
+
+// WithoutDelays returns a context in which state change waits don't delay between refreshes.
+// It is used when AWS APIs are served by an in-process fake.
+func WithoutDelays(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noDelaysKey{}, true)
+}
+
+func delaysDisabled(ctx context.Context) bool {
+	v, _ := ctx.Value(noDelaysKey{}).(bool)
+	return v
+}
+
+// StateChangeConf is the configuration struct used for `WaitForStateContext`.
+// It has the same fields as the Terraform Plugin SDK's retry.StateChangeConf.
+type StateChangeConf sdkretry.StateChangeConf
+
+// WaitForStateContext watches an object and waits for it to achieve the state
+// specified in the configuration using the specified Refresh() func,
+// waiting the number of seconds specified in the timeout configuration.
+// See the Terraform Plugin SDK's retry.StateChangeConf.WaitForStateContext.
+//
+// When replaying recorded AWS API interactions, or if the context was created by WithoutDelays,
+// there is no delay before the first refresh and no wait between refreshes.
+func (conf *StateChangeConf) WaitForStateContext(ctx context.Context) (interface{}, error) {
+	c := sdkretry.StateChangeConf(*conf)
+
+	if vcr.IsReplaying() || delaysDisabled(ctx) {
+		c.Delay = 0
+		c.MinTimeout = 0
+		c.PollInterval = replayPollInterval
+	}
+
+	return c.WaitForStateContext(ctx)
+}
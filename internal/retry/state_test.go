@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestStateChangeConfWaitForStateContextReplaying(t *testing.T) { //nolint:paralleltest
+	t.Setenv("VCR_PATH", t.TempDir())
+	t.Setenv("VCR_MODE", "REPLAYING")
+
+	ctx := context.Background()
+	n := 0
+	conf := &StateChangeConf{
+		Delay:      1 * time.Minute,
+		Pending:    []string{"pending"},
+		Target:     []string{"done"},
+		Timeout:    2 * time.Minute,
+		MinTimeout: 30 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			n++
+			if n < 3 {
+				return n, "pending", nil
+			}
+			return n, "done", nil
+		},
+	}
+
+	start := time.Now()
+	got, err := conf.WaitForStateContext(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != 3 {
+		t.Errorf("got %v, expected 3", got)
+	}
+
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("waited %s while replaying", elapsed)
+	}
+}
+
+func TestStateChangeConfWaitForStateContextWithoutDelays(t *testing.T) {
+	t.Parallel()
+
+	ctx := WithoutDelays(context.Background())
+	n := 0
+	conf := &StateChangeConf{
+		Delay:                     1 * time.Minute,
+		Pending:                   []string{"pending"},
+		Target:                    []string{"done"},
+		Timeout:                   2 * time.Minute,
+		MinTimeout:                30 * time.Second,
+		ContinuousTargetOccurence: 2,
+		Refresh: func() (interface{}, string, error) {
+			n++
+			if n < 3 {
+				return n, "pending", nil
+			}
+			return n, "done", nil
+		},
+	}
+
+	start := time.Now()
+	got, err := conf.WaitForStateContext(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != 4 {
+		t.Errorf("got %v, expected 4", got)
+	}
+
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("waited %s without delays", elapsed)
+	}
+}
@@ -0,0 +1,704 @@
+// Code generated by internal/generate/tagstests/main.go; DO NOT EDIT.
+
+package appintegrations_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appintegrationsservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAppIntegrationsDataIntegration_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataIntegration appintegrationsservice.GetDataIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	description := "example description"
+	firstExecutionFrom := "1439788442681"
+	resourceName := "aws_appintegrations_data_integration.test"
+	key := "DATA_INTEGRATION_SOURCE_URI"
+	sourceUri := os.Getenv(key)
+	if sourceUri == "" {
+		t.Skip("Environment variable DATA_INTEGRATION_SOURCE_URI is not set")
+
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, map[string]string{
+					"key1": "value1",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, map[string]string{
+					"key1": "value1updated",
+					"key2": "value2",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key2", "value2"),
+				),
+			},
+			{
+				Config: acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, map[string]string{
+					"key2": "value2",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key2", "value2"),
+				),
+			},
+			{
+				Config: acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsDataIntegration_tags_EmptyMap(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataIntegration appintegrationsservice.GetDataIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	description := "example description"
+	firstExecutionFrom := "1439788442681"
+	resourceName := "aws_appintegrations_data_integration.test"
+	key := "DATA_INTEGRATION_SOURCE_URI"
+	sourceUri := os.Getenv(key)
+	if sourceUri == "" {
+		t.Skip("Environment variable DATA_INTEGRATION_SOURCE_URI is not set")
+
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, map[string]string{}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsDataIntegration_tags_AddOnUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataIntegration appintegrationsservice.GetDataIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	description := "example description"
+	firstExecutionFrom := "1439788442681"
+	resourceName := "aws_appintegrations_data_integration.test"
+	key := "DATA_INTEGRATION_SOURCE_URI"
+	sourceUri := os.Getenv(key)
+	if sourceUri == "" {
+		t.Skip("Environment variable DATA_INTEGRATION_SOURCE_URI is not set")
+
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "0"),
+				),
+			},
+			{
+				Config: acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, map[string]string{
+					"key1": "value1",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsDataIntegration_tags_EmptyTag_OnCreate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataIntegration appintegrationsservice.GetDataIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	description := "example description"
+	firstExecutionFrom := "1439788442681"
+	resourceName := "aws_appintegrations_data_integration.test"
+	key := "DATA_INTEGRATION_SOURCE_URI"
+	sourceUri := os.Getenv(key)
+	if sourceUri == "" {
+		t.Skip("Environment variable DATA_INTEGRATION_SOURCE_URI is not set")
+
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, map[string]string{
+					"key1": "",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsDataIntegration_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataIntegration appintegrationsservice.GetDataIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	description := "example description"
+	firstExecutionFrom := "1439788442681"
+	resourceName := "aws_appintegrations_data_integration.test"
+	key := "DATA_INTEGRATION_SOURCE_URI"
+	sourceUri := os.Getenv(key)
+	if sourceUri == "" {
+		t.Skip("Environment variable DATA_INTEGRATION_SOURCE_URI is not set")
+
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, map[string]string{
+					"key1": "value1",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
+				),
+			},
+			{
+				Config: acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, map[string]string{
+					"key1": "value1",
+					"key2": "",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key2", ""),
+				),
+			},
+			{
+				Config: acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, map[string]string{
+					"key1": "value1",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsDataIntegration_tags_DefaultTags_providerOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataIntegration appintegrationsservice.GetDataIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	description := "example description"
+	firstExecutionFrom := "1439788442681"
+	resourceName := "aws_appintegrations_data_integration.test"
+	key := "DATA_INTEGRATION_SOURCE_URI"
+	sourceUri := os.Getenv(key)
+	if sourceUri == "" {
+		t.Skip("Environment variable DATA_INTEGRATION_SOURCE_URI is not set")
+
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, nil),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags2("providerkey1", "providervalue1", "providerkey2", "providervalue2"),
+					acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, nil),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey2", "providervalue2"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1updated"),
+					acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, nil),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1updated"),
+				),
+			},
+			{
+				Config: acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsDataIntegration_tags_DefaultTags_nonOverlapping(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataIntegration appintegrationsservice.GetDataIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	description := "example description"
+	firstExecutionFrom := "1439788442681"
+	resourceName := "aws_appintegrations_data_integration.test"
+	key := "DATA_INTEGRATION_SOURCE_URI"
+	sourceUri := os.Getenv(key)
+	if sourceUri == "" {
+		t.Skip("Environment variable DATA_INTEGRATION_SOURCE_URI is not set")
+
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, map[string]string{
+						"resourcekey1": "resourcevalue1",
+					}),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.resourcekey1", "resourcevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.resourcekey1", "resourcevalue1"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1updated"),
+					acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, map[string]string{
+						"resourcekey1": "resourcevalue1updated",
+						"resourcekey2": "resourcevalue2",
+					}),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.resourcekey1", "resourcevalue1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.resourcekey2", "resourcevalue2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.resourcekey1", "resourcevalue1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.resourcekey2", "resourcevalue2"),
+				),
+			},
+			{
+				Config: acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsDataIntegration_tags_DefaultTags_overlapping(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataIntegration appintegrationsservice.GetDataIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	description := "example description"
+	firstExecutionFrom := "1439788442681"
+	resourceName := "aws_appintegrations_data_integration.test"
+	key := "DATA_INTEGRATION_SOURCE_URI"
+	sourceUri := os.Getenv(key)
+	if sourceUri == "" {
+		t.Skip("Environment variable DATA_INTEGRATION_SOURCE_URI is not set")
+
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("overlapkey1", "providervalue1"),
+					acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, map[string]string{
+						"overlapkey1": "resourcevalue1",
+					}),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.overlapkey1", "resourcevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.overlapkey1", "resourcevalue1"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags2("overlapkey1", "providervalue1", "overlapkey2", "providervalue2"),
+					acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, map[string]string{
+						"overlapkey1": "resourcevalue1",
+						"overlapkey2": "resourcevalue2",
+					}),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.overlapkey1", "resourcevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.overlapkey2", "resourcevalue2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.overlapkey1", "resourcevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.overlapkey2", "resourcevalue2"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("overlapkey1", "providervalue1"),
+					acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, map[string]string{
+						"overlapkey1": "resourcevalue2",
+					}),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.overlapkey1", "resourcevalue2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.overlapkey1", "resourcevalue2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsDataIntegration_tags_DefaultTags_updateToProviderOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataIntegration appintegrationsservice.GetDataIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	description := "example description"
+	firstExecutionFrom := "1439788442681"
+	resourceName := "aws_appintegrations_data_integration.test"
+	key := "DATA_INTEGRATION_SOURCE_URI"
+	sourceUri := os.Getenv(key)
+	if sourceUri == "" {
+		t.Skip("Environment variable DATA_INTEGRATION_SOURCE_URI is not set")
+
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, map[string]string{
+					"key1": "value1",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("key1", "value1"),
+					acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, nil),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsDataIntegration_tags_DefaultTags_updateToResourceOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataIntegration appintegrationsservice.GetDataIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	description := "example description"
+	firstExecutionFrom := "1439788442681"
+	resourceName := "aws_appintegrations_data_integration.test"
+	key := "DATA_INTEGRATION_SOURCE_URI"
+	sourceUri := os.Getenv(key)
+	if sourceUri == "" {
+		t.Skip("Environment variable DATA_INTEGRATION_SOURCE_URI is not set")
+
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("key1", "value1"),
+					acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, nil),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
+				),
+			},
+			{
+				Config: acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, map[string]string{
+					"key1": "value1",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsDataIntegration_tags_DefaultTags_emptyResourceTag(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataIntegration appintegrationsservice.GetDataIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	description := "example description"
+	firstExecutionFrom := "1439788442681"
+	resourceName := "aws_appintegrations_data_integration.test"
+	key := "DATA_INTEGRATION_SOURCE_URI"
+	sourceUri := os.Getenv(key)
+	if sourceUri == "" {
+		t.Skip("Environment variable DATA_INTEGRATION_SOURCE_URI is not set")
+
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, map[string]string{
+						"key1": "",
+					}),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsDataIntegration_tags_IgnoreTags_Overlap_DefaultTag(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataIntegration appintegrationsservice.GetDataIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	description := "example description"
+	firstExecutionFrom := "1439788442681"
+	resourceName := "aws_appintegrations_data_integration.test"
+	key := "DATA_INTEGRATION_SOURCE_URI"
+	sourceUri := os.Getenv(key)
+	if sourceUri == "" {
+		t.Skip("Environment variable DATA_INTEGRATION_SOURCE_URI is not set")
+
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultAndIgnoreTagsKeys1("providerkey1", "providervalue1"),
+					acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, map[string]string{
+						"resourcekey1": "resourcevalue1",
+					}),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.resourcekey1", "resourcevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.resourcekey1", "resourcevalue1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppIntegrationsDataIntegration_tags_IgnoreTags_Overlap_ResourceTag(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataIntegration appintegrationsservice.GetDataIntegrationOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	description := "example description"
+	firstExecutionFrom := "1439788442681"
+	resourceName := "aws_appintegrations_data_integration.test"
+	key := "DATA_INTEGRATION_SOURCE_URI"
+	sourceUri := os.Getenv(key)
+	if sourceUri == "" {
+		t.Skip("Environment variable DATA_INTEGRATION_SOURCE_URI is not set")
+
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appintegrationsservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigIgnoreTagsKeys("resourcekey1"),
+					acctest.ConfigResourceTags(t, testAccDataIntegrationConfig_basic(rName, description, sourceUri, firstExecutionFrom), resourceName, map[string]string{
+						"resourcekey1": "resourcevalue1",
+						"resourcekey2": "resourcevalue2",
+					}),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.resourcekey2", "resourcevalue2"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
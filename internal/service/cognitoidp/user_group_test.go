@@ -151,7 +151,7 @@ func testAccCheckUserGroupExists(ctx context.Context, name string) resource.Test
 		}
 
 		if id != fmt.Sprintf("%s/%s", userPoolId, name) {
-			return fmt.Errorf(fmt.Sprintf("ID should be user_pool_id/name. ID was %s. name was %s, user_pool_id was %s", id, name, userPoolId))
+			return fmt.Errorf("ID should be user_pool_id/name. ID was %s. name was %s, user_pool_id was %s", id, name, userPoolId)
 		}
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn(ctx)
@@ -5,6 +5,7 @@
 //go:generate go run ./test-fixtures/generate/document_classifier/main.go
 //go:generate go run ./test-fixtures/generate/entity_recognizer/main.go
 //go:generate go run ../../generate/servicepackage/main.go
+//go:generate go run ../../generate/tagstests/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package comprehend
@@ -5,6 +5,7 @@ package configservice
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -12,7 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 )
 
 const (
@@ -265,7 +266,7 @@ func refreshConformancePackStatus(ctx context.Context, conn *configservice.Confi
 		}
 
 		if errMsg := aws.StringValue(status.ConformancePackStatusReason); errMsg != "" {
-			return status, aws.StringValue(status.ConformancePackState), fmt.Errorf(errMsg)
+			return status, aws.StringValue(status.ConformancePackState), errors.New(errMsg)
 		}
 
 		return status, aws.StringValue(status.ConformancePackState), nil
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKResource("aws_ec2_image_block_public_access", name="Image Block Public Access")
+func ResourceImageBlockPublicAccess() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceImageBlockPublicAccessCreate,
+		ReadWithoutTimeout:   resourceImageBlockPublicAccessRead,
+		UpdateWithoutTimeout: resourceImageBlockPublicAccessUpdate,
+		DeleteWithoutTimeout: resourceImageBlockPublicAccessDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(imageBlockPublicAccessState_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceImageBlockPublicAccessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	state := d.Get("state").(string)
+	if err := setImageBlockPublicAccessState(ctx, conn, state, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting EC2 Image Block Public Access (%s): %s", state, err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	return append(diags, resourceImageBlockPublicAccessRead(ctx, d, meta)...)
+}
+
+func resourceImageBlockPublicAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	output, err := FindImageBlockPublicAccessState(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Image Block Public Access: %s", err)
+	}
+
+	d.Set("state", output.ImageBlockPublicAccessState)
+
+	return diags
+}
+
+func resourceImageBlockPublicAccessUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	state := d.Get("state").(string)
+	if err := setImageBlockPublicAccessState(ctx, conn, state, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating EC2 Image Block Public Access (%s): %s", state, err)
+	}
+
+	return append(diags, resourceImageBlockPublicAccessRead(ctx, d, meta)...)
+}
+
+func resourceImageBlockPublicAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	// Removing the resource unblocks public sharing of AMIs.
+	if err := setImageBlockPublicAccessState(ctx, conn, ec2.ImageBlockPublicAccessDisabledStateUnblocked, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling EC2 Image Block Public Access: %s", err)
+	}
+
+	return diags
+}
+
+func setImageBlockPublicAccessState(ctx context.Context, conn *ec2.EC2, state string, timeout time.Duration) error {
+	var err error
+
+	if state == ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing {
+		_, err = conn.EnableImageBlockPublicAccessWithContext(ctx, &ec2.EnableImageBlockPublicAccessInput{
+			ImageBlockPublicAccessState: aws.String(state),
+		})
+	} else {
+		_, err = conn.DisableImageBlockPublicAccessWithContext(ctx, &ec2.DisableImageBlockPublicAccessInput{})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// The new state can take several minutes to propagate.
+	_, err = WaitImageBlockPublicAccessState(ctx, conn, state, timeout)
+
+	return err
+}
+
+func imageBlockPublicAccessState_Values() []string {
+	return append(ec2.ImageBlockPublicAccessEnabledState_Values(), ec2.ImageBlockPublicAccessDisabledState_Values()...)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestAccEC2ImageBlockPublicAccess_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_image_block_public_access.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImageBlockPublicAccessDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageBlockPublicAccessConfig_basic(ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageBlockPublicAccess(ctx, resourceName, ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccImageBlockPublicAccessConfig_basic(ec2.ImageBlockPublicAccessDisabledStateUnblocked),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageBlockPublicAccess(ctx, resourceName, ec2.ImageBlockPublicAccessDisabledStateUnblocked),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.ImageBlockPublicAccessDisabledStateUnblocked),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImageBlockPublicAccessDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := tfec2.FindImageBlockPublicAccessState(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.ImageBlockPublicAccessState) != ec2.ImageBlockPublicAccessDisabledStateUnblocked {
+			return fmt.Errorf("EC2 Image Block Public Access not disabled on resource removal")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckImageBlockPublicAccess(ctx context.Context, n, state string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := tfec2.FindImageBlockPublicAccessState(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(output.ImageBlockPublicAccessState); got != state {
+			return fmt.Errorf("EC2 Image Block Public Access is not in expected state (%s): %s", state, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccImageBlockPublicAccessConfig_basic(state string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_image_block_public_access" "test" {
+  state = %[1]q
+}
+`, state)
+}
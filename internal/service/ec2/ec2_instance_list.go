@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKListResource("aws_instance", name="Instance")
+func newInstanceListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return &instanceListResource{}, nil
+}
+
+type instanceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *instanceListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_instance"
+}
+
+func (l *instanceListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{}
+}
+
+func (l *instanceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+
+	conn := l.Meta().EC2Conn(ctx)
+
+	// Terminated instances can't be managed.
+	input := &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name: aws.String("instance-state-name"),
+				Values: aws.StringSlice([]string{
+					ec2.InstanceStateNamePending,
+					ec2.InstanceStateNameRunning,
+					ec2.InstanceStateNameShuttingDown,
+					ec2.InstanceStateNameStopping,
+					ec2.InstanceStateNameStopped,
+				}),
+			},
+		},
+	}
+
+	instances, err := FindInstances(ctx, conn, input)
+
+	if tfresource.NotFound(err) {
+		stream.Results = list.NoListResults
+
+		return
+	}
+
+	if err != nil {
+		diags.AddError("listing EC2 Instances", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for _, instance := range instances {
+			id := aws.StringValue(instance.InstanceId)
+			displayName := id
+			for _, tag := range instance.Tags {
+				if aws.StringValue(tag.Key) == "Name" {
+					displayName = fmt.Sprintf("%s (%s)", aws.StringValue(tag.Value), id)
+					break
+				}
+			}
+
+			result, ok := l.NewListResult(ctx, request, id, displayName)
+			if !ok {
+				continue
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
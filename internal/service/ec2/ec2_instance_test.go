@@ -96,7 +96,7 @@ func TestFetchRootDevice(t *testing.T) {
 
 	for _, tc := range cases {
 		tc := tc
-		t.Run(fmt.Sprintf(tc.label), func(t *testing.T) {
+		t.Run(tc.label, func(t *testing.T) {
 			t.Parallel()
 
 			conn := ec2.New(sess)
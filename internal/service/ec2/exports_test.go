@@ -5,9 +5,11 @@ package ec2
 
 // Exports for use in tests only.
 var (
-	ResourceInstanceConnectEndpoint  = newResourceInstanceConnectEndpoint
-	ResourceSecurityGroupEgressRule  = newResourceSecurityGroupEgressRule
-	ResourceSecurityGroupIngressRule = newResourceSecurityGroupIngressRule
+	ResourceInstanceConnectEndpoint       = newResourceInstanceConnectEndpoint
+	ResourceSecurityGroupEgressRule       = newResourceSecurityGroupEgressRule
+	ResourceSecurityGroupIngressRule      = newResourceSecurityGroupIngressRule
+	ResourceVPCBlockPublicAccessExclusion = newResourceVPCBlockPublicAccessExclusion
+	ResourceVPCBlockPublicAccessOptions   = newResourceVPCBlockPublicAccessOptions
 
 	UpdateTags = updateTags
 )
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVPCBlockPublicAccessExclusion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_block_public_access_exclusion.test"
+	vpcResourceName := "aws_vpc.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCBlockPublicAccessExclusionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCBlockPublicAccessExclusionConfig_basic(rName, string(awstypes.InternetGatewayExclusionModeAllowBidirectional)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCBlockPublicAccessExclusionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "internet_gateway_exclusion_mode", string(awstypes.InternetGatewayExclusionModeAllowBidirectional)),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "resource_arn", "ec2", regexp.MustCompile(`vpc/vpc-.+`)),
+					resource.TestCheckNoResourceAttr(resourceName, "subnet_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", vpcResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCBlockPublicAccessExclusion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_block_public_access_exclusion.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCBlockPublicAccessExclusionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCBlockPublicAccessExclusionConfig_basic(rName, string(awstypes.InternetGatewayExclusionModeAllowBidirectional)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCBlockPublicAccessExclusionExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceVPCBlockPublicAccessExclusion, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCBlockPublicAccessExclusion_subnet(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_block_public_access_exclusion.test"
+	subnetResourceName := "aws_subnet.test.0"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCBlockPublicAccessExclusionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCBlockPublicAccessExclusionConfig_subnet(rName, string(awstypes.InternetGatewayExclusionModeAllowEgress)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCBlockPublicAccessExclusionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "internet_gateway_exclusion_mode", string(awstypes.InternetGatewayExclusionModeAllowEgress)),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "resource_arn", "ec2", regexp.MustCompile(`subnet/subnet-.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_id", subnetResourceName, "id"),
+					resource.TestCheckNoResourceAttr(resourceName, "vpc_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCBlockPublicAccessExclusion_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_block_public_access_exclusion.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCBlockPublicAccessExclusionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCBlockPublicAccessExclusionConfig_basic(rName, string(awstypes.InternetGatewayExclusionModeAllowBidirectional)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCBlockPublicAccessExclusionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "internet_gateway_exclusion_mode", string(awstypes.InternetGatewayExclusionModeAllowBidirectional)),
+				),
+			},
+			{
+				Config: testAccVPCBlockPublicAccessExclusionConfig_basic(rName, string(awstypes.InternetGatewayExclusionModeAllowEgress)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCBlockPublicAccessExclusionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "internet_gateway_exclusion_mode", string(awstypes.InternetGatewayExclusionModeAllowEgress)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPCBlockPublicAccessExclusionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC Block Public Access Exclusion ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindVPCBlockPublicAccessExclusionByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckVPCBlockPublicAccessExclusionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpc_block_public_access_exclusion" {
+				continue
+			}
+
+			_, err := tfec2.FindVPCBlockPublicAccessExclusionByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("VPC Block Public Access Exclusion %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCBlockPublicAccessExclusionConfig_basic(rName, mode string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_vpc_block_public_access_exclusion" "test" {
+  internet_gateway_exclusion_mode = %[1]q
+  vpc_id                          = aws_vpc.test.id
+}
+`, mode))
+}
+
+func testAccVPCBlockPublicAccessExclusionConfig_subnet(rName, mode string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_vpc_block_public_access_exclusion" "test" {
+  internet_gateway_exclusion_mode = %[1]q
+  subnet_id                       = aws_subnet.test[0].id
+}
+`, mode))
+}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="VPC Block Public Access Options")
+func newResourceVPCBlockPublicAccessOptions(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceVPCBlockPublicAccessOptions{}
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type resourceVPCBlockPublicAccessOptions struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceVPCBlockPublicAccessOptions) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpc_block_public_access_options"
+}
+
+func (r *resourceVPCBlockPublicAccessOptions) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"aws_account_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"aws_region": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"internet_gateway_block_mode": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.InternetGatewayBlockMode](),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceVPCBlockPublicAccessOptions) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceVPCBlockPublicAccessOptionsData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	mode := awstypes.InternetGatewayBlockMode(data.InternetGatewayBlockMode.ValueString())
+	options, err := modifyVPCBlockPublicAccessOptions(ctx, conn, mode, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating VPC Block Public Access Options (%s)", mode), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(r.Meta().RegionForContext(ctx))
+	data.refreshFromOutput(options)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVPCBlockPublicAccessOptions) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceVPCBlockPublicAccessOptionsData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	options, err := FindVPCBlockPublicAccessOptions(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Block Public Access Options (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.refreshFromOutput(options)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceVPCBlockPublicAccessOptions) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceVPCBlockPublicAccessOptionsData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	if !new.InternetGatewayBlockMode.Equal(old.InternetGatewayBlockMode) {
+		mode := awstypes.InternetGatewayBlockMode(new.InternetGatewayBlockMode.ValueString())
+		options, err := modifyVPCBlockPublicAccessOptions(ctx, conn, mode, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating VPC Block Public Access Options (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.refreshFromOutput(options)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceVPCBlockPublicAccessOptions) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceVPCBlockPublicAccessOptionsData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	// Removing the resource turns VPC Block Public Access off.
+	if _, err := modifyVPCBlockPublicAccessOptions(ctx, conn, awstypes.InternetGatewayBlockModeOff, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting VPC Block Public Access Options (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func modifyVPCBlockPublicAccessOptions(ctx context.Context, conn *ec2.Client, mode awstypes.InternetGatewayBlockMode, timeout time.Duration) (*awstypes.VpcBlockPublicAccessOptions, error) {
+	_, err := conn.ModifyVpcBlockPublicAccessOptions(ctx, &ec2.ModifyVpcBlockPublicAccessOptionsInput{
+		InternetGatewayBlockMode: mode,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := WaitVPCBlockPublicAccessOptionsUpdated(ctx, conn, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for update: %w", err)
+	}
+
+	return options, nil
+}
+
+type resourceVPCBlockPublicAccessOptionsData struct {
+	AWSAccountID             types.String   `tfsdk:"aws_account_id"`
+	AWSRegion                types.String   `tfsdk:"aws_region"`
+	ID                       types.String   `tfsdk:"id"`
+	InternetGatewayBlockMode types.String   `tfsdk:"internet_gateway_block_mode"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (data *resourceVPCBlockPublicAccessOptionsData) refreshFromOutput(options *awstypes.VpcBlockPublicAccessOptions) {
+	data.AWSAccountID = types.StringPointerValue(options.AwsAccountId)
+	data.AWSRegion = types.StringPointerValue(options.AwsRegion)
+	data.InternetGatewayBlockMode = types.StringValue(string(options.InternetGatewayBlockMode))
+}
@@ -78,7 +78,7 @@ func (d *dataSourceSecurityGroupRules) Read(ctx context.Context, request datasou
 		securityGroupRuleIDs = append(securityGroupRuleIDs, aws.StringValue(v.SecurityGroupRuleId))
 	}
 
-	data.ID = types.StringValue(d.Meta().Region)
+	data.ID = types.StringValue(d.Meta().RegionForContext(ctx))
 	data.IDs = flex.FlattenFrameworkStringValueList(ctx, securityGroupRuleIDs)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
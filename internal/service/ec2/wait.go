@@ -17,8 +17,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -421,6 +421,30 @@ func WaitImageAvailable(ctx context.Context, conn *ec2.EC2, id string, timeout t
 	return nil, err
 }
 
+func WaitImageBlockPublicAccessState(ctx context.Context, conn *ec2.EC2, target string, timeout time.Duration) (*ec2.GetImageBlockPublicAccessStateOutput, error) {
+	var pending []string
+	for _, v := range imageBlockPublicAccessState_Values() {
+		if v != target {
+			pending = append(pending, v)
+		}
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: StatusImageBlockPublicAccessState(ctx, conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.GetImageBlockPublicAccessStateOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitImageDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Image, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{ec2.ImageStateAvailable, ec2.ImageStateFailed, ec2.ImageStatePending},
@@ -3243,3 +3267,142 @@ func WaitInstanceConnectEndpointDeleted(ctx context.Context, conn *ec2_sdkv2.Cli
 
 	return nil, err
 }
+
+func WaitVerifiedAccessEndpointCreated(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.VerifiedAccessEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VerifiedAccessEndpointStatusCodePending),
+		Target:  enum.Slice(types.VerifiedAccessEndpointStatusCodeActive),
+		Refresh: StatusVerifiedAccessEndpoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VerifiedAccessEndpoint); ok {
+		if status := output.Status; status != nil {
+			tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVerifiedAccessEndpointUpdated(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.VerifiedAccessEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VerifiedAccessEndpointStatusCodeUpdating),
+		Target:  enum.Slice(types.VerifiedAccessEndpointStatusCodeActive),
+		Refresh: StatusVerifiedAccessEndpoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VerifiedAccessEndpoint); ok {
+		if status := output.Status; status != nil {
+			tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVerifiedAccessEndpointDeleted(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.VerifiedAccessEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VerifiedAccessEndpointStatusCodeActive, types.VerifiedAccessEndpointStatusCodeDeleting),
+		Target:  []string{},
+		Refresh: StatusVerifiedAccessEndpoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VerifiedAccessEndpoint); ok {
+		if status := output.Status; status != nil {
+			tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVPCBlockPublicAccessOptionsUpdated(ctx context.Context, conn *ec2_sdkv2.Client, timeout time.Duration) (*types.VpcBlockPublicAccessOptions, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VpcBlockPublicAccessStateUpdateInProgress),
+		Target:  enum.Slice(types.VpcBlockPublicAccessStateUpdateComplete),
+		Refresh: StatusVPCBlockPublicAccessOptions(ctx, conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcBlockPublicAccessOptions); ok {
+		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Reason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVPCBlockPublicAccessExclusionCreated(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.VpcBlockPublicAccessExclusion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VpcBlockPublicAccessExclusionStateCreateInProgress),
+		Target:  enum.Slice(types.VpcBlockPublicAccessExclusionStateCreateComplete),
+		Refresh: StatusVPCBlockPublicAccessExclusion(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcBlockPublicAccessExclusion); ok {
+		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Reason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVPCBlockPublicAccessExclusionUpdated(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.VpcBlockPublicAccessExclusion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VpcBlockPublicAccessExclusionStateUpdateInProgress),
+		Target:  enum.Slice(types.VpcBlockPublicAccessExclusionStateUpdateComplete),
+		Refresh: StatusVPCBlockPublicAccessExclusion(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcBlockPublicAccessExclusion); ok {
+		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Reason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVPCBlockPublicAccessExclusionDeleted(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*types.VpcBlockPublicAccessExclusion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VpcBlockPublicAccessExclusionStateDeleteInProgress),
+		Target:  []string{},
+		Refresh: StatusVPCBlockPublicAccessExclusion(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcBlockPublicAccessExclusion); ok {
+		tfresource.SetLastError(err, errors.New(aws_sdkv2.ToString(output.Reason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
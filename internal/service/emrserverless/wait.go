@@ -5,12 +5,12 @@ package emrserverless
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/emrserverless"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -38,7 +38,7 @@ func waitApplicationCreated(ctx context.Context, conn *emrserverless.EMRServerle
 
 	if output, ok := outputRaw.(*emrserverless.Application); ok {
 		if stateChangeReason := output.StateDetails; stateChangeReason != nil {
-			tfresource.SetLastError(err, fmt.Errorf(aws.StringValue(stateChangeReason)))
+			tfresource.SetLastError(err, errors.New(aws.StringValue(stateChangeReason)))
 		}
 
 		return output, err
@@ -61,7 +61,7 @@ func waitApplicationTerminated(ctx context.Context, conn *emrserverless.EMRServe
 
 	if output, ok := outputRaw.(*emrserverless.Application); ok {
 		if stateChangeReason := output.StateDetails; stateChangeReason != nil {
-			tfresource.SetLastError(err, fmt.Errorf(aws.StringValue(stateChangeReason)))
+			tfresource.SetLastError(err, errors.New(aws.StringValue(stateChangeReason)))
 		}
 
 		return output, err
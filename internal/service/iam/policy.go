@@ -14,13 +14,15 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -31,6 +33,10 @@ import (
 const (
 	policyNameMaxLen       = 128
 	policyNamePrefixMaxLen = policyNameMaxLen - id.UniqueIDSuffixLength
+
+	// Managed policy size in characters, excluding whitespace.
+	// Reference: https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html.
+	policyMaxSize = 6144
 )
 
 // @SDKResource("aws_iam_policy", name="Policy")
@@ -79,9 +85,16 @@ func ResourcePolicy() *schema.Resource {
 				ForceNew: true,
 			},
 			"policy": {
-				Type:                  schema.TypeString,
-				Required:              true,
-				ValidateFunc:          verify.ValidIAMPolicyJSON,
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: verify.ValidAllDiag(
+					validation.ToDiagFunc(validation.StringIsNotEmpty),
+					iampolicy.ValidDocument(iampolicy.Options{
+						Type:           iampolicy.IdentityBasedPolicy,
+						MaxSize:        policyMaxSize,
+						WarnFullAccess: true,
+					}),
+				),
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
 				StateFunc: func(v interface{}) string {
@@ -201,7 +201,7 @@ func composeErrors(desc string, uErr error, rErr error, gErr error) diag.Diagnos
 			errMsg = errMsg + "\n– " + e.Error()
 		}
 	}
-	return diag.Errorf(errMsg)
+	return diag.Errorf("%s", errMsg)
 }
 
 func attachPolicyToUsers(ctx context.Context, conn *iam.IAM, users []*string, arn string) error {
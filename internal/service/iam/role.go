@@ -18,7 +18,6 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -27,6 +26,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -36,9 +38,16 @@ import (
 const (
 	roleNameMaxLen       = 64
 	roleNamePrefixMaxLen = roleNameMaxLen - id.UniqueIDSuffixLength
+
+	// Policy sizes in characters, excluding whitespace.
+	// The trust policy size is the maximum to which the default quota of 2048 can be increased.
+	// Reference: https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html.
+	roleTrustPolicyMaxSize    = 4096
+	roleInlinePoliciesMaxSize = 10240
 )
 
 // @SDKResource("aws_iam_role", name="Role")
+// @Identity("name", global=true)
 // @Tags
 func ResourceRole() *schema.Resource {
 	return &schema.Resource{
@@ -57,9 +66,12 @@ func ResourceRole() *schema.Resource {
 				Computed: true,
 			},
 			"assume_role_policy": {
-				Type:                  schema.TypeString,
-				Required:              true,
-				ValidateFunc:          validation.StringIsJSON,
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: iampolicy.ValidDocument(iampolicy.Options{
+					Type:    iampolicy.TrustPolicy,
+					MaxSize: roleTrustPolicyMaxSize,
+				}),
 				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 				DiffSuppressOnRefresh: true,
 				StateFunc: func(v interface{}) string {
@@ -100,9 +112,15 @@ func ResourceRole() *schema.Resource {
 							),
 						},
 						"policy": {
-							Type:                  schema.TypeString,
-							Optional:              true, // semantically required but syntactically optional to allow empty inline_policy
-							ValidateFunc:          verify.ValidIAMPolicyJSON,
+							Type:     schema.TypeString,
+							Optional: true, // semantically required but syntactically optional to allow empty inline_policy
+							ValidateDiagFunc: verify.ValidAllDiag(
+								validation.ToDiagFunc(validation.StringIsNotEmpty),
+								iampolicy.ValidDocument(iampolicy.Options{
+									Type:    iampolicy.IdentityBasedPolicy,
+									MaxSize: roleInlinePoliciesMaxSize,
+								}),
+							),
 							DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 							DiffSuppressOnRefresh: true,
 							StateFunc: func(v interface{}) string {
@@ -658,6 +676,30 @@ func FindRoleByName(ctx context.Context, conn *iam.IAM, name string) (*iam.Role,
 	return output.Role, nil
 }
 
+func findRoles(ctx context.Context, conn *iam.IAM, input *iam.ListRolesInput, filter tfslices.FilterFunc[*iam.Role]) ([]*iam.Role, error) {
+	var output []*iam.Role
+
+	err := conn.ListRolesPagesWithContext(ctx, input, func(page *iam.ListRolesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Roles {
+			if v != nil && filter(v) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func readRolePolicyAttachments(ctx context.Context, conn *iam.IAM, roleName string) ([]*string, error) {
 	managedPolicies := make([]*string, 0)
 	input := &iam.ListAttachedRolePoliciesInput{
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+// @SDKListResource("aws_iam_role", name="Role")
+func newRoleListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return &roleListResource{}, nil
+}
+
+type roleListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *roleListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_role"
+}
+
+func (l *roleListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"path_prefix": listschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (l *roleListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data roleListResourceData
+	var diags diag.Diagnostics
+
+	diags.Append(request.Config.Get(ctx, &data)...)
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	conn := l.Meta().IAMConn(ctx)
+
+	input := &iam.ListRolesInput{
+		PathPrefix: flex.StringFromFramework(ctx, data.PathPrefix),
+	}
+
+	roles, err := findRoles(ctx, conn, input, func(*iam.Role) bool { return true })
+
+	if err != nil {
+		diags.AddError("listing IAM Roles", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for _, role := range roles {
+			name := aws.StringValue(role.RoleName)
+
+			result, ok := l.NewListResult(ctx, request, name, name)
+			if !ok {
+				continue
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+type roleListResourceData struct {
+	PathPrefix types.String `tfsdk:"path_prefix"`
+}
@@ -6,6 +6,8 @@ package iam_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"regexp"
 	"testing"
 
@@ -17,11 +19,70 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/awsmock"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func TestRoleRead_notFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := awsmock.Context(context.Background())
+	s := awsmock.NewServer(t)
+	s.Handle("iam", "GetRole", awsmock.RespondError(http.StatusNotFound, iam.ErrCodeNoSuchEntityException, "The role with name test cannot be found."))
+
+	p := s.Provider(ctx, t)
+	r := p.ResourcesMap["aws_iam_role"]
+	d := r.TestResourceData()
+	d.SetId("test")
+
+	if diags := r.ReadWithoutTimeout(ctx, d, p.Meta()); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Id(); got != "" {
+		t.Errorf("ID = %q, want removed from state", got)
+	}
+}
+
+func TestRoleRead_arnIsUniqueID(t *testing.T) {
+	t.Parallel()
+
+	ctx := awsmock.Context(context.Background())
+	s := awsmock.NewServer(t)
+	getRoleResponse := func(arn string) awsmock.Handler {
+		return awsmock.Respond(fmt.Sprintf(`<Role><RoleName>test</RoleName><RoleId>AROAQ7SSZBKHREXAMPLE</RoleId><Arn>%[1]s</Arn><Path>/</Path><CreateDate>2023-01-01T00:00:00Z</CreateDate><AssumeRolePolicyDocument>%[2]s</AssumeRolePolicyDocument><MaxSessionDuration>3600</MaxSessionDuration></Role>`,
+			arn, url.QueryEscape(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`)))
+	}
+	// Immediately after a role is created IAM may return its unique ID as its ARN.
+	s.Handle("iam", "GetRole", awsmock.Sequence(
+		getRoleResponse("AROAQ7SSZBKHREXAMPLE"),
+		getRoleResponse("AROAQ7SSZBKHREXAMPLE"),
+		getRoleResponse("arn:aws:iam::"+awsmock.AccountID+":role/test"),
+	))
+	s.Handle("iam", "ListRolePolicies", awsmock.Respond(`<PolicyNames></PolicyNames><IsTruncated>false</IsTruncated>`))
+	s.Handle("iam", "ListAttachedRolePolicies", awsmock.Respond(`<AttachedPolicies></AttachedPolicies><IsTruncated>false</IsTruncated>`))
+
+	p := s.Provider(ctx, t)
+	r := p.ResourcesMap["aws_iam_role"]
+	d := r.TestResourceData()
+	d.SetId("test")
+
+	if diags := r.ReadWithoutTimeout(ctx, d, p.Meta()); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := d.Get("arn").(string), "arn:aws:iam::"+awsmock.AccountID+":role/test"; got != want {
+		t.Errorf("arn = %q, want %q", got, want)
+	}
+
+	// The initial read, one refresh returning the unique ID and 5 consecutive refreshes returning the ARN.
+	if got, want := len(s.Requests("iam", "GetRole")), 7; got != want {
+		t.Errorf("GetRole requests = %d, want %d", got, want)
+	}
+}
+
 func TestAccIAMRole_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf iam.Role
@@ -9,7 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ivschat"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -48,8 +48,8 @@ func statusRoom(ctx context.Context, conn *ivschat.Client, id string, updateDeta
 		if updateDetails == nil {
 			return out, statusNormal, nil
 		} else {
-			if (updateDetails.MaximumMessageLength != 0 && updateDetails.MaximumMessageLength == out.MaximumMessageLength) ||
-				(updateDetails.MaximumMessageRatePerSecond != 0 && updateDetails.MaximumMessageRatePerSecond == out.MaximumMessageRatePerSecond) ||
+			if (updateDetails.MaximumMessageLength != nil && aws.ToInt32(updateDetails.MaximumMessageLength) == aws.ToInt32(out.MaximumMessageLength)) ||
+				(updateDetails.MaximumMessageRatePerSecond != nil && aws.ToInt32(updateDetails.MaximumMessageRatePerSecond) == aws.ToInt32(out.MaximumMessageRatePerSecond)) ||
 				(updateDetails.MessageReviewHandler != nil && out.MessageReviewHandler != nil &&
 					(updateDetails.MessageReviewHandler.FallbackResult == out.MessageReviewHandler.FallbackResult || aws.ToString(updateDetails.MessageReviewHandler.Uri) == aws.ToString(out.MessageReviewHandler.Uri))) ||
 				(updateDetails.Name != nil && aws.ToString(updateDetails.Name) == aws.ToString(out.Name)) ||
@@ -13,6 +13,9 @@ const (
 
 const (
 	PolicyNameDefault = "default"
+
+	// Reference: https://docs.aws.amazon.com/kms/latest/developerguide/resource-limits.html#key-policy-limit.
+	keyPolicyMaxSize = 32768
 )
 
 const (
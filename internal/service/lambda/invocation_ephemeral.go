@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+// @FrameworkEphemeralResource(name="Invocation")
+func newEphemeralInvocation(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralInvocation{}, nil
+}
+
+type ephemeralInvocation struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralInvocation) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_lambda_invocation"
+}
+
+func (e *ephemeralInvocation) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"function_name": schema.StringAttribute{
+				Required: true,
+			},
+			"input": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					fwvalidators.JSON(),
+				},
+			},
+			"qualifier": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"result": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralInvocation) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ephemeralInvocationData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().LambdaConn(ctx)
+
+	if data.Qualifier.IsNull() {
+		data.Qualifier = types.StringValue(FunctionVersionLatest)
+	}
+
+	functionName := data.FunctionName.ValueString()
+	input := &lambda.InvokeInput{
+		FunctionName:   aws.String(functionName),
+		InvocationType: aws.String(lambda.InvocationTypeRequestResponse),
+		Payload:        []byte(data.Input.ValueString()),
+		Qualifier:      flex.StringFromFramework(ctx, data.Qualifier),
+	}
+
+	output, err := conn.InvokeWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("invoking Lambda Function (%s)", functionName), err.Error())
+
+		return
+	}
+
+	if output.FunctionError != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("invoking Lambda Function (%s)", functionName), fmt.Sprintf("returned error: %q", string(output.Payload)))
+
+		return
+	}
+
+	data.Result = types.StringValue(string(output.Payload))
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type ephemeralInvocationData struct {
+	FunctionName types.String `tfsdk:"function_name"`
+	Input        types.String `tfsdk:"input"`
+	Qualifier    types.String `tfsdk:"qualifier"`
+	Result       types.String `tfsdk:"result"`
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func TestAccLambdaInvocationEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	testData := "value3"
+	echoResourceName := "echo.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6EchoProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvocationEphemeralConfig_basic(rName, testData),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, tfjsonpath.New("data").AtMapKey("qualifier"), knownvalue.StringExact("$LATEST")),
+					statecheck.ExpectKnownValue(echoResourceName, tfjsonpath.New("data").AtMapKey("result"), knownvalue.StringFunc(func(v string) error {
+						if expected := `{"key1":"value1","key2":"value2","key3":"` + testData + `"}`; !verify.SuppressEquivalentJSONDiffs("", v, expected, nil) {
+							return fmt.Errorf("expected %s, got %s", expected, v)
+						}
+
+						return nil
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccInvocationEphemeralConfig_basic(rName, testData string) string {
+	return fmt.Sprintf(testAccInvocationDataSource_base_config(rName)+`
+resource "aws_lambda_function" "lambda" {
+  depends_on = [aws_iam_role_policy_attachment.lambda_role_policy]
+
+  filename      = "test-fixtures/lambda_invocation.zip"
+  function_name = "%s"
+  role          = aws_iam_role.lambda_role.arn
+  handler       = "lambda_invocation.handler"
+  runtime       = "nodejs16.x"
+
+  environment {
+    variables = {
+      TEST_DATA = "%s"
+    }
+  }
+}
+
+ephemeral "aws_lambda_invocation" "test" {
+  function_name = aws_lambda_function.lambda.function_name
+
+  input = jsonencode({
+    key1 = "value1"
+    key2 = "value2"
+  })
+}
+
+provider "echo" {
+  data = ephemeral.aws_lambda_invocation.test
+}
+
+resource "echo" "test" {}
+`, rName, testData)
+}
@@ -15,13 +15,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/medialive/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -1170,7 +1170,7 @@ func expandInputAttachmentInputSettings(tfList []interface{}) *types.InputSettin
 		out.DenoiseFilter = types.InputDenoiseFilter(v)
 	}
 	if v, ok := m["filter_strength"].(int); ok {
-		out.FilterStrength = int32(v)
+		out.FilterStrength = aws.Int32(int32(v))
 	}
 	if v, ok := m["input_filter"].(string); ok && v != "" {
 		out.InputFilter = types.InputFilter(v)
@@ -1179,7 +1179,7 @@ func expandInputAttachmentInputSettings(tfList []interface{}) *types.InputSettin
 		out.NetworkInputSettings = expandInputAttachmentInputSettingsNetworkInputSettings(v)
 	}
 	if v, ok := m["scte35_pid"].(int); ok {
-		out.Scte35Pid = int32(v)
+		out.Scte35Pid = aws.Int32(int32(v))
 	}
 	if v, ok := m["smpte2038_data_preference"].(string); ok && v != "" {
 		out.Smpte2038DataPreference = types.Smpte2038DataPreference(v)
@@ -1261,16 +1261,16 @@ func expandNetworkInputSettingsHLSInputSettings(tfList []interface{}) *types.Hls
 
 	var out types.HlsInputSettings
 	if v, ok := m["bandwidth"].(int); ok {
-		out.Bandwidth = int32(v)
+		out.Bandwidth = aws.Int32(int32(v))
 	}
 	if v, ok := m["buffer_segments"].(int); ok {
-		out.BufferSegments = int32(v)
+		out.BufferSegments = aws.Int32(int32(v))
 	}
 	if v, ok := m["retries"].(int); ok {
-		out.Retries = int32(v)
+		out.Retries = aws.Int32(int32(v))
 	}
 	if v, ok := m["retry_interval"].(int); ok {
-		out.RetryInterval = int32(v)
+		out.RetryInterval = aws.Int32(int32(v))
 	}
 	if v, ok := m["scte35_source"].(string); ok && v != "" {
 		out.Scte35Source = types.HlsScte35SourceType(v)
@@ -1308,10 +1308,10 @@ func flattenInputAttachmentsInputSettings(in *types.InputSettings) []interface{}
 		"caption_selector":          flattenInputAttachmentsInputSettingsCaptionSelectors(in.CaptionSelectors),
 		"deblock_filter":            string(in.DeblockFilter),
 		"denoise_filter":            string(in.DenoiseFilter),
-		"filter_strength":           int(in.FilterStrength),
+		"filter_strength":           int(aws.ToInt32(in.FilterStrength)),
 		"input_filter":              string(in.InputFilter),
 		"network_input_settings":    flattenInputAttachmentsInputSettingsNetworkInputSettings(in.NetworkInputSettings),
-		"scte35_pid":                int(in.Scte35Pid),
+		"scte35_pid":                int(aws.ToInt32(in.Scte35Pid)),
 		"smpte2038_data_preference": string(in.Smpte2038DataPreference),
 		"source_end_behavior":       string(in.SourceEndBehavior),
 	}
@@ -1375,10 +1375,10 @@ func flattenNetworkInputSettingsHLSInputSettings(in *types.HlsInputSettings) []i
 	}
 
 	m := map[string]interface{}{
-		"bandwidth":       int(in.Bandwidth),
-		"buffer_segments": int(in.BufferSegments),
-		"retries":         int(in.Retries),
-		"retry_interval":  int(in.RetryInterval),
+		"bandwidth":       int(aws.ToInt32(in.Bandwidth)),
+		"buffer_segments": int(aws.ToInt32(in.BufferSegments)),
+		"retries":         int(aws.ToInt32(in.Retries)),
+		"retry_interval":  int(aws.ToInt32(in.RetryInterval)),
 		"scte35_source":   string(in.Scte35Source),
 	}
 
@@ -2754,7 +2754,7 @@ func expandAudioDescriptionsAudioNormalizationSettings(tfList []interface{}) *ty
 		out.AlgorithmControl = types.AudioNormalizationAlgorithmControl(v)
 	}
 	if v, ok := m["target_lkfs"].(float32); ok {
-		out.TargetLkfs = float64(v)
+		out.TargetLkfs = aws.Float64(float64(v))
 	}
 
 	return &out
@@ -2802,7 +2802,7 @@ func expandAudioDescriptionsCodecSettingsAacSettings(tfList []interface{}) *type
 
 	var out types.AacSettings
 	if v, ok := m["bitrate"].(float64); ok {
-		out.Bitrate = v
+		out.Bitrate = aws.Float64(v)
 	}
 	if v, ok := m["coding_mode"].(string); ok && v != "" {
 		out.CodingMode = types.AacCodingMode(v)
@@ -2820,7 +2820,7 @@ func expandAudioDescriptionsCodecSettingsAacSettings(tfList []interface{}) *type
 		out.RawFormat = types.AacRawFormat(v)
 	}
 	if v, ok := m["sample_rate"].(float64); ok {
-		out.SampleRate = v
+		out.SampleRate = aws.Float64(v)
 	}
 	if v, ok := m["spec"].(string); ok && v != "" {
 		out.Spec = types.AacSpec(v)
@@ -2841,7 +2841,7 @@ func expandAudioDescriptionsCodecSettingsAc3Settings(tfList []interface{}) *type
 
 	var out types.Ac3Settings
 	if v, ok := m["bitrate"].(float64); ok {
-		out.Bitrate = v
+		out.Bitrate = aws.Float64(v)
 	}
 	if v, ok := m["bitstream_mode"].(string); ok && v != "" {
 		out.BitstreamMode = types.Ac3BitstreamMode(v)
@@ -2850,7 +2850,7 @@ func expandAudioDescriptionsCodecSettingsAc3Settings(tfList []interface{}) *type
 		out.CodingMode = types.Ac3CodingMode(v)
 	}
 	if v, ok := m["dialnorm"].(int); ok {
-		out.Dialnorm = int32(v)
+		out.Dialnorm = aws.Int32(int32(v))
 	}
 	if v, ok := m["drc_profile"].(string); ok && v != "" {
 		out.DrcProfile = types.Ac3DrcProfile(v)
@@ -2874,13 +2874,13 @@ func expandAudioDescriptionsCodecSettingsEac3AtmosSettings(tfList []interface{})
 
 	var out types.Eac3AtmosSettings
 	if v, ok := m["bitrate"].(float32); ok {
-		out.Bitrate = float64(v)
+		out.Bitrate = aws.Float64(float64(v))
 	}
 	if v, ok := m["coding_mode"].(string); ok && v != "" {
 		out.CodingMode = types.Eac3AtmosCodingMode(v)
 	}
 	if v, ok := m["dialnorm"].(int); ok {
-		out.Dialnorm = int32(v)
+		out.Dialnorm = aws.Int32(int32(v))
 	}
 	if v, ok := m["drc_line"].(string); ok && v != "" {
 		out.DrcLine = types.Eac3AtmosDrcLine(v)
@@ -2889,10 +2889,10 @@ func expandAudioDescriptionsCodecSettingsEac3AtmosSettings(tfList []interface{})
 		out.DrcRf = types.Eac3AtmosDrcRf(v)
 	}
 	if v, ok := m["height_trim"].(float32); ok {
-		out.HeightTrim = float64(v)
+		out.HeightTrim = aws.Float64(float64(v))
 	}
 	if v, ok := m["surround_trim"].(float32); ok {
-		out.SurroundTrim = float64(v)
+		out.SurroundTrim = aws.Float64(float64(v))
 	}
 
 	return &out
@@ -2910,7 +2910,7 @@ func expandAudioDescriptionsCodecSettingsEac3Settings(tfList []interface{}) *typ
 		out.AttenuationControl = types.Eac3AttenuationControl(v)
 	}
 	if v, ok := m["bitrate"].(float32); ok {
-		out.Bitrate = float64(v)
+		out.Bitrate = aws.Float64(float64(v))
 	}
 	if v, ok := m["bitstream_mode"].(string); ok && v != "" {
 		out.BitstreamMode = types.Eac3BitstreamMode(v)
@@ -2922,7 +2922,7 @@ func expandAudioDescriptionsCodecSettingsEac3Settings(tfList []interface{}) *typ
 		out.DcFilter = types.Eac3DcFilter(v)
 	}
 	if v, ok := m["dialnorm"].(int); ok {
-		out.Dialnorm = int32(v)
+		out.Dialnorm = aws.Int32(int32(v))
 	}
 	if v, ok := m["drc_line"].(string); ok && v != "" {
 		out.DrcLine = types.Eac3DrcLine(v)
@@ -2937,16 +2937,16 @@ func expandAudioDescriptionsCodecSettingsEac3Settings(tfList []interface{}) *typ
 		out.LfeFilter = types.Eac3LfeFilter(v)
 	}
 	if v, ok := m["lo_ro_center_mix_level"].(float32); ok {
-		out.LoRoCenterMixLevel = float64(v)
+		out.LoRoCenterMixLevel = aws.Float64(float64(v))
 	}
 	if v, ok := m["lo_ro_surround_mix_level"].(float32); ok {
-		out.LoRoSurroundMixLevel = float64(v)
+		out.LoRoSurroundMixLevel = aws.Float64(float64(v))
 	}
 	if v, ok := m["lt_rt_center_mix_level"].(float32); ok {
-		out.LtRtCenterMixLevel = float64(v)
+		out.LtRtCenterMixLevel = aws.Float64(float64(v))
 	}
 	if v, ok := m["lt_rt_surround_mix_level"].(float32); ok {
-		out.LtRtSurroundMixLevel = float64(v)
+		out.LtRtSurroundMixLevel = aws.Float64(float64(v))
 	}
 	if v, ok := m["metadata_control"].(string); ok && v != "" {
 		out.MetadataControl = types.Eac3MetadataControl(v)
@@ -2976,13 +2976,13 @@ func expandAudioDescriptionsCodecSettingsMp2Settings(tfList []interface{}) *type
 
 	var out types.Mp2Settings
 	if v, ok := m["bitrate"].(float32); ok {
-		out.Bitrate = float64(v)
+		out.Bitrate = aws.Float64(float64(v))
 	}
 	if v, ok := m["coding_mode"].(string); ok && v != "" {
 		out.CodingMode = types.Mp2CodingMode(v)
 	}
 	if v, ok := m["sample_rate"].(float32); ok {
-		out.Bitrate = float64(v)
+		out.Bitrate = aws.Float64(float64(v))
 	}
 
 	return &out
@@ -2997,13 +2997,13 @@ func expandAudioDescriptionsCodecSettingsWavSettings(tfList []interface{}) *type
 
 	var out types.WavSettings
 	if v, ok := m["bit_depth"].(float32); ok {
-		out.BitDepth = float64(v)
+		out.BitDepth = aws.Float64(float64(v))
 	}
 	if v, ok := m["coding_mode"].(string); ok && v != "" {
 		out.CodingMode = types.WavCodingMode(v)
 	}
 	if v, ok := m["sample_rate"].(float32); ok {
-		out.SampleRate = float64(v)
+		out.SampleRate = aws.Float64(float64(v))
 	}
 
 	return &out
@@ -3021,10 +3021,10 @@ func expandChannelEncoderSettingsAudioDescriptionsRemixSettings(tfList []interfa
 		out.ChannelMappings = expandChannelMappings(v.List())
 	}
 	if v, ok := m["channels_in"].(int); ok {
-		out.ChannelsIn = int32(v)
+		out.ChannelsIn = aws.Int32(int32(v))
 	}
 	if v, ok := m["channels_out"].(int); ok {
-		out.ChannelsOut = int32(v)
+		out.ChannelsOut = aws.Int32(int32(v))
 	}
 
 	return &out
@@ -3047,7 +3047,7 @@ func expandChannelMappings(tfList []interface{}) []types.AudioChannelMapping {
 			o.InputChannelLevels = expandInputChannelLevels(v.List())
 		}
 		if v, ok := m["output_channel"].(int); ok {
-			o.OutputChannel = int32(v)
+			o.OutputChannel = aws.Int32(int32(v))
 		}
 
 		out = append(out, o)
@@ -3070,10 +3070,10 @@ func expandInputChannelLevels(tfList []interface{}) []types.InputChannelLevel {
 
 		var o types.InputChannelLevel
 		if v, ok := m["gain"].(int); ok {
-			o.Gain = int32(v)
+			o.Gain = aws.Int32(int32(v))
 		}
 		if v, ok := m["input_channel"].(int); ok {
-			o.InputChannel = int32(v)
+			o.InputChannel = aws.Int32(int32(v))
 		}
 
 		out = append(out, o)
@@ -3166,7 +3166,7 @@ func expandArchiveGroupSettings(tfList []interface{}) *types.ArchiveGroupSetting
 		o.ArchiveCdnSettings = expandArchiveCDNSettings(v)
 	}
 	if v, ok := m["rollover_interval"].(int); ok {
-		o.RolloverInterval = int32(v)
+		o.RolloverInterval = aws.Int32(int32(v))
 	}
 
 	return &o
@@ -3279,7 +3279,7 @@ func expandHLSGroupSettings(tfList []interface{}) *types.HlsGroupSettings {
 		out.IncompleteSegmentBehavior = types.HlsIncompleteSegmentBehavior(v)
 	}
 	if v, ok := m["index_n_segments"].(int); ok {
-		out.IndexNSegments = int32(v)
+		out.IndexNSegments = aws.Int32(int32(v))
 	}
 	if v, ok := m["input_loss_action"].(string); ok && v != "" {
 		out.InputLossAction = types.InputLossActionForHlsOut(v)
@@ -3291,7 +3291,7 @@ func expandHLSGroupSettings(tfList []interface{}) *types.HlsGroupSettings {
 		out.IvSource = types.HlsIvSource(v)
 	}
 	if v, ok := m["keep_segments"].(int); ok {
-		out.KeepSegments = int32(v)
+		out.KeepSegments = aws.Int32(int32(v))
 	}
 	if v, ok := m["key_format"].(string); ok && v != "" {
 		out.KeyFormat = aws.String(v)
@@ -3309,7 +3309,7 @@ func expandHLSGroupSettings(tfList []interface{}) *types.HlsGroupSettings {
 		out.ManifestDurationFormat = types.HlsManifestDurationFormat(v)
 	}
 	if v, ok := m["min_segment_length"].(int); ok {
-		out.MinSegmentLength = int32(v)
+		out.MinSegmentLength = aws.Int32(int32(v))
 	}
 	if v, ok := m["mode"].(string); ok && v != "" {
 		out.Mode = types.HlsMode(v)
@@ -3324,16 +3324,16 @@ func expandHLSGroupSettings(tfList []interface{}) *types.HlsGroupSettings {
 		out.ProgramDateTimeClock = types.HlsProgramDateTimeClock(v)
 	}
 	if v, ok := m["program_date_time_period"].(int); ok {
-		out.ProgramDateTimePeriod = int32(v)
+		out.ProgramDateTimePeriod = aws.Int32(int32(v))
 	}
 	if v, ok := m["redundant_manifest"].(string); ok && v != "" {
 		out.RedundantManifest = types.HlsRedundantManifest(v)
 	}
 	if v, ok := m["segment_length"].(int); ok {
-		out.SegmentLength = int32(v)
+		out.SegmentLength = aws.Int32(int32(v))
 	}
 	if v, ok := m["segments_per_subdirectory"].(int); ok {
-		out.SegmentsPerSubdirectory = int32(v)
+		out.SegmentsPerSubdirectory = aws.Int32(int32(v))
 	}
 	if v, ok := m["stream_inf_resolution"].(string); ok && v != "" {
 		out.StreamInfResolution = types.HlsStreamInfResolution(v)
@@ -3342,10 +3342,10 @@ func expandHLSGroupSettings(tfList []interface{}) *types.HlsGroupSettings {
 		out.TimedMetadataId3Frame = types.HlsTimedMetadataId3Frame(v)
 	}
 	if v, ok := m["timed_metadata_id3_period"].(int); ok {
-		out.TimedMetadataId3Period = int32(v)
+		out.TimedMetadataId3Period = aws.Int32(int32(v))
 	}
 	if v, ok := m["timestamp_delta_milliseconds"].(int); ok {
-		out.TimestampDeltaMilliseconds = int32(v)
+		out.TimestampDeltaMilliseconds = aws.Int32(int32(v))
 	}
 	if v, ok := m["ts_file_mode"].(string); ok && v != "" {
 		out.TsFileMode = types.HlsTsFileMode(v)
@@ -3375,7 +3375,7 @@ func expandMsSmoothGroupSettings(tfList []interface{}) *types.MsSmoothGroupSetti
 		out.CertificateMode = types.SmoothGroupCertificateMode(v)
 	}
 	if v, ok := m["connection_retry_interval"].(int); ok {
-		out.ConnectionRetryInterval = int32(v)
+		out.ConnectionRetryInterval = aws.Int32(int32(v))
 	}
 	if v, ok := m["event_id"].(string); ok && v != "" {
 		out.EventId = aws.String(v)
@@ -3387,25 +3387,25 @@ func expandMsSmoothGroupSettings(tfList []interface{}) *types.MsSmoothGroupSetti
 		out.EventStopBehavior = types.SmoothGroupEventStopBehavior(v)
 	}
 	if v, ok := m["filecache_duration"].(int); ok {
-		out.FilecacheDuration = int32(v)
+		out.FilecacheDuration = aws.Int32(int32(v))
 	}
 	if v, ok := m["fragment_length"].(int); ok {
-		out.FragmentLength = int32(v)
+		out.FragmentLength = aws.Int32(int32(v))
 	}
 	if v, ok := m["input_loss_action"].(string); ok && v != "" {
 		out.InputLossAction = types.InputLossActionForMsSmoothOut(v)
 	}
 	if v, ok := m["num_retries"].(int); ok {
-		out.NumRetries = int32(v)
+		out.NumRetries = aws.Int32(int32(v))
 	}
 	if v, ok := m["restart_delay"].(int); ok {
-		out.RestartDelay = int32(v)
+		out.RestartDelay = aws.Int32(int32(v))
 	}
 	if v, ok := m["segmentation_mode"].(string); ok && v != "" {
 		out.SegmentationMode = types.SmoothGroupSegmentationMode(v)
 	}
 	if v, ok := m["send_delay_ms"].(int); ok {
-		out.SendDelayMs = int32(v)
+		out.SendDelayMs = aws.Int32(int32(v))
 	}
 	if v, ok := m["sparse_track_type"].(string); ok && v != "" {
 		out.SparseTrackType = types.SmoothGroupSparseTrackType(v)
@@ -3458,19 +3458,19 @@ func expandHSLAkamaiSettings(tfList []interface{}) *types.HlsAkamaiSettings {
 
 	var out types.HlsAkamaiSettings
 	if v, ok := m["connection_retry_interval"].(int); ok {
-		out.ConnectionRetryInterval = int32(v)
+		out.ConnectionRetryInterval = aws.Int32(int32(v))
 	}
 	if v, ok := m["filecache_duration"].(int); ok {
-		out.FilecacheDuration = int32(v)
+		out.FilecacheDuration = aws.Int32(int32(v))
 	}
 	if v, ok := m["http_transfer_mode"].(string); ok && v != "" {
 		out.HttpTransferMode = types.HlsAkamaiHttpTransferMode(v)
 	}
 	if v, ok := m["num_retries"].(int); ok {
-		out.NumRetries = int32(v)
+		out.NumRetries = aws.Int32(int32(v))
 	}
 	if v, ok := m["restart_delay"].(int); ok {
-		out.RestartDelay = int32(v)
+		out.RestartDelay = aws.Int32(int32(v))
 	}
 	if v, ok := m["salt"].(string); ok && v != "" {
 		out.Salt = aws.String(v)
@@ -3491,16 +3491,16 @@ func expandHSLBasicPutSettings(tfList []interface{}) *types.HlsBasicPutSettings
 
 	var out types.HlsBasicPutSettings
 	if v, ok := m["connection_retry_interval"].(int); ok {
-		out.ConnectionRetryInterval = int32(v)
+		out.ConnectionRetryInterval = aws.Int32(int32(v))
 	}
 	if v, ok := m["filecache_duration"].(int); ok {
-		out.FilecacheDuration = int32(v)
+		out.FilecacheDuration = aws.Int32(int32(v))
 	}
 	if v, ok := m["num_retries"].(int); ok {
-		out.NumRetries = int32(v)
+		out.NumRetries = aws.Int32(int32(v))
 	}
 	if v, ok := m["restart_delay"].(int); ok {
-		out.RestartDelay = int32(v)
+		out.RestartDelay = aws.Int32(int32(v))
 	}
 
 	return &out
@@ -3515,19 +3515,19 @@ func expandHLSMediaStoreSettings(tfList []interface{}) *types.HlsMediaStoreSetti
 
 	var out types.HlsMediaStoreSettings
 	if v, ok := m["connection_retry_interval"].(int); ok {
-		out.ConnectionRetryInterval = int32(v)
+		out.ConnectionRetryInterval = aws.Int32(int32(v))
 	}
 	if v, ok := m["filecache_duration"].(int); ok {
-		out.FilecacheDuration = int32(v)
+		out.FilecacheDuration = aws.Int32(int32(v))
 	}
 	if v, ok := m["media_store_storage_class"].(string); ok && v != "" {
 		out.MediaStoreStorageClass = types.HlsMediaStoreStorageClass(v)
 	}
 	if v, ok := m["num_retries"].(int); ok {
-		out.NumRetries = int32(v)
+		out.NumRetries = aws.Int32(int32(v))
 	}
 	if v, ok := m["restart_delay"].(int); ok {
-		out.RestartDelay = int32(v)
+		out.RestartDelay = aws.Int32(int32(v))
 	}
 
 	return &out
@@ -3557,19 +3557,19 @@ func expandHLSWebdavSettings(tfList []interface{}) *types.HlsWebdavSettings {
 
 	var out types.HlsWebdavSettings
 	if v, ok := m["connection_retry_interval"].(int); ok {
-		out.ConnectionRetryInterval = int32(v)
+		out.ConnectionRetryInterval = aws.Int32(int32(v))
 	}
 	if v, ok := m["filecache_duration"].(int); ok {
-		out.FilecacheDuration = int32(v)
+		out.FilecacheDuration = aws.Int32(int32(v))
 	}
 	if v, ok := m["http_transfer_mode"].(string); ok && v != "" {
 		out.HttpTransferMode = types.HlsWebdavHttpTransferMode(v)
 	}
 	if v, ok := m["num_retries"].(int); ok {
-		out.NumRetries = int32(v)
+		out.NumRetries = aws.Int32(int32(v))
 	}
 	if v, ok := m["restart_delay"].(int); ok {
-		out.RestartDelay = int32(v)
+		out.RestartDelay = aws.Int32(int32(v))
 	}
 	return &out
 }
@@ -3588,7 +3588,7 @@ func expandHSLGroupSettingsCaptionLanguageMappings(tfList []interface{}) []types
 
 		var o types.CaptionLanguageMapping
 		if v, ok := m["caption_channel"].(int); ok {
-			o.CaptionChannel = int32(v)
+			o.CaptionChannel = aws.Int32(int32(v))
 		}
 		if v, ok := m["language_code"].(string); ok && v != "" {
 			o.LanguageCode = aws.String(v)
@@ -3737,7 +3737,7 @@ func expandRtmpGroupSettings(tfList []interface{}) *types.RtmpGroupSettings {
 		out.CacheFullBehavior = types.RtmpCacheFullBehavior(v)
 	}
 	if v, ok := m["cache_length"].(int); ok {
-		out.CacheLength = int32(v)
+		out.CacheLength = aws.Int32(int32(v))
 	}
 	if v, ok := m["caption_data"].(string); ok && v != "" {
 		out.CaptionData = types.RtmpCaptionData(v)
@@ -3746,7 +3746,7 @@ func expandRtmpGroupSettings(tfList []interface{}) *types.RtmpGroupSettings {
 		out.InputLossAction = types.InputLossActionForRtmpOut(v)
 	}
 	if v, ok := m["restart_delay"].(int); ok {
-		out.RestartDelay = int32(v)
+		out.RestartDelay = aws.Int32(int32(v))
 	}
 
 	return &out
@@ -3767,7 +3767,7 @@ func expandUdpGroupSettings(tfList []interface{}) *types.UdpGroupSettings {
 		out.TimedMetadataId3Frame = types.UdpTimedMetadataId3Frame(v)
 	}
 	if v, ok := m["timed_metadata_id3_period"].(int); ok {
-		out.TimedMetadataId3Period = int32(v)
+		out.TimedMetadataId3Period = aws.Int32(int32(v))
 	}
 
 	return &out
@@ -4054,7 +4054,7 @@ func expandStandardHLSSettingsH3u8Settings(tfList []interface{}) *types.M3u8Sett
 
 	var out types.M3u8Settings
 	if v, ok := m["audio_frames_per_pes"].(int); ok {
-		out.AudioFramesPerPes = int32(v)
+		out.AudioFramesPerPes = aws.Int32(int32(v))
 	}
 	if v, ok := m["audio_pids"].(string); ok && v != "" {
 		out.AudioPids = aws.String(v)
@@ -4066,25 +4066,25 @@ func expandStandardHLSSettingsH3u8Settings(tfList []interface{}) *types.M3u8Sett
 		out.NielsenId3Behavior = types.M3u8NielsenId3Behavior(v)
 	}
 	if v, ok := m["pat_interval"].(int); ok {
-		out.PatInterval = int32(v)
+		out.PatInterval = aws.Int32(int32(v))
 	}
 	if v, ok := m["pcr_control"].(string); ok && v != "" {
 		out.PcrControl = types.M3u8PcrControl(v)
 	}
 	if v, ok := m["pcr_period"].(int); ok {
-		out.PcrPeriod = int32(v)
+		out.PcrPeriod = aws.Int32(int32(v))
 	}
 	if v, ok := m["pcr_pid"].(string); ok && v != "" {
 		out.PcrPid = aws.String(v)
 	}
 	if v, ok := m["pmt_interval"].(int); ok {
-		out.PmtInterval = int32(v)
+		out.PmtInterval = aws.Int32(int32(v))
 	}
 	if v, ok := m["pmt_pid"].(string); ok && v != "" {
 		out.PmtPid = aws.String(v)
 	}
 	if v, ok := m["program_num"].(int); ok {
-		out.ProgramNum = int32(v)
+		out.ProgramNum = aws.Int32(int32(v))
 	}
 	if v, ok := m["scte35_behavior"].(string); ok && v != "" {
 		out.Scte35Behavior = types.M3u8Scte35Behavior(v)
@@ -4099,7 +4099,7 @@ func expandStandardHLSSettingsH3u8Settings(tfList []interface{}) *types.M3u8Sett
 		out.TimedMetadataPid = aws.String(v)
 	}
 	if v, ok := m["transport_stream_id"].(int); ok {
-		out.TransportStreamId = int32(v)
+		out.TransportStreamId = aws.Int32(int32(v))
 	}
 	if v, ok := m["video_pid"].(string); ok && v != "" {
 		out.VideoPid = aws.String(v)
@@ -4123,10 +4123,10 @@ func expandOutputsOutputSettingsRtmpOutputSettings(tfList []interface{}) *types.
 		settings.CertificateMode = types.RtmpOutputCertificateMode(v)
 	}
 	if v, ok := m["connection_retry_interval"].(int); ok {
-		settings.ConnectionRetryInterval = int32(v)
+		settings.ConnectionRetryInterval = aws.Int32(int32(v))
 	}
 	if v, ok := m["num_retries"].(int); ok {
-		settings.NumRetries = int32(v)
+		settings.NumRetries = aws.Int32(int32(v))
 	}
 
 	return &settings
@@ -4147,7 +4147,7 @@ func expandOutputsOutputSettingsUdpOutputSettings(tfList []interface{}) *types.U
 		settings.Destination = expandDestination(v)
 	}
 	if v, ok := m["buffer_msec"].(int); ok {
-		settings.BufferMsec = int32(v)
+		settings.BufferMsec = aws.Int32(int32(v))
 	}
 	if v, ok := m["fec_output_settings"].([]interface{}); ok && len(v) > 0 {
 		settings.FecOutputSettings = expandFecOutputSettings(v)
@@ -4198,13 +4198,13 @@ func expandFecOutputSettings(tfList []interface{}) *types.FecOutputSettings {
 
 	var settings types.FecOutputSettings
 	if v, ok := m["column_depth"].(int); ok {
-		settings.ColumnDepth = int32(v)
+		settings.ColumnDepth = aws.Int32(int32(v))
 	}
 	if v, ok := m["include_fec"].(string); ok && v != "" {
 		settings.IncludeFec = types.FecOutputIncludeFec(v)
 	}
 	if v, ok := m["row_length"].(int); ok {
-		settings.RowLength = int32(v)
+		settings.RowLength = aws.Int32(int32(v))
 	}
 
 	return &settings
@@ -4234,7 +4234,7 @@ func expandM2tsSettings(tfList []interface{}) *types.M2tsSettings {
 		s.AudioBufferModel = types.M2tsAudioBufferModel(v)
 	}
 	if v, ok := m["audio_frames_per_pes"].(int); ok {
-		s.AudioFramesPerPes = int32(v)
+		s.AudioFramesPerPes = aws.Int32(int32(v))
 	}
 	if v, ok := m["audio_pids"].(string); ok && v != "" {
 		s.AudioPids = aws.String(v)
@@ -4243,7 +4243,7 @@ func expandM2tsSettings(tfList []interface{}) *types.M2tsSettings {
 		s.AudioStreamType = types.M2tsAudioStreamType(v)
 	}
 	if v, ok := m["bitrate"].(int); ok {
-		s.Bitrate = int32(v)
+		s.Bitrate = aws.Int32(int32(v))
 	}
 	if v, ok := m["buffer_model"].(string); ok && v != "" {
 		s.BufferModel = types.M2tsBufferModel(v)
@@ -4270,7 +4270,7 @@ func expandM2tsSettings(tfList []interface{}) *types.M2tsSettings {
 
 			var s types.DvbTdtSettings
 			if v, ok := m["rep_interval"].(int); ok {
-				s.RepInterval = int32(v)
+				s.RepInterval = aws.Int32(int32(v))
 			}
 			return &s
 		}(v)
@@ -4285,7 +4285,7 @@ func expandM2tsSettings(tfList []interface{}) *types.M2tsSettings {
 		s.EbpAudioInterval = types.M2tsAudioInterval(v)
 	}
 	if v, ok := m["ebp_lookahead_ms"].(int); ok {
-		s.EbpLookaheadMs = int32(v)
+		s.EbpLookaheadMs = aws.Int32(int32(v))
 	}
 	if v, ok := m["ebp_placement"].(string); ok && v != "" {
 		s.EbpPlacement = types.M2tsEbpPlacement(v)
@@ -4303,7 +4303,7 @@ func expandM2tsSettings(tfList []interface{}) *types.M2tsSettings {
 		s.EtvSignalPid = aws.String(v)
 	}
 	if v, ok := m["fragment_time"].(float64); ok {
-		s.FragmentTime = v
+		s.FragmentTime = aws.Float64(v)
 	}
 	if v, ok := m["klv"].(string); ok && v != "" {
 		s.Klv = types.M2tsKlv(v)
@@ -4315,28 +4315,28 @@ func expandM2tsSettings(tfList []interface{}) *types.M2tsSettings {
 		s.NielsenId3Behavior = types.M2tsNielsenId3Behavior(v)
 	}
 	if v, ok := m["null_packet_bitrate"].(float32); ok {
-		s.NullPacketBitrate = float64(v)
+		s.NullPacketBitrate = aws.Float64(float64(v))
 	}
 	if v, ok := m["pat_interval"].(int); ok {
-		s.PatInterval = int32(v)
+		s.PatInterval = aws.Int32(int32(v))
 	}
 	if v, ok := m["pcr_control"].(string); ok && v != "" {
 		s.PcrControl = types.M2tsPcrControl(v)
 	}
 	if v, ok := m["pcr_period"].(int); ok {
-		s.PcrPeriod = int32(v)
+		s.PcrPeriod = aws.Int32(int32(v))
 	}
 	if v, ok := m["pcr_pid"].(string); ok && v != "" {
 		s.PcrPid = aws.String(v)
 	}
 	if v, ok := m["pmt_interval"].(int); ok {
-		s.PmtInterval = int32(v)
+		s.PmtInterval = aws.Int32(int32(v))
 	}
 	if v, ok := m["pmt_pid"].(string); ok && v != "" {
 		s.PmtPid = aws.String(v)
 	}
 	if v, ok := m["program_num"].(int); ok {
-		s.ProgramNum = int32(v)
+		s.ProgramNum = aws.Int32(int32(v))
 	}
 	if v, ok := m["rate_mode"].(string); ok && v != "" {
 		s.RateMode = types.M2tsRateMode(v)
@@ -4357,7 +4357,7 @@ func expandM2tsSettings(tfList []interface{}) *types.M2tsSettings {
 		s.SegmentationStyle = types.M2tsSegmentationStyle(v)
 	}
 	if v, ok := m["segmentation_time"].(float64); ok {
-		s.SegmentationTime = v
+		s.SegmentationTime = aws.Float64(v)
 	}
 	if v, ok := m["timed_metadata_behavior"].(string); ok && v != "" {
 		s.TimedMetadataBehavior = types.M2tsTimedMetadataBehavior(v)
@@ -4366,7 +4366,7 @@ func expandM2tsSettings(tfList []interface{}) *types.M2tsSettings {
 		s.TimedMetadataPid = aws.String(v)
 	}
 	if v, ok := m["transport_stream_id"].(int); ok {
-		s.TransportStreamId = int32(v)
+		s.TransportStreamId = aws.Int32(int32(v))
 	}
 	if v, ok := m["video_pid"].(string); ok && v != "" {
 		s.VideoPid = aws.String(v)
@@ -4384,13 +4384,13 @@ func expandM2tsDvbNitSettings(tfList []interface{}) *types.DvbNitSettings {
 
 	var s types.DvbNitSettings
 	if v, ok := m["network_ids"].(int); ok {
-		s.NetworkId = int32(v)
+		s.NetworkId = aws.Int32(int32(v))
 	}
 	if v, ok := m["network_name"].(string); ok && v != "" {
 		s.NetworkName = aws.String(v)
 	}
 	if v, ok := m["network_ids"].(int); ok {
-		s.RepInterval = int32(v)
+		s.RepInterval = aws.Int32(int32(v))
 	}
 	return &s
 }
@@ -4407,7 +4407,7 @@ func expandM2tsDvbSdtSettings(tfList []interface{}) *types.DvbSdtSettings {
 		s.OutputSdt = types.DvbSdtOutputSdt(v)
 	}
 	if v, ok := m["rep_interval"].(int); ok {
-		s.RepInterval = int32(v)
+		s.RepInterval = aws.Int32(int32(v))
 	}
 	if v, ok := m["service_name"].(string); ok && v != "" {
 		s.ServiceName = aws.String(v)
@@ -4430,7 +4430,7 @@ func expandChannelEncoderSettingsTimecodeConfig(tfList []interface{}) *types.Tim
 		config.Source = types.TimecodeConfigSource(v)
 	}
 	if v, ok := m["sync_threshold"].(int32); ok {
-		config.SyncThreshold = v
+		config.SyncThreshold = aws.Int32(v)
 	}
 
 	return &config
@@ -4456,7 +4456,7 @@ func expandChannelEncoderSettingsVideoDescriptions(tfList []interface{}) []types
 			d.CodecSettings = expandChannelEncoderSettingsVideoDescriptionsCodecSettings(v)
 		}
 		if v, ok := m["height"].(int); ok {
-			d.Height = int32(v)
+			d.Height = aws.Int32(int32(v))
 		}
 		if v, ok := m["respond_to_afd"].(string); ok && v != "" {
 			d.RespondToAfd = types.VideoDescriptionRespondToAfd(v)
@@ -4465,10 +4465,10 @@ func expandChannelEncoderSettingsVideoDescriptions(tfList []interface{}) []types
 			d.ScalingBehavior = types.VideoDescriptionScalingBehavior(v)
 		}
 		if v, ok := m["sharpness"].(int); ok {
-			d.Sharpness = int32(v)
+			d.Sharpness = aws.Int32(int32(v))
 		}
 		if v, ok := m["width"].(int); ok {
-			d.Width = int32(v)
+			d.Width = aws.Int32(int32(v))
 		}
 
 		videoDesc = append(videoDesc, d)
@@ -4525,7 +4525,7 @@ func expandsVideoDescriptionsCodecSettingsFrameCaptureSettings(tfList []interfac
 
 	var out types.FrameCaptureSettings
 	if v, ok := m["capture_interval"].(int); ok {
-		out.CaptureInterval = int32(v)
+		out.CaptureInterval = aws.Int32(int32(v))
 	}
 	if v, ok := m["capture_interval_units"].(string); ok && v != "" {
 		out.CaptureIntervalUnits = types.FrameCaptureIntervalUnit(v)
@@ -4549,13 +4549,13 @@ func expandsVideoDescriptionsCodecSettingsH264Settings(tfList []interface{}) *ty
 		out.AfdSignaling = types.AfdSignaling(v)
 	}
 	if v, ok := m["bitrate"].(int); ok {
-		out.Bitrate = int32(v)
+		out.Bitrate = aws.Int32(int32(v))
 	}
 	if v, ok := m["buf_fill_pct"].(int); ok {
-		out.BufFillPct = int32(v)
+		out.BufFillPct = aws.Int32(int32(v))
 	}
 	if v, ok := m["buf_size"].(int); ok {
-		out.BufSize = int32(v)
+		out.BufSize = aws.Int32(int32(v))
 	}
 	if v, ok := m["color_metadata"].(string); ok && v != "" {
 		out.ColorMetadata = types.H264ColorMetadata(v)
@@ -4579,22 +4579,22 @@ func expandsVideoDescriptionsCodecSettingsH264Settings(tfList []interface{}) *ty
 		out.FramerateControl = types.H264FramerateControl(v)
 	}
 	if v, ok := m["framerate_denominator"].(int); ok {
-		out.FramerateDenominator = int32(v)
+		out.FramerateDenominator = aws.Int32(int32(v))
 	}
 	if v, ok := m["framerate_numerator"].(int); ok {
-		out.FramerateNumerator = int32(v)
+		out.FramerateNumerator = aws.Int32(int32(v))
 	}
 	if v, ok := m["gop_b_reference"].(string); ok && v != "" {
 		out.GopBReference = types.H264GopBReference(v)
 	}
 	if v, ok := m["gop_closed_cadence"].(int); ok {
-		out.GopClosedCadence = int32(v)
+		out.GopClosedCadence = aws.Int32(int32(v))
 	}
 	if v, ok := m["gop_num_b_frames"].(int); ok {
-		out.GopNumBFrames = int32(v)
+		out.GopNumBFrames = aws.Int32(int32(v))
 	}
 	if v, ok := m["gop_size"].(float64); ok {
-		out.GopSize = v
+		out.GopSize = aws.Float64(v)
 	}
 	if v, ok := m["gop_size_units"].(string); ok && v != "" {
 		out.GopSizeUnits = types.H264GopSizeUnits(v)
@@ -4606,22 +4606,22 @@ func expandsVideoDescriptionsCodecSettingsH264Settings(tfList []interface{}) *ty
 		out.LookAheadRateControl = types.H264LookAheadRateControl(v)
 	}
 	if v, ok := m["max_bitrate"].(int); ok {
-		out.MaxBitrate = int32(v)
+		out.MaxBitrate = aws.Int32(int32(v))
 	}
 	if v, ok := m["min_i_interval"].(int); ok {
-		out.MinIInterval = int32(v)
+		out.MinIInterval = aws.Int32(int32(v))
 	}
 	if v, ok := m["num_ref_frames"].(int); ok {
-		out.NumRefFrames = int32(v)
+		out.NumRefFrames = aws.Int32(int32(v))
 	}
 	if v, ok := m["par_control"].(string); ok && v != "" {
 		out.ParControl = types.H264ParControl(v)
 	}
 	if v, ok := m["par_denominator"].(int); ok {
-		out.ParDenominator = int32(v)
+		out.ParDenominator = aws.Int32(int32(v))
 	}
 	if v, ok := m["par_numerator"].(int); ok {
-		out.ParNumerator = int32(v)
+		out.ParNumerator = aws.Int32(int32(v))
 	}
 	if v, ok := m["profile"].(string); ok && v != "" {
 		out.Profile = types.H264Profile(v)
@@ -4630,7 +4630,7 @@ func expandsVideoDescriptionsCodecSettingsH264Settings(tfList []interface{}) *ty
 		out.QualityLevel = types.H264QualityLevel(v)
 	}
 	if v, ok := m["qvbr_quality_level"].(int); ok {
-		out.QvbrQualityLevel = int32(v)
+		out.QvbrQualityLevel = aws.Int32(int32(v))
 	}
 	if v, ok := m["rate_control_mode"].(string); ok && v != "" {
 		out.RateControlMode = types.H264RateControlMode(v)
@@ -4642,10 +4642,10 @@ func expandsVideoDescriptionsCodecSettingsH264Settings(tfList []interface{}) *ty
 		out.SceneChangeDetect = types.H264SceneChangeDetect(v)
 	}
 	if v, ok := m["slices"].(int); ok {
-		out.Slices = int32(v)
+		out.Slices = aws.Int32(int32(v))
 	}
 	if v, ok := m["softness"].(int); ok {
-		out.Softness = int32(v)
+		out.Softness = aws.Int32(int32(v))
 	}
 	if v, ok := m["spatial_aq"].(string); ok && v != "" {
 		out.SpatialAq = types.H264SpatialAq(v)
@@ -4708,10 +4708,10 @@ func expandsVideoDescriptionsCodecSettingsH265Settings(tfList []interface{}) *ty
 
 	var out types.H265Settings
 	if v, ok := m["framerate_denominator"].(int); ok {
-		out.FramerateDenominator = int32(v)
+		out.FramerateDenominator = aws.Int32(int32(v))
 	}
 	if v, ok := m["framerate_numerator"].(int); ok {
-		out.FramerateNumerator = int32(v)
+		out.FramerateNumerator = aws.Int32(int32(v))
 	}
 	if v, ok := m["adaptive_quantization"].(string); ok && v != "" {
 		out.AdaptiveQuantization = types.H265AdaptiveQuantization(v)
@@ -4723,10 +4723,10 @@ func expandsVideoDescriptionsCodecSettingsH265Settings(tfList []interface{}) *ty
 		out.AlternativeTransferFunction = types.H265AlternativeTransferFunction(v)
 	}
 	if v, ok := m["bitrate"].(int); ok {
-		out.Bitrate = int32(v)
+		out.Bitrate = aws.Int32(int32(v))
 	}
 	if v, ok := m["buf_size"].(int); ok {
-		out.BufSize = int32(v)
+		out.BufSize = aws.Int32(int32(v))
 	}
 	if v, ok := m["color_metadata"].(string); ok && v != "" {
 		out.ColorMetadata = types.H265ColorMetadata(v)
@@ -4744,10 +4744,10 @@ func expandsVideoDescriptionsCodecSettingsH265Settings(tfList []interface{}) *ty
 		out.FlickerAq = types.H265FlickerAq(v)
 	}
 	if v, ok := m["gop_closed_cadence"].(int); ok {
-		out.GopClosedCadence = int32(v)
+		out.GopClosedCadence = aws.Int32(int32(v))
 	}
 	if v, ok := m["gop_size"].(float64); ok {
-		out.GopSize = v
+		out.GopSize = aws.Float64(v)
 	}
 	if v, ok := m["gop_size_units"].(string); ok && v != "" {
 		out.GopSizeUnits = types.H265GopSizeUnits(v)
@@ -4759,22 +4759,22 @@ func expandsVideoDescriptionsCodecSettingsH265Settings(tfList []interface{}) *ty
 		out.LookAheadRateControl = types.H265LookAheadRateControl(v)
 	}
 	if v, ok := m["max_bitrate"].(int); ok {
-		out.MaxBitrate = int32(v)
+		out.MaxBitrate = aws.Int32(int32(v))
 	}
 	if v, ok := m["min_i_interval"].(int); ok {
-		out.MinIInterval = int32(v)
+		out.MinIInterval = aws.Int32(int32(v))
 	}
 	if v, ok := m["par_denominator"].(int); ok {
-		out.ParDenominator = int32(v)
+		out.ParDenominator = aws.Int32(int32(v))
 	}
 	if v, ok := m["par_numerator"].(int); ok {
-		out.ParNumerator = int32(v)
+		out.ParNumerator = aws.Int32(int32(v))
 	}
 	if v, ok := m["profile"].(string); ok && v != "" {
 		out.Profile = types.H265Profile(v)
 	}
 	if v, ok := m["qvbr_quality_level"].(int); ok {
-		out.QvbrQualityLevel = int32(v)
+		out.QvbrQualityLevel = aws.Int32(int32(v))
 	}
 	if v, ok := m["rate_control_mode"].(string); ok && v != "" {
 		out.RateControlMode = types.H265RateControlMode(v)
@@ -4786,7 +4786,7 @@ func expandsVideoDescriptionsCodecSettingsH265Settings(tfList []interface{}) *ty
 		out.SceneChangeDetect = types.H265SceneChangeDetect(v)
 	}
 	if v, ok := m["slices"].(int); ok {
-		out.Slices = int32(v)
+		out.Slices = aws.Int32(int32(v))
 	}
 	if v, ok := m["tier"].(string); ok && v != "" {
 		out.Tier = types.H265Tier(v)
@@ -4837,10 +4837,10 @@ func expandH265Hdr10Settings(tfList []interface{}) *types.Hdr10Settings {
 
 	var out types.Hdr10Settings
 	if v, ok := m["max_cll"].(int); ok {
-		out.MaxCll = int32(v)
+		out.MaxCll = aws.Int32(int32(v))
 	}
 	if v, ok := m["max_fall"].(int); ok {
-		out.MaxFall = int32(v)
+		out.MaxFall = aws.Int32(int32(v))
 	}
 
 	return &out
@@ -4933,7 +4933,7 @@ func expandNielsenNaseIiNwSettings(tfList []interface{}) *types.NielsenNaesIiNw
 		out.CheckDigitString = aws.String(v)
 	}
 	if v, ok := m["sid"].(float32); ok {
-		out.Sid = float64(v)
+		out.Sid = aws.Float64(float64(v))
 	}
 
 	return &out
@@ -5211,22 +5211,22 @@ func flattenStandardHLSSettingsM3u8Settings(in *types.M3u8Settings) []interface{
 	}
 
 	m := map[string]interface{}{
-		"audio_frames_per_pes":    int(in.AudioFramesPerPes),
+		"audio_frames_per_pes":    int(aws.ToInt32(in.AudioFramesPerPes)),
 		"audio_pids":              aws.ToString(in.AudioPids),
 		"ecm_pid":                 aws.ToString(in.EcmPid),
 		"nielsen_id3_behavior":    string(in.NielsenId3Behavior),
-		"pat_interval":            int(in.PatInterval),
+		"pat_interval":            int(aws.ToInt32(in.PatInterval)),
 		"pcr_control":             string(in.PcrControl),
-		"pcr_period":              int(in.PcrPeriod),
+		"pcr_period":              int(aws.ToInt32(in.PcrPeriod)),
 		"pcr_pid":                 aws.ToString(in.PcrPid),
-		"pmt_interval":            int(in.PmtInterval),
+		"pmt_interval":            int(aws.ToInt32(in.PmtInterval)),
 		"pmt_pid":                 aws.ToString(in.PmtPid),
-		"program_num":             int(in.ProgramNum),
+		"program_num":             int(aws.ToInt32(in.ProgramNum)),
 		"scte35_behavior":         string(in.Scte35Behavior),
 		"scte35_pid":              aws.ToString(in.Scte35Pid),
 		"timed_metadata_behavior": string(in.TimedMetadataBehavior),
 		"timed_metadata_pid":      aws.ToString(in.TimedMetadataPid),
-		"transport_stream_id":     int(in.TransportStreamId),
+		"transport_stream_id":     int(aws.ToInt32(in.TransportStreamId)),
 		"video_pid":               aws.ToString(in.VideoPid),
 	}
 
@@ -5241,8 +5241,8 @@ func flattenOutputsOutputSettingsRtmpOutputSettings(in *types.RtmpOutputSettings
 	m := map[string]interface{}{
 		"destination":               flattenDestination(in.Destination),
 		"certificate_mode":          string(in.CertificateMode),
-		"connection_retry_interval": int(in.ConnectionRetryInterval),
-		"num_retries":               int(in.NumRetries),
+		"connection_retry_interval": int(aws.ToInt32(in.ConnectionRetryInterval)),
+		"num_retries":               int(aws.ToInt32(in.NumRetries)),
 	}
 
 	return []interface{}{m}
@@ -5256,7 +5256,7 @@ func flattenOutputsOutputSettingsUdpOutputSettings(in *types.UdpOutputSettings)
 	m := map[string]interface{}{
 		"container_settings":  flattenOutputsOutputSettingsUdpOutputSettingsContainerSettings(in.ContainerSettings),
 		"destination":         flattenDestination(in.Destination),
-		"buffer_msec":         int(in.BufferMsec),
+		"buffer_msec":         int(aws.ToInt32(in.BufferMsec)),
 		"fec_output_settings": flattenFecOutputSettings(in.FecOutputSettings),
 	}
 
@@ -5294,9 +5294,9 @@ func flattenFecOutputSettings(in *types.FecOutputSettings) []interface{} {
 	}
 
 	m := map[string]interface{}{
-		"column_depth": int(in.ColumnDepth),
+		"column_depth": int(aws.ToInt32(in.ColumnDepth)),
 		"include_fec":  string(in.IncludeFec),
-		"row_length":   int(in.RowLength),
+		"row_length":   int(aws.ToInt32(in.RowLength)),
 	}
 
 	return []interface{}{m}
@@ -5313,10 +5313,10 @@ func flattenM2tsSettings(in *types.M2tsSettings) []interface{} {
 		"arib_captions_pid":           aws.ToString(in.AribCaptionsPid),
 		"arib_captions_pid_control":   string(in.AribCaptionsPidControl),
 		"audio_buffer_model":          string(in.AudioBufferModel),
-		"audio_frames_per_pes":        int(in.AudioFramesPerPes),
+		"audio_frames_per_pes":        int(aws.ToInt32(in.AudioFramesPerPes)),
 		"audio_pids":                  aws.ToString(in.AudioPids),
 		"audio_stream_type":           string(in.AudioStreamType),
-		"bitrate":                     int(in.Bitrate),
+		"bitrate":                     int(aws.ToInt32(in.Bitrate)),
 		"buffer_model":                string(in.BufferModel),
 		"cc_descriptor":               string(in.CcDescriptor),
 		"dvb_nit_settings":            flattenDvbNitSettings(in.DvbNitSettings),
@@ -5326,7 +5326,7 @@ func flattenM2tsSettings(in *types.M2tsSettings) []interface{} {
 		"dvb_teletext_pid":            aws.ToString(in.DvbTeletextPid),
 		"ebif":                        string(in.Ebif),
 		"ebp_audio_interval":          string(in.EbpAudioInterval),
-		"ebp_lookahead_ms":            int(in.EbpLookaheadMs),
+		"ebp_lookahead_ms":            int(aws.ToInt32(in.EbpLookaheadMs)),
 		"ebp_placement":               string(in.EbpPlacement),
 		"ecm_pid":                     aws.ToString(in.EcmPid),
 		"es_rate_in_pes":              string(in.EsRateInPes),
@@ -5336,14 +5336,14 @@ func flattenM2tsSettings(in *types.M2tsSettings) []interface{} {
 		"klv":                         string(in.Klv),
 		"klv_data_pids":               aws.ToString(in.KlvDataPids),
 		"nielsen_id3_behavior":        string(in.NielsenId3Behavior),
-		"null_packet_bitrate":         float32(in.NullPacketBitrate),
-		"pat_interval":                int(in.PatInterval),
+		"null_packet_bitrate":         float32(aws.ToFloat64(in.NullPacketBitrate)),
+		"pat_interval":                int(aws.ToInt32(in.PatInterval)),
 		"pcr_control":                 string(in.PcrControl),
-		"pcr_period":                  int(in.PcrPeriod),
+		"pcr_period":                  int(aws.ToInt32(in.PcrPeriod)),
 		"pcr_pid":                     aws.ToString(in.PcrPid),
-		"pmt_interval":                int(in.PmtInterval),
+		"pmt_interval":                int(aws.ToInt32(in.PmtInterval)),
 		"pmt_pid":                     aws.ToString(in.PmtPid),
-		"program_num":                 int(in.ProgramNum),
+		"program_num":                 int(aws.ToInt32(in.ProgramNum)),
 		"rate_mode":                   string(in.RateMode),
 		"scte27_pids":                 aws.ToString(in.Scte27Pids),
 		"scte35_control":              string(in.Scte35Control),
@@ -5353,7 +5353,7 @@ func flattenM2tsSettings(in *types.M2tsSettings) []interface{} {
 		"segmentation_time":           in.SegmentationTime,
 		"timed_metadata_behavior":     string(in.TimedMetadataBehavior),
 		"timed_metadata_pid":          aws.ToString(in.TimedMetadataPid),
-		"transport_stream_id":         int(in.TransportStreamId),
+		"transport_stream_id":         int(aws.ToInt32(in.TransportStreamId)),
 		"video_pid":                   aws.ToString(in.VideoPid),
 	}
 
@@ -5366,9 +5366,9 @@ func flattenDvbNitSettings(in *types.DvbNitSettings) []interface{} {
 	}
 
 	m := map[string]interface{}{
-		"network_id":   int(in.NetworkId),
+		"network_id":   int(aws.ToInt32(in.NetworkId)),
 		"network_name": aws.ToString(in.NetworkName),
-		"rep_interval": int(in.RepInterval),
+		"rep_interval": int(aws.ToInt32(in.RepInterval)),
 	}
 
 	return []interface{}{m}
@@ -5381,7 +5381,7 @@ func flattenDvbSdtSettings(in *types.DvbSdtSettings) []interface{} {
 
 	m := map[string]interface{}{
 		"output_sdt":            string(in.OutputSdt),
-		"rep_interval":          int(in.RepInterval),
+		"rep_interval":          int(aws.ToInt32(in.RepInterval)),
 		"service_name":          aws.ToString(in.ServiceName),
 		"service_provider_name": aws.ToString(in.ServiceProviderName),
 	}
@@ -5395,7 +5395,7 @@ func flattenDvbTdtSettings(in *types.DvbTdtSettings) []interface{} {
 	}
 
 	m := map[string]interface{}{
-		"rep_interval": int(in.RepInterval),
+		"rep_interval": int(aws.ToInt32(in.RepInterval)),
 	}
 
 	return []interface{}{m}
@@ -5409,7 +5409,7 @@ func flattenOutputGroupSettingsArchiveGroupSettings(as *types.ArchiveGroupSettin
 	m := map[string]interface{}{
 		"destination":          flattenDestination(as.Destination),
 		"archive_cdn_settings": flattenOutputGroupSettingsArchiveCDNSettings(as.ArchiveCdnSettings),
-		"rollover_interval":    int(as.RolloverInterval),
+		"rollover_interval":    int(aws.ToInt32(as.RolloverInterval)),
 	}
 
 	return []interface{}{m}
@@ -5452,29 +5452,29 @@ func flattenOutputGroupSettingsHLSGroupSettings(in *types.HlsGroupSettings) []in
 		"hls_id3_segment_tagging":      string(in.HlsId3SegmentTagging),
 		"iframe_only_playlists":        string(in.IFrameOnlyPlaylists),
 		"incomplete_segment_behavior":  string(in.IncompleteSegmentBehavior),
-		"index_n_segments":             int(in.IndexNSegments),
+		"index_n_segments":             int(aws.ToInt32(in.IndexNSegments)),
 		"input_loss_action":            string(in.InputLossAction),
 		"iv_in_manifest":               string(in.IvInManifest),
 		"iv_source":                    string(in.IvSource),
-		"keep_segments":                int(in.KeepSegments),
+		"keep_segments":                int(aws.ToInt32(in.KeepSegments)),
 		"key_format":                   aws.ToString(in.KeyFormat),
 		"key_format_versions":          aws.ToString(in.KeyFormatVersions),
 		"key_provider_settings":        flattenHLSKeyProviderSettings(in.KeyProviderSettings),
 		"manifest_compression":         string(in.ManifestCompression),
 		"manifest_duration_format":     string(in.ManifestDurationFormat),
-		"min_segment_length":           int(in.MinSegmentLength),
+		"min_segment_length":           int(aws.ToInt32(in.MinSegmentLength)),
 		"mode":                         string(in.Mode),
 		"output_selection":             string(in.OutputSelection),
 		"program_date_time":            string(in.ProgramDateTime),
 		"program_date_time_clock":      string(in.ProgramDateTimeClock),
-		"program_date_time_period":     int(in.ProgramDateTimePeriod),
+		"program_date_time_period":     int(aws.ToInt32(in.ProgramDateTimePeriod)),
 		"redundant_manifest":           string(in.RedundantManifest),
-		"segment_length":               int(in.SegmentLength),
-		"segments_per_subdirectory":    int(in.SegmentsPerSubdirectory),
+		"segment_length":               int(aws.ToInt32(in.SegmentLength)),
+		"segments_per_subdirectory":    int(aws.ToInt32(in.SegmentsPerSubdirectory)),
 		"stream_inf_resolution":        string(in.StreamInfResolution),
 		"timed_metadata_id3_frame":     string(in.TimedMetadataId3Frame),
-		"timed_metadata_id3_period":    int(in.TimedMetadataId3Period),
-		"timestamp_delta_milliseconds": int(in.TimestampDeltaMilliseconds),
+		"timed_metadata_id3_period":    int(aws.ToInt32(in.TimedMetadataId3Period)),
+		"timestamp_delta_milliseconds": int(aws.ToInt32(in.TimestampDeltaMilliseconds)),
 		"ts_file_mode":                 string(in.TsFileMode),
 	}
 
@@ -5491,17 +5491,17 @@ func flattenOutputGroupSettingsMsSmoothGroupSettings(in *types.MsSmoothGroupSett
 		"acquisition_point_id":        aws.ToString(in.AcquisitionPointId),
 		"audio_only_timecode_control": string(in.AudioOnlyTimecodeControl),
 		"certificate_mode":            string(in.CertificateMode),
-		"connection_retry_interval":   int(in.ConnectionRetryInterval),
+		"connection_retry_interval":   int(aws.ToInt32(in.ConnectionRetryInterval)),
 		"event_id":                    aws.ToString(in.EventId),
 		"event_id_mode":               string(in.EventIdMode),
 		"event_stop_behavior":         string(in.EventStopBehavior),
-		"filecache_duration":          int(in.FilecacheDuration),
-		"fragment_length":             int(in.FragmentLength),
+		"filecache_duration":          int(aws.ToInt32(in.FilecacheDuration)),
+		"fragment_length":             int(aws.ToInt32(in.FragmentLength)),
 		"input_loss_action":           string(in.InputLossAction),
-		"num_retries":                 int(in.NumRetries),
-		"restart_delay":               int(in.RestartDelay),
+		"num_retries":                 int(aws.ToInt32(in.NumRetries)),
+		"restart_delay":               int(aws.ToInt32(in.RestartDelay)),
 		"segmentation_mode":           string(in.SegmentationMode),
-		"send_delay_ms":               int(in.SendDelayMs),
+		"send_delay_ms":               int(aws.ToInt32(in.SendDelayMs)),
 		"sparse_track_type":           string(in.SparseTrackType),
 		"stream_manifest_behavior":    string(in.StreamManifestBehavior),
 		"timestamp_offset":            aws.ToString(in.TimestampOffset),
@@ -5532,7 +5532,7 @@ func flattenHLSCaptionLanguageMappings(in []types.CaptionLanguageMapping) []inte
 	var out []interface{}
 	for _, item := range in {
 		m := map[string]interface{}{
-			"caption_channel":      int(item.CaptionChannel),
+			"caption_channel":      int(aws.ToInt32(item.CaptionChannel)),
 			"language_code":        aws.ToString(item.LanguageCode),
 			"language_description": aws.ToString(item.LanguageDescription),
 		}
@@ -5565,11 +5565,11 @@ func flattenHLSAkamaiSettings(in *types.HlsAkamaiSettings) []interface{} {
 	}
 
 	m := map[string]interface{}{
-		"connection_retry_interval": int(in.ConnectionRetryInterval),
-		"filecache_duration":        int(in.FilecacheDuration),
+		"connection_retry_interval": int(aws.ToInt32(in.ConnectionRetryInterval)),
+		"filecache_duration":        int(aws.ToInt32(in.FilecacheDuration)),
 		"http_transfer_mode":        string(in.HttpTransferMode),
-		"num_retries":               int(in.NumRetries),
-		"restart_delay":             int(in.RestartDelay),
+		"num_retries":               int(aws.ToInt32(in.NumRetries)),
+		"restart_delay":             int(aws.ToInt32(in.RestartDelay)),
 		"salt":                      aws.ToString(in.Salt),
 		"token":                     aws.ToString(in.Token),
 	}
@@ -5583,10 +5583,10 @@ func flattenHLSBasicPutSettings(in *types.HlsBasicPutSettings) []interface{} {
 	}
 
 	m := map[string]interface{}{
-		"connection_retry_interval": int(in.ConnectionRetryInterval),
-		"filecache_duration":        int(in.FilecacheDuration),
-		"num_retries":               int(in.NumRetries),
-		"restart_delay":             int(in.RestartDelay),
+		"connection_retry_interval": int(aws.ToInt32(in.ConnectionRetryInterval)),
+		"filecache_duration":        int(aws.ToInt32(in.FilecacheDuration)),
+		"num_retries":               int(aws.ToInt32(in.NumRetries)),
+		"restart_delay":             int(aws.ToInt32(in.RestartDelay)),
 	}
 
 	return []interface{}{m}
@@ -5598,11 +5598,11 @@ func flattenHLSMediaStoreSettings(in *types.HlsMediaStoreSettings) []interface{}
 	}
 
 	m := map[string]interface{}{
-		"connection_retry_interval": int(in.ConnectionRetryInterval),
-		"filecache_duration":        int(in.FilecacheDuration),
+		"connection_retry_interval": int(aws.ToInt32(in.ConnectionRetryInterval)),
+		"filecache_duration":        int(aws.ToInt32(in.FilecacheDuration)),
 		"media_store_storage_class": string(in.MediaStoreStorageClass),
-		"num_retries":               int(in.NumRetries),
-		"restart_delay":             int(in.RestartDelay),
+		"num_retries":               int(aws.ToInt32(in.NumRetries)),
+		"restart_delay":             int(aws.ToInt32(in.RestartDelay)),
 	}
 
 	return []interface{}{m}
@@ -5638,11 +5638,11 @@ func flattenHLSWebdavSettings(in *types.HlsWebdavSettings) []interface{} {
 	}
 
 	m := map[string]interface{}{
-		"connection_retry_interval": int(in.ConnectionRetryInterval),
-		"filecache_duration":        int(in.FilecacheDuration),
+		"connection_retry_interval": int(aws.ToInt32(in.ConnectionRetryInterval)),
+		"filecache_duration":        int(aws.ToInt32(in.FilecacheDuration)),
 		"http_transfer_mode":        string(in.HttpTransferMode),
-		"num_retries":               int(in.NumRetries),
-		"restart_delay":             int(in.RestartDelay),
+		"num_retries":               int(aws.ToInt32(in.NumRetries)),
+		"restart_delay":             int(aws.ToInt32(in.RestartDelay)),
 	}
 
 	return []interface{}{m}
@@ -5720,10 +5720,10 @@ func flattenOutputGroupSettingsRtmpGroupSettings(rt *types.RtmpGroupSettings) []
 		"ad_markers":            flattenAdMakers(rt.AdMarkers),
 		"authentication_scheme": string(rt.AuthenticationScheme),
 		"cache_full_behavior":   string(rt.CacheFullBehavior),
-		"cache_length":          int(rt.CacheLength),
+		"cache_length":          int(aws.ToInt32(rt.CacheLength)),
 		"caption_data":          string(rt.CaptionData),
 		"input_loss_action":     string(rt.InputLossAction),
-		"restart_delay":         int(rt.RestartDelay),
+		"restart_delay":         int(aws.ToInt32(rt.RestartDelay)),
 	}
 
 	return []interface{}{m}
@@ -5737,7 +5737,7 @@ func flattenOutputGroupSettingsUdpGroupSettings(in *types.UdpGroupSettings) []in
 	m := map[string]interface{}{
 		"input_loss_action":         string(in.InputLossAction),
 		"timed_metadata_id3_frame":  string(in.TimedMetadataId3Frame),
-		"timed_metadata_id3_period": int(in.TimedMetadataId3Period),
+		"timed_metadata_id3_period": int(aws.ToInt32(in.TimedMetadataId3Period)),
 	}
 
 	return []interface{}{m}
@@ -5797,7 +5797,7 @@ func flattenTimecodeConfig(in *types.TimecodeConfig) []interface{} {
 
 	m := map[string]interface{}{
 		"source":         string(in.Source),
-		"sync_threshold": int(in.SyncThreshold),
+		"sync_threshold": int(aws.ToInt32(in.SyncThreshold)),
 	}
 
 	return []interface{}{m}
@@ -5814,11 +5814,11 @@ func flattenVideoDescriptions(tfList []types.VideoDescription) []interface{} {
 		m := map[string]interface{}{
 			"name":             aws.ToString(item.Name),
 			"codec_settings":   flattenVideoDescriptionsCodecSettings(item.CodecSettings),
-			"height":           int(item.Height),
+			"height":           int(aws.ToInt32(item.Height)),
 			"respond_to_afd":   string(item.RespondToAfd),
 			"scaling_behavior": string(item.ScalingBehavior),
-			"sharpness":        int(item.Sharpness),
-			"width":            int(item.Width),
+			"sharpness":        int(aws.ToInt32(item.Sharpness)),
+			"width":            int(aws.ToInt32(item.Width)),
 		}
 
 		out = append(out, m)
@@ -5859,7 +5859,7 @@ func flattenCodecSettingsFrameCaptureSettings(in *types.FrameCaptureSettings) []
 	}
 
 	m := map[string]interface{}{
-		"capture_interval":       int(in.CaptureInterval),
+		"capture_interval":       int(aws.ToInt32(in.CaptureInterval)),
 		"capture_interval_units": string(in.CaptureIntervalUnits),
 	}
 
@@ -5874,9 +5874,9 @@ func flattenCodecSettingsH264Settings(in *types.H264Settings) []interface{} {
 	m := map[string]interface{}{
 		"adaptive_quantization":   string(in.AdaptiveQuantization),
 		"afd_signaling":           string(in.AfdSignaling),
-		"bitrate":                 int(in.Bitrate),
-		"buf_fill_pct":            int(in.BufFillPct),
-		"buf_size":                int(in.BufSize),
+		"bitrate":                 int(aws.ToInt32(in.Bitrate)),
+		"buf_fill_pct":            int(aws.ToInt32(in.BufFillPct)),
+		"buf_size":                int(aws.ToInt32(in.BufSize)),
 		"color_metadata":          string(in.ColorMetadata),
 		"entropy_encoding":        string(in.EntropyEncoding),
 		"filter_settings":         flattenH264SettingsFilterSettings(in.FilterSettings),
@@ -5884,28 +5884,28 @@ func flattenCodecSettingsH264Settings(in *types.H264Settings) []interface{} {
 		"flicker_aq":              string(in.FlickerAq),
 		"force_field_pictures":    string(in.ForceFieldPictures),
 		"framerate_control":       string(in.FramerateControl),
-		"framerate_denominator":   int(in.FramerateDenominator),
-		"framerate_numerator":     int(in.FramerateNumerator),
+		"framerate_denominator":   int(aws.ToInt32(in.FramerateDenominator)),
+		"framerate_numerator":     int(aws.ToInt32(in.FramerateNumerator)),
 		"gop_b_reference":         string(in.GopBReference),
-		"gop_closed_cadence":      int(in.GopClosedCadence),
-		"gop_num_b_frames":        int(in.GopNumBFrames),
+		"gop_closed_cadence":      int(aws.ToInt32(in.GopClosedCadence)),
+		"gop_num_b_frames":        int(aws.ToInt32(in.GopNumBFrames)),
 		"gop_size":                in.GopSize,
 		"gop_size_units":          string(in.GopSizeUnits),
 		"level":                   string(in.Level),
 		"look_ahead_rate_control": string(in.LookAheadRateControl),
-		"max_bitrate":             int(in.MaxBitrate),
-		"min_i_interval":          int(in.MinIInterval),
-		"num_ref_frames":          int(in.NumRefFrames),
+		"max_bitrate":             int(aws.ToInt32(in.MaxBitrate)),
+		"min_i_interval":          int(aws.ToInt32(in.MinIInterval)),
+		"num_ref_frames":          int(aws.ToInt32(in.NumRefFrames)),
 		"par_control":             string(in.ParControl),
-		"par_denominator":         int(in.ParDenominator),
-		"par_numerator":           int(in.ParNumerator),
+		"par_denominator":         int(aws.ToInt32(in.ParDenominator)),
+		"par_numerator":           int(aws.ToInt32(in.ParNumerator)),
 		"profile":                 string(in.Profile),
 		"quality_level":           string(in.QualityLevel),
-		"qvbr_quality_level":      int(in.QvbrQualityLevel),
+		"qvbr_quality_level":      int(aws.ToInt32(in.QvbrQualityLevel)),
 		"rate_control_mode":       string(in.RateControlMode),
 		"scan_type":               string(in.ScanType),
 		"scene_change_detect":     string(in.SceneChangeDetect),
-		"slices":                  int(in.Slices),
+		"slices":                  int(aws.ToInt32(in.Slices)),
 		"spatial_aq":              string(in.SpatialAq),
 		"subgop_length":           string(in.SubgopLength),
 		"syntax":                  string(in.Syntax),
@@ -5947,33 +5947,33 @@ func flattenCodecSettingsH265Settings(in *types.H265Settings) []interface{} {
 	}
 
 	m := map[string]interface{}{
-		"framerate_denominator":         int(in.FramerateDenominator),
-		"framerate_numerator":           int(in.FramerateNumerator),
+		"framerate_denominator":         int(aws.ToInt32(in.FramerateDenominator)),
+		"framerate_numerator":           int(aws.ToInt32(in.FramerateNumerator)),
 		"adaptive_quantization":         string(in.AdaptiveQuantization),
 		"afd_signaling":                 string(in.AfdSignaling),
 		"alternative_transfer_function": string(in.AlternativeTransferFunction),
-		"bitrate":                       int(in.Bitrate),
-		"buf_size":                      int(in.BufSize),
+		"bitrate":                       int(aws.ToInt32(in.Bitrate)),
+		"buf_size":                      int(aws.ToInt32(in.BufSize)),
 		"color_metadata":                string(in.ColorMetadata),
 		"color_space_settings":          flattenH265ColorSpaceSettings(in.ColorSpaceSettings),
 		"filter_settings":               flattenH265FilterSettings(in.FilterSettings),
 		"fixed_afd":                     string(in.FixedAfd),
 		"flicker_aq":                    string(in.FlickerAq),
-		"gop_closed_cadence":            int(in.GopClosedCadence),
+		"gop_closed_cadence":            int(aws.ToInt32(in.GopClosedCadence)),
 		"gop_size":                      in.GopSize,
 		"gop_size_units":                string(in.GopSizeUnits),
 		"level":                         string(in.Level),
 		"look_ahead_rate_control":       string(in.LookAheadRateControl),
-		"max_bitrate":                   int(in.MaxBitrate),
-		"min_i_interval":                int(in.MinIInterval),
-		"par_denominator":               int(in.ParDenominator),
-		"par_numerator":                 int(in.ParNumerator),
+		"max_bitrate":                   int(aws.ToInt32(in.MaxBitrate)),
+		"min_i_interval":                int(aws.ToInt32(in.MinIInterval)),
+		"par_denominator":               int(aws.ToInt32(in.ParDenominator)),
+		"par_numerator":                 int(aws.ToInt32(in.ParNumerator)),
 		"profile":                       string(in.Profile),
-		"qvbr_quality_level":            int(in.QvbrQualityLevel),
+		"qvbr_quality_level":            int(aws.ToInt32(in.QvbrQualityLevel)),
 		"rate_control_mode":             string(in.RateControlMode),
 		"scan_type":                     string(in.ScanType),
 		"scene_change_detect":           string(in.SceneChangeDetect),
-		"slices":                        int(in.Slices),
+		"slices":                        int(aws.ToInt32(in.Slices)),
 		"tier":                          string(in.Tier),
 		"timecode_burnin_settings":      flattenH265TimecodeBurninSettings(in.TimecodeBurninSettings),
 		"timecode_insertion":            string(in.TimecodeInsertion),
@@ -6012,8 +6012,8 @@ func flattenH265Hdr10Settings(in *types.Hdr10Settings) []interface{} {
 	}
 
 	m := map[string]interface{}{
-		"max_cll":  int(in.MaxCll),
-		"max_fall": int(in.MaxFall),
+		"max_cll":  int(aws.ToInt32(in.MaxCll)),
+		"max_fall": int(aws.ToInt32(in.MaxFall)),
 	}
 
 	return []interface{}{m}
@@ -6146,7 +6146,7 @@ func flattenCodecSettingsAc3Settings(in *types.Ac3Settings) []interface{} {
 		"bitrate":          in.Bitrate,
 		"bitstream_mode":   string(in.BitstreamMode),
 		"coding_mode":      string(in.CodingMode),
-		"dialnorm":         int(in.Dialnorm),
+		"dialnorm":         int(aws.ToInt32(in.Dialnorm)),
 		"drc_profile":      string(in.DrcProfile),
 		"lfe_filter":       string(in.LfeFilter),
 		"metadata_control": string(in.MetadataControl),
@@ -6161,13 +6161,13 @@ func flattenCodecSettingsEac3AtmosSettings(in *types.Eac3AtmosSettings) []interf
 	}
 
 	m := map[string]interface{}{
-		"bitrate":       float32(in.Bitrate),
+		"bitrate":       float32(aws.ToFloat64(in.Bitrate)),
 		"coding_mode":   string(in.CodingMode),
-		"dialnorm":      int(in.Dialnorm),
+		"dialnorm":      int(aws.ToInt32(in.Dialnorm)),
 		"drc_line":      string(in.DrcLine),
 		"drc_rf":        string(in.DrcRf),
-		"height_trim":   float32(in.HeightTrim),
-		"surround_trim": float32(in.SurroundTrim),
+		"height_trim":   float32(aws.ToFloat64(in.HeightTrim)),
+		"surround_trim": float32(aws.ToFloat64(in.SurroundTrim)),
 	}
 
 	return []interface{}{m}
@@ -6180,19 +6180,19 @@ func flattenCodecSettingsEac3Settings(in *types.Eac3Settings) []interface{} {
 
 	m := map[string]interface{}{
 		"attenuation_control":      string(in.AttenuationControl),
-		"bitrate":                  float32(in.Bitrate),
+		"bitrate":                  float32(aws.ToFloat64(in.Bitrate)),
 		"bitstream_mode":           string(in.BitstreamMode),
 		"coding_mode":              string(in.CodingMode),
 		"dc_filter":                string(in.DcFilter),
-		"dialnorm":                 int(in.Dialnorm),
+		"dialnorm":                 int(aws.ToInt32(in.Dialnorm)),
 		"drc_line":                 string(in.DrcLine),
 		"drc_rf":                   string(in.DrcRf),
 		"lfe_control":              string(in.LfeControl),
 		"lfe_filter":               string(in.LfeFilter),
-		"lo_ro_center_mix_level":   float32(in.LoRoCenterMixLevel),
-		"lo_ro_surround_mix_level": float32(in.LoRoSurroundMixLevel),
-		"lt_rt_center_mix_level":   float32(in.LtRtCenterMixLevel),
-		"lt_rt_surround_mix_level": float32(in.LtRtSurroundMixLevel),
+		"lo_ro_center_mix_level":   float32(aws.ToFloat64(in.LoRoCenterMixLevel)),
+		"lo_ro_surround_mix_level": float32(aws.ToFloat64(in.LoRoSurroundMixLevel)),
+		"lt_rt_center_mix_level":   float32(aws.ToFloat64(in.LtRtCenterMixLevel)),
+		"lt_rt_surround_mix_level": float32(aws.ToFloat64(in.LtRtSurroundMixLevel)),
 		"metadata_control":         string(in.MetadataControl),
 		"passthrough_control":      string(in.PassthroughControl),
 		"phase_control":            string(in.PhaseControl),
@@ -6210,9 +6210,9 @@ func flattenCodecSettingsMp2Settings(in *types.Mp2Settings) []interface{} {
 	}
 
 	m := map[string]interface{}{
-		"bitrate":     float32(in.Bitrate),
+		"bitrate":     float32(aws.ToFloat64(in.Bitrate)),
 		"coding_mode": string(in.CodingMode),
-		"sample_rate": float32(in.SampleRate),
+		"sample_rate": float32(aws.ToFloat64(in.SampleRate)),
 	}
 
 	return []interface{}{m}
@@ -6224,9 +6224,9 @@ func flattenCodecSettingsWavSettings(in *types.WavSettings) []interface{} {
 	}
 
 	m := map[string]interface{}{
-		"bit_depth":   float32(in.BitDepth),
+		"bit_depth":   float32(aws.ToFloat64(in.BitDepth)),
 		"coding_mode": string(in.CodingMode),
-		"sample_rate": float32(in.SampleRate),
+		"sample_rate": float32(aws.ToFloat64(in.SampleRate)),
 	}
 
 	return []interface{}{m}
@@ -6239,8 +6239,8 @@ func flattenAudioDescriptionsRemixSettings(in *types.RemixSettings) []interface{
 
 	m := map[string]interface{}{
 		"channel_mappings": flattenChannelMappings(in.ChannelMappings),
-		"channels_in":      int(in.ChannelsIn),
-		"channels_out":     int(in.ChannelsOut),
+		"channels_in":      int(aws.ToInt32(in.ChannelsIn)),
+		"channels_out":     int(aws.ToInt32(in.ChannelsOut)),
 	}
 
 	return []interface{}{m}
@@ -6255,7 +6255,7 @@ func flattenChannelMappings(in []types.AudioChannelMapping) []interface{} {
 	for _, item := range in {
 		m := map[string]interface{}{
 			"input_channel_levels": flattenInputChannelLevels(item.InputChannelLevels),
-			"output_channel":       int(item.OutputChannel),
+			"output_channel":       int(aws.ToInt32(item.OutputChannel)),
 		}
 
 		out = append(out, m)
@@ -6272,8 +6272,8 @@ func flattenInputChannelLevels(in []types.InputChannelLevel) []interface{} {
 	var out []interface{}
 	for _, item := range in {
 		m := map[string]interface{}{
-			"gain":          int(item.Gain),
-			"input_channel": int(item.InputChannel),
+			"gain":          int(aws.ToInt32(item.Gain)),
+			"input_channel": int(aws.ToInt32(item.InputChannel)),
 		}
 
 		out = append(out, m)
@@ -6303,7 +6303,7 @@ func flattenNielsenNaesIiNwSettings(in *types.NielsenNaesIiNw) []interface{} {
 
 	m := map[string]interface{}{
 		"check_digit_string": aws.ToString(in.CheckDigitString),
-		"sid":                float32(in.Sid),
+		"sid":                float32(aws.ToFloat64(in.Sid)),
 	}
 
 	return []interface{}{m}
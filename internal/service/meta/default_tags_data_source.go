@@ -58,7 +58,7 @@ func (d *dataSourceDefaultTags) Read(ctx context.Context, request datasource.Rea
 		return
 	}
 
-	defaultTagsConfig := d.Meta().DefaultTagsConfig
+	defaultTagsConfig := d.Meta().DefaultTagsConfig(ctx)
 	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
 	tags := defaultTagsConfig.GetTags()
 
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @SDKListResource("aws_s3_bucket", name="Bucket")
+func newBucketListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return &bucketListResource{}, nil
+}
+
+type bucketListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *bucketListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3_bucket"
+}
+
+func (l *bucketListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{}
+}
+
+func (l *bucketListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+
+	conn := l.Meta().S3Conn(ctx)
+	region := l.Meta().RegionForContext(ctx)
+
+	output, err := conn.ListBucketsWithContext(ctx, &s3.ListBucketsInput{})
+
+	if err != nil {
+		diags.AddError("listing S3 Buckets", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+
+		return
+	}
+
+	// ListBuckets returns all buckets owned by the caller, regardless of Region.
+	inRegion := bucketRegionFilter(ctx, conn, region)
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for _, bucket := range output.Buckets {
+			name := aws.StringValue(bucket.Name)
+
+			// A bucket whose Region can't be determined, e.g. because it was
+			// deleted after ListBuckets returned, mustn't fail the whole list.
+			ok, err := inRegion(bucket)
+			if err != nil {
+				log.Printf("[WARN] Skipping S3 Bucket (%s): %s", name, err)
+				continue
+			}
+			if !ok {
+				continue
+			}
+
+			result, ok := l.NewListResult(ctx, request, name, name)
+			if !ok {
+				continue
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func bucketRegion(ctx context.Context, conn *s3.S3, bucket string) (string, error) {
+	region, err := s3manager.GetBucketRegionWithClient(ctx, conn, bucket, func(r *request.Request) {
+		// By default, GetBucketRegion forces virtual host addressing, which
+		// is not compatible with many non-AWS implementations. Instead, pass
+		// the provider s3_force_path_style configuration, which defaults to
+		// false, but allows override.
+		r.Config.S3ForcePathStyle = conn.Config.S3ForcePathStyle
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return region, nil
+}
+
+type bucketFilter func(*s3.Bucket) (bool, error)
+
+func filterBuckets(in []*s3.Bucket, f bucketFilter) ([]*s3.Bucket, error) {
+	var errs *multierror.Error
+	var out []*s3.Bucket
+
+	for _, b := range in {
+		if ok, err := f(b); err != nil {
+			errs = multierror.Append(errs, err)
+		} else if ok {
+			out = append(out, b)
+		}
+	}
+
+	return out, errs.ErrorOrNil()
+}
+
+func bucketRegionFilter(ctx context.Context, conn *s3.S3, region string) bucketFilter {
+	return func(bucket *s3.Bucket) (bool, error) {
+		name := aws.StringValue(bucket.Name)
+
+		bucketRegion, err := bucketRegion(ctx, conn, name)
+		if err != nil {
+			return false, err
+		}
+		if bucketRegion != region {
+			log.Printf("[INFO] Skipping S3 Bucket (%s): not in %s", name, region)
+			return false, nil
+		}
+
+		return true, nil
+	}
+}
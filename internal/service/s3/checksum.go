@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+const (
+	// Objects larger than this cannot be uploaded with a single PutObject request.
+	// Reference: https://docs.aws.amazon.com/AmazonS3/latest/userguide/upload-objects.html.
+	objectPutMaxSize = 5 * 1024 * 1024 * 1024
+
+	// The minimum part size used to upload objects with checksums in multiple parts.
+	objectChecksumMinPartSize = 64 * 1024 * 1024
+)
+
+// objectReader is implemented by the content of an S3 object.
+// Ranges of the content can be read independently so that it can be uploaded and checksummed in parts.
+type objectReader interface {
+	io.ReadSeeker
+	io.ReaderAt
+}
+
+// objectChecksumAttribute returns the name of the attribute holding an object's checksum for the specified algorithm.
+func objectChecksumAttribute(algorithm string) string {
+	return "checksum_" + strings.ToLower(algorithm)
+}
+
+// objectChecksumAttributes returns the names of all the attributes holding an object's checksums.
+func objectChecksumAttributes() []string {
+	var attributes []string
+
+	for _, algorithm := range s3.ChecksumAlgorithm_Values() {
+		attributes = append(attributes, objectChecksumAttribute(algorithm))
+	}
+
+	return attributes
+}
+
+// objectChecksum returns the base64-encoded checksum of the content read from r using the specified algorithm,
+// in the form returned by S3. r is rewound afterwards.
+func objectChecksum(r io.ReadSeeker, algorithm string) (string, error) {
+	h, err := newObjectChecksumHash(algorithm)
+
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+func newObjectChecksumHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		return crc32.NewIEEE(), nil
+	case s3.ChecksumAlgorithmCrc32c:
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli)), nil
+	case s3.ChecksumAlgorithmSha1:
+		return sha1.New(), nil
+	case s3.ChecksumAlgorithmSha256:
+		return sha256.New(), nil
+	default:
+		return nil, fmt.Errorf("unsupported checksum algorithm: %s", algorithm)
+	}
+}
+
+// objectChecksumPartSize returns the part size used to upload an object of the specified size with checksums in multiple parts.
+func objectChecksumPartSize(size int64) int64 {
+	return max(objectChecksumMinPartSize, (size+s3manager.MaxUploadParts-1)/s3manager.MaxUploadParts)
+}
+
+// objectPartChecksums returns the base64-encoded checksums of each part of the content read from r,
+// split into parts of the specified size.
+func objectPartChecksums(r io.ReaderAt, size, partSize int64, algorithm string) ([]string, error) {
+	var checksums []string
+
+	for offset := int64(0); offset < size; offset += partSize {
+		checksum, err := objectChecksum(io.NewSectionReader(r, offset, min(partSize, size-offset)), algorithm)
+
+		if err != nil {
+			return nil, err
+		}
+
+		checksums = append(checksums, checksum)
+	}
+
+	return checksums, nil
+}
+
+// compositeObjectChecksum returns the checksum of the checksums of a multipart upload's parts, in the form returned by S3,
+// e.g. "Ce8bJA==-2".
+// Reference: https://docs.aws.amazon.com/AmazonS3/latest/userguide/checking-object-integrity.html#large-object-checksums.
+func compositeObjectChecksum(partChecksums []string, algorithm string) (string, error) {
+	h, err := newObjectChecksumHash(algorithm)
+
+	if err != nil {
+		return "", err
+	}
+
+	for _, v := range partChecksums {
+		b, err := base64.StdEncoding.DecodeString(v)
+
+		if err != nil {
+			return "", err
+		}
+
+		h.Write(b)
+	}
+
+	return fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(h.Sum(nil)), len(partChecksums)), nil
+}
+
+// isCompositeObjectChecksum returns whether the checksum is a checksum of the checksums of a multipart upload's parts,
+// e.g. "Ce8bJA==-2", which cannot be compared to the checksum of the object's content.
+func isCompositeObjectChecksum(checksum string) bool {
+	return strings.Contains(checksum, "-")
+}
+
+// compositeObjectChecksumParts returns the number of parts of a composite checksum.
+func compositeObjectChecksumParts(checksum string) (int, bool) {
+	_, v, ok := strings.Cut(checksum, "-")
+
+	if !ok {
+		return 0, false
+	}
+
+	n, err := strconv.Atoi(v)
+
+	return n, err == nil
+}
+
+// objectContentSize returns the size of the content read from r. r is rewound afterwards.
+func objectContentSize(r io.ReadSeeker) (int64, error) {
+	size, err := r.Seek(0, io.SeekEnd)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// setUploadInputChecksum sets a pre-computed checksum on an upload so that S3 verifies the uploaded content.
+func setUploadInputChecksum(input *s3manager.UploadInput, algorithm, checksum string) {
+	input.ChecksumAlgorithm = aws.String(algorithm)
+
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		input.ChecksumCRC32 = aws.String(checksum)
+	case s3.ChecksumAlgorithmCrc32c:
+		input.ChecksumCRC32C = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha1:
+		input.ChecksumSHA1 = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha256:
+		input.ChecksumSHA256 = aws.String(checksum)
+	}
+}
+
+// setUploadPartInputChecksum sets a pre-computed checksum on a part upload so that S3 verifies the uploaded part.
+func setUploadPartInputChecksum(input *s3.UploadPartInput, algorithm, checksum string) {
+	input.ChecksumAlgorithm = aws.String(algorithm)
+
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		input.ChecksumCRC32 = aws.String(checksum)
+	case s3.ChecksumAlgorithmCrc32c:
+		input.ChecksumCRC32C = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha1:
+		input.ChecksumSHA1 = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha256:
+		input.ChecksumSHA256 = aws.String(checksum)
+	}
+}
+
+// setCompletedPartChecksum sets an uploaded part's checksum, which must be specified when completing a multipart upload with checksums.
+func setCompletedPartChecksum(part *s3.CompletedPart, algorithm, checksum string) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		part.ChecksumCRC32 = aws.String(checksum)
+	case s3.ChecksumAlgorithmCrc32c:
+		part.ChecksumCRC32C = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha1:
+		part.ChecksumSHA1 = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha256:
+		part.ChecksumSHA256 = aws.String(checksum)
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+func TestObjectChecksum(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName    string
+		Content     string
+		Algorithm   string
+		ExpectError bool
+		Expected    string
+	}{
+		{
+			TestName:  "CRC32",
+			Content:   "hello world",
+			Algorithm: s3.ChecksumAlgorithmCrc32,
+			Expected:  "DUoRhQ==",
+		},
+		{
+			TestName:  "CRC32C",
+			Content:   "hello world",
+			Algorithm: s3.ChecksumAlgorithmCrc32c,
+			Expected:  "yZRlqg==",
+		},
+		{
+			TestName:  "SHA1",
+			Content:   "hello world",
+			Algorithm: s3.ChecksumAlgorithmSha1,
+			Expected:  "Kq5sNclPz7QV2+lfQIuc6R7oRu0=",
+		},
+		{
+			TestName:  "SHA256",
+			Content:   "hello world",
+			Algorithm: s3.ChecksumAlgorithmSha256,
+			Expected:  "uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek=",
+		},
+		{
+			TestName:  "empty content",
+			Algorithm: s3.ChecksumAlgorithmCrc32,
+			Expected:  "AAAAAA==",
+		},
+		{
+			TestName:    "unsupported algorithm",
+			Content:     "hello world",
+			Algorithm:   "MD5",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			r := strings.NewReader(testCase.Content)
+			got, err := objectChecksum(r, testCase.Algorithm)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+
+			// The content must be available for upload after the checksum is computed.
+			if !testCase.ExpectError {
+				b, err := io.ReadAll(r)
+
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if got, want := string(b), testCase.Content; got != want {
+					t.Errorf("got content %q, expected %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestIsCompositeObjectChecksum(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Checksum string
+		Expected bool
+	}{
+		{Checksum: "uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek=", Expected: false},
+		{Checksum: "Ce8bJA==-2", Expected: true},
+		{Checksum: "", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		if got := isCompositeObjectChecksum(testCase.Checksum); got != testCase.Expected {
+			t.Errorf("isCompositeObjectChecksum(%q) = %t, expected %t", testCase.Checksum, got, testCase.Expected)
+		}
+	}
+}
+
+func TestCompositeObjectChecksum(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName              string
+		Algorithm             string
+		ExpectedPartChecksums []string
+		Expected              string
+	}{
+		{
+			TestName:              "CRC32",
+			Algorithm:             s3.ChecksumAlgorithmCrc32,
+			ExpectedPartChecksums: []string{"NhCmhg==", "6436Jg==", "mN1KzA=="},
+			Expected:              "NyyG8Q==-3",
+		},
+		{
+			TestName:  "SHA256",
+			Algorithm: s3.ChecksumAlgorithmSha256,
+			ExpectedPartChecksums: []string{
+				"LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ=",
+				"Q8+JdyDMWmk7JQjJ6V6HEZQqlu2Y13L+NxI9MWA84g8=",
+				"GKw+c0PwFokMUQ6T+TUmEWnZ4/VlQ2Qpgw+vCTT0+OQ=",
+			},
+			Expected: "pzSGO5U+k/TnIIwV9oZNNyf1DQbT37kZpa4hVAUTJ/A=-3",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			content := "hello world"
+			partChecksums, err := objectPartChecksums(strings.NewReader(content), int64(len(content)), 5, testCase.Algorithm)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := strings.Join(partChecksums, ","), strings.Join(testCase.ExpectedPartChecksums, ","); got != want {
+				t.Errorf("got part checksums %s, expected %s", got, want)
+			}
+
+			got, err := compositeObjectChecksum(partChecksums, testCase.Algorithm)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+
+			if n, ok := compositeObjectChecksumParts(got); !ok || n != len(partChecksums) {
+				t.Errorf("compositeObjectChecksumParts(%q) = %d, %t, expected %d", got, n, ok, len(partChecksums))
+			}
+		})
+	}
+}
+
+func TestObjectChecksumPartSize(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Size     int64
+		Expected int64
+	}{
+		{Size: objectPutMaxSize + 1, Expected: objectChecksumMinPartSize},
+		{Size: 5 * 1024 * 1024 * 1024 * 1024, Expected: 549755814},
+	}
+
+	for _, testCase := range testCases {
+		got := objectChecksumPartSize(testCase.Size)
+
+		if got != testCase.Expected {
+			t.Errorf("objectChecksumPartSize(%d) = %d, expected %d", testCase.Size, got, testCase.Expected)
+		}
+
+		if parts := (testCase.Size + got - 1) / got; parts > s3manager.MaxUploadParts {
+			t.Errorf("objectChecksumPartSize(%d) = %d, %d parts exceeds maximum", testCase.Size, got, parts)
+		}
+	}
+}
@@ -6,3 +6,8 @@ package s3
 const (
 	filterRulesSliceStartLen = 2
 )
+
+const (
+	// Reference: https://docs.aws.amazon.com/AmazonS3/latest/userguide/bucket-policies.html.
+	bucketPolicyMaxSize = 20480
+)
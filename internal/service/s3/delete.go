@@ -6,6 +6,7 @@ package s3
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -14,11 +15,21 @@ import (
 	multierror "github.com/hashicorp/go-multierror"
 )
 
+const (
+	// The maximum number of keys in a DeleteObjects request.
+	deleteObjectsMaxKeys = 1000
+)
+
 // EmptyBucket empties the specified S3 bucket by deleting all object versions and delete markers.
 // If `force` is `true` then S3 Object Lock governance mode restrictions are bypassed and
 // an attempt is made to remove any S3 Object Lock legal holds.
+// Directory buckets don't support versioning so their objects are listed and deleted without versions.
 // Returns the number of objects deleted.
 func EmptyBucket(ctx context.Context, conn *s3.S3, bucket string, force bool) (int64, error) {
+	if isDirectoryBucket(bucket) {
+		return forEachObjectsPage(ctx, conn, bucket, deletePageOfObjects)
+	}
+
 	nObjects, err := forEachObjectVersionsPage(ctx, conn, bucket, func(ctx context.Context, conn *s3.S3, bucket string, page *s3.ListObjectVersionsOutput) (int64, error) {
 		return deletePageOfObjectVersions(ctx, conn, bucket, force, page)
 	})
@@ -70,6 +81,43 @@ func forEachObjectVersionsPage(ctx context.Context, conn *s3.S3, bucket string,
 	return nObjects, nil
 }
 
+// forEachObjectsPage calls the specified function for each page returned from the S3 ListObjectsV2Pages API.
+func forEachObjectsPage(ctx context.Context, conn *s3.S3, bucket string, fn func(ctx context.Context, conn *s3.S3, bucket string, page *s3.ListObjectsV2Output) (int64, error)) (int64, error) {
+	var nObjects int64
+
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	var lastErr error
+
+	err := conn.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		n, err := fn(ctx, conn, bucket, page)
+		nObjects += n
+
+		if err != nil {
+			lastErr = err
+
+			return false
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nObjects, fmt.Errorf("listing S3 Bucket (%s) objects: %w", bucket, err)
+	}
+
+	if lastErr != nil {
+		return nObjects, lastErr
+	}
+
+	return nObjects, nil
+}
+
 // deletePageOfObjectVersions deletes a page (<= 1000) of S3 object versions.
 // If `force` is `true` then S3 Object Lock governance mode restrictions are bypassed and
 // an attempt is made to remove any S3 Object Lock legal holds.
@@ -216,6 +264,96 @@ func deletePageOfDeleteMarkers(ctx context.Context, conn *s3.S3, bucket string,
 	return nObjects, nil
 }
 
+// deletePageOfObjects deletes a page (<= 1000) of S3 objects.
+// Returns the number of objects deleted.
+func deletePageOfObjects(ctx context.Context, conn *s3.S3, bucket string, page *s3.ListObjectsV2Output) (int64, error) {
+	keys := make([]string, 0, len(page.Contents))
+	for _, v := range page.Contents {
+		keys = append(keys, aws.StringValue(v.Key))
+	}
+
+	deleted, err := deleteBatchOfObjects(ctx, conn, bucket, keys)
+
+	return int64(len(deleted)), err
+}
+
+// deleteObjects deletes the specified S3 objects, in batches of the maximum number of keys per DeleteObjects request.
+// Objects which don't exist are ignored.
+// Returns the keys of the objects deleted, which on error may be a subset of the specified keys.
+func deleteObjects(ctx context.Context, conn *s3.S3, bucket string, keys []string) ([]string, error) {
+	var deleted []string
+
+	for batch := range slices.Chunk(keys, deleteObjectsMaxKeys) {
+		v, err := deleteBatchOfObjects(ctx, conn, bucket, batch)
+		deleted = append(deleted, v...)
+
+		if err != nil {
+			return deleted, err
+		}
+	}
+
+	return deleted, nil
+}
+
+// deleteBatchOfObjects deletes a batch (<= 1000) of S3 objects.
+// Objects which don't exist are treated as deleted.
+// Returns the keys of the objects deleted.
+func deleteBatchOfObjects(ctx context.Context, conn *s3.S3, bucket string, keys []string) ([]string, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	toDelete := make([]*s3.ObjectIdentifier, 0, len(keys))
+	for _, v := range keys {
+		toDelete = append(toDelete, &s3.ObjectIdentifier{
+			Key: aws.String(v),
+		})
+	}
+
+	input := &s3.DeleteObjectsInput{
+		Bucket: aws.String(bucket),
+		Delete: &s3.Delete{
+			Objects: toDelete,
+			Quiet:   aws.Bool(true), // Only report errors.
+		},
+	}
+
+	output, err := conn.DeleteObjectsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return keys, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("deleting S3 Bucket (%s) objects: %w", bucket, err)
+	}
+
+	failed := make(map[string]struct{}, len(output.Errors))
+	var deleteErrs *multierror.Error
+
+	for _, v := range output.Errors {
+		if aws.StringValue(v.Code) == s3.ErrCodeNoSuchKey {
+			continue
+		}
+
+		failed[aws.StringValue(v.Key)] = struct{}{}
+		deleteErrs = multierror.Append(deleteErrs, newDeleteObjectVersionError(v))
+	}
+
+	deleted := make([]string, 0, len(keys)-len(failed))
+	for _, v := range keys {
+		if _, ok := failed[v]; !ok {
+			deleted = append(deleted, v)
+		}
+	}
+
+	if err := deleteErrs.ErrorOrNil(); err != nil {
+		return deleted, fmt.Errorf("deleting S3 Bucket (%s) objects: %w", bucket, err)
+	}
+
+	return deleted, nil
+}
+
 func newObjectVersionError(key, versionID string, err error) error {
 	if err == nil {
 		return nil
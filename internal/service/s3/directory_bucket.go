@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkResource(name="Directory Bucket")
+func newResourceDirectoryBucket(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceDirectoryBucket{}
+
+	return r, nil
+}
+
+type resourceDirectoryBucket struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceDirectoryBucket) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3_directory_bucket"
+}
+
+func (r *resourceDirectoryBucket) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": framework.ARNAttributeComputedOnly(),
+			"bucket": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(directoryBucketNameRegexp, `must be in the format [bucket_name]--[azid]--x-s3`),
+				},
+			},
+			"data_redundancy": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(s3.DataRedundancySingleAvailabilityZone),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(s3.DataRedundancy_Values()...),
+				},
+			},
+			"force_destroy": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"id": framework.IDAttribute(),
+			"type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(s3.BucketTypeDirectory),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(s3.BucketType_Values()...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"location": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"type": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString(s3.LocationTypeAvailabilityZone),
+							Validators: []validator.String{
+								stringvalidator.OneOf(s3.LocationType_Values()...),
+							},
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceDirectoryBucket) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceDirectoryBucketData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := directoryBucketRegionalConn(ctx, r.Meta())
+
+	bucket := data.Bucket.ValueString()
+	input := &s3.CreateBucketInput{
+		Bucket: aws.String(bucket),
+		CreateBucketConfiguration: &s3.CreateBucketConfiguration{
+			Bucket: &s3.BucketInfo{
+				DataRedundancy: flex.StringFromFramework(ctx, data.DataRedundancy),
+				Type:           flex.StringFromFramework(ctx, data.Type),
+			},
+			Location: flex.ExpandFrameworkListNestedBlockPtr(ctx, data.Location, r.expandLocationInfo),
+		},
+	}
+
+	_, err := conn.CreateBucketWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Directory Bucket (%s)", bucket), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = types.StringValue(directoryBucketARN(ctx, r.Meta(), bucket))
+	data.ID = types.StringValue(bucket)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDirectoryBucket) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceDirectoryBucketData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := directoryBucketRegionalConn(ctx, r.Meta())
+
+	bucket := data.ID.ValueString()
+	_, err := findDirectoryBucket(ctx, conn, bucket)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Bucket (%s)", bucket), err.Error())
+
+		return
+	}
+
+	// ListDirectoryBuckets only returns the bucket's name, the remaining attributes are derived from it.
+	azID, err := directoryBucketAvailabilityZoneID(bucket)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Bucket (%s)", bucket), err.Error())
+
+		return
+	}
+
+	data.ARN = types.StringValue(directoryBucketARN(ctx, r.Meta(), bucket))
+	data.Bucket = types.StringValue(bucket)
+	data.Location = flex.FlattenFrameworkListNestedBlock[directoryBucketLocationData](ctx, []*s3.LocationInfo{{
+		Name: aws.String(azID),
+		Type: aws.String(s3.LocationTypeAvailabilityZone),
+	}}, r.flattenLocationInfo)
+
+	// Set defaults for import.
+	if data.DataRedundancy.IsNull() {
+		data.DataRedundancy = types.StringValue(s3.DataRedundancySingleAvailabilityZone)
+	}
+	if data.ForceDestroy.IsNull() {
+		data.ForceDestroy = types.BoolValue(false)
+	}
+	if data.Type.IsNull() {
+		data.Type = types.StringValue(s3.BucketTypeDirectory)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDirectoryBucket) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new resourceDirectoryBucketData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Only force_destroy can be updated in-place.
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceDirectoryBucket) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceDirectoryBucketData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := directoryBucketRegionalConn(ctx, r.Meta())
+
+	bucket := data.ID.ValueString()
+
+	tflog.Debug(ctx, "deleting S3 Directory Bucket", map[string]interface{}{
+		"id": bucket,
+	})
+
+	_, err := conn.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{
+		Bucket: aws.String(bucket),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeBucketNotEmpty) && data.ForceDestroy.ValueBool() {
+		zonalConn, err := directoryBucketZonalConn(ctx, r.Meta(), bucket)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("emptying S3 Directory Bucket (%s)", bucket), err.Error())
+
+			return
+		}
+
+		// Directory buckets don't support S3 Object Lock.
+		if n, err := EmptyBucket(ctx, zonalConn, bucket, false); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("emptying S3 Directory Bucket (%s)", bucket), err.Error())
+
+			return
+		} else {
+			tflog.Debug(ctx, "deleted S3 objects", map[string]interface{}{
+				"bucket":   bucket,
+				"nObjects": n,
+			})
+		}
+
+		_, err = conn.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{
+			Bucket: aws.String(bucket),
+		})
+
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Directory Bucket (%s)", bucket), err.Error())
+		}
+
+		return
+	}
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Directory Bucket (%s)", bucket), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceDirectoryBucket) expandLocationInfo(ctx context.Context, data directoryBucketLocationData) *s3.LocationInfo {
+	return &s3.LocationInfo{
+		Name: flex.StringFromFramework(ctx, data.Name),
+		Type: flex.StringFromFramework(ctx, data.Type),
+	}
+}
+
+func (r *resourceDirectoryBucket) flattenLocationInfo(ctx context.Context, apiObject *s3.LocationInfo) directoryBucketLocationData {
+	return directoryBucketLocationData{
+		Name: flex.StringToFramework(ctx, apiObject.Name),
+		Type: flex.StringToFramework(ctx, apiObject.Type),
+	}
+}
+
+type resourceDirectoryBucketData struct {
+	ARN            types.String `tfsdk:"arn"`
+	Bucket         types.String `tfsdk:"bucket"`
+	DataRedundancy types.String `tfsdk:"data_redundancy"`
+	ForceDestroy   types.Bool   `tfsdk:"force_destroy"`
+	ID             types.String `tfsdk:"id"`
+	Location       types.List   `tfsdk:"location"`
+	Type           types.String `tfsdk:"type"`
+}
+
+type directoryBucketLocationData struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+func findDirectoryBucket(ctx context.Context, conn *s3.S3, bucket string) (*s3.Bucket, error) {
+	input := &s3.ListDirectoryBucketsInput{}
+	var output *s3.Bucket
+
+	err := conn.ListDirectoryBucketsPagesWithContext(ctx, input, func(page *s3.ListDirectoryBucketsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Buckets {
+			if v != nil && aws.StringValue(v.Name) == bucket {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
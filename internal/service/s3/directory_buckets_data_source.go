@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+// @FrameworkDataSource(name="Directory Buckets")
+func newDataSourceDirectoryBuckets(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &dataSourceDirectoryBuckets{}
+
+	return d, nil
+}
+
+type dataSourceDirectoryBuckets struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceDirectoryBuckets) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_s3_directory_buckets"
+}
+
+func (d *dataSourceDirectoryBuckets) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arns": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"buckets": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"id": framework.IDAttribute(),
+		},
+	}
+}
+
+func (d *dataSourceDirectoryBuckets) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceDirectoryBucketsData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := directoryBucketRegionalConn(ctx, d.Meta())
+
+	input := &s3.ListDirectoryBucketsInput{}
+	var buckets []string
+
+	err := conn.ListDirectoryBucketsPagesWithContext(ctx, input, func(page *s3.ListDirectoryBucketsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Buckets {
+			buckets = append(buckets, aws.StringValue(v.Name))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError("listing S3 Directory Buckets", err.Error())
+
+		return
+	}
+
+	arns := make([]string, 0, len(buckets))
+
+	for _, v := range buckets {
+		arns = append(arns, directoryBucketARN(ctx, d.Meta(), v))
+	}
+
+	data.ARNs = flex.FlattenFrameworkStringValueListLegacy(ctx, arns)
+	data.Buckets = flex.FlattenFrameworkStringValueListLegacy(ctx, buckets)
+	data.ID = types.StringValue(d.Meta().RegionForContext(ctx))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceDirectoryBucketsData struct {
+	ARNs    types.List   `tfsdk:"arns"`
+	Buckets types.List   `tfsdk:"buckets"`
+	ID      types.String `tfsdk:"id"`
+}
@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+// Exports for use in tests only.
+var (
+	ResourceDirectoryBucket = newResourceDirectoryBucket
+
+	ConnForBucket               = connForBucket
+	DirectoryBucketRegionalConn = directoryBucketRegionalConn
+	FindDirectoryBucket         = findDirectoryBucket
+	FindObjectKeysByPrefix      = findObjectKeysByPrefix
+)
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Directory buckets (S3 Express One Zone) are served from Regional and Zonal endpoints
+// rather than the S3 service endpoint, and the AWS SDK for Go v1 does not resolve them.
+// Reference: https://docs.aws.amazon.com/AmazonS3/latest/userguide/s3-express-Regions-and-Zones.html.
+
+const (
+	directoryBucketNameSuffix = "--x-s3"
+
+	s3ExpressSigningName        = "s3express"
+	s3ExpressSessionTokenHeader = "X-Amz-S3session-Token"
+)
+
+var directoryBucketNameRegexp = regexp.MustCompile(`^[0-9a-z.-]+--([0-9a-z]+(?:-[0-9a-z]+)+)--x-s3$`)
+
+// isDirectoryBucket returns whether the specified bucket name is that of a directory bucket.
+func isDirectoryBucket(bucket string) bool {
+	return strings.HasSuffix(bucket, directoryBucketNameSuffix)
+}
+
+// directoryBucketAvailabilityZoneID returns the Availability Zone ID encoded in a directory bucket's name,
+// e.g. "usw2-az1" for "example--usw2-az1--x-s3".
+func directoryBucketAvailabilityZoneID(bucket string) (string, error) {
+	m := directoryBucketNameRegexp.FindStringSubmatch(bucket)
+
+	if m == nil {
+		return "", fmt.Errorf("invalid directory bucket name (%s), expected <base-name>--<az-id>--x-s3", bucket)
+	}
+
+	return m[1], nil
+}
+
+// directoryBucketARN returns the ARN of the specified directory bucket.
+func directoryBucketARN(ctx context.Context, client *conns.AWSClient, bucket string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   s3ExpressSigningName,
+		Region:    client.RegionForContext(ctx),
+		AccountID: client.AccountID,
+		Resource:  "bucket/" + bucket,
+	}.String()
+}
+
+// directoryBucketRegionalConn returns an S3 API client for the S3 Express Regional endpoint,
+// which serves bucket-level operations such as CreateBucket, DeleteBucket and ListDirectoryBuckets.
+func directoryBucketRegionalConn(ctx context.Context, client *conns.AWSClient) *s3.S3 {
+	endpoint := fmt.Sprintf("https://s3express-control.%s.%s", client.RegionForContext(ctx), client.DNSSuffix)
+
+	// The Regional endpoint only supports path-style requests.
+	return newS3ExpressConn(ctx, client, endpoint, true)
+}
+
+// directoryBucketZonalConn returns an S3 API client for the S3 Express Zonal endpoint serving the specified directory bucket,
+// which serves object-level operations. Requests are authorized with credentials obtained from CreateSession.
+func directoryBucketZonalConn(ctx context.Context, client *conns.AWSClient, bucket string) (*s3.S3, error) {
+	azID, err := directoryBucketAvailabilityZoneID(bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("https://s3express-%s.%s.%s", azID, client.RegionForContext(ctx), client.DNSSuffix)
+
+	// CreateSession itself is signed with the provider's credentials.
+	sessionCreds := credentials.NewCredentials(&s3ExpressSessionProvider{
+		bucket: bucket,
+		conn:   newS3ExpressConn(ctx, client, endpoint, false),
+	})
+
+	conn := newS3ExpressConn(ctx, client, endpoint, false)
+	conn.Handlers.Sign.PushFrontNamed(s3ExpressSessionAuthHandler(sessionCreds))
+
+	return conn, nil
+}
+
+// connForBucket returns the S3 API client to use for object-level operations in the specified bucket.
+func connForBucket(ctx context.Context, meta interface{}, bucket string) (*s3.S3, error) {
+	client := meta.(*conns.AWSClient)
+
+	if isDirectoryBucket(bucket) {
+		return directoryBucketZonalConn(ctx, client, bucket)
+	}
+
+	return client.S3Conn(ctx), nil
+}
+
+func newS3ExpressConn(ctx context.Context, client *conns.AWSClient, endpoint string, pathStyle bool) *s3.S3 {
+	config := client.S3Conn(ctx).Config
+	config.Endpoint = aws.String(endpoint)
+	config.S3ForcePathStyle = aws.Bool(pathStyle)
+	// Directory buckets don't support Content-MD5 validation of object uploads.
+	config.S3DisableContentMD5Validation = aws.Bool(true)
+
+	conn := s3.New(client.Session.Copy(&config))
+	conn.SigningName = s3ExpressSigningName
+
+	return conn
+}
+
+// s3ExpressSessionAuthHandler returns a request handler that signs Zonal endpoint requests with the S3 Express session's keys.
+// The session token is sent in its own header rather than as a security token.
+func s3ExpressSessionAuthHandler(sessionCreds *credentials.Credentials) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "tfs3.S3ExpressSessionAuthHandler",
+		Fn: func(r *request.Request) {
+			v, err := sessionCreds.GetWithContext(r.Context())
+
+			if err != nil {
+				r.Error = err
+				return
+			}
+
+			r.HTTPRequest.Header.Set(s3ExpressSessionTokenHeader, v.SessionToken)
+			r.Config.Credentials = credentials.NewStaticCredentials(v.AccessKeyID, v.SecretAccessKey, "")
+		},
+	}
+}
+
+// s3ExpressSessionProvider provides S3 Express session credentials for a directory bucket, refreshing them before they expire.
+type s3ExpressSessionProvider struct {
+	credentials.Expiry
+
+	bucket string
+	conn   *s3.S3
+}
+
+func (p *s3ExpressSessionProvider) Retrieve() (credentials.Value, error) {
+	return p.RetrieveWithContext(aws.BackgroundContext())
+}
+
+func (p *s3ExpressSessionProvider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	output, err := p.conn.CreateSessionWithContext(ctx, &s3.CreateSessionInput{
+		Bucket: aws.String(p.bucket),
+	})
+
+	if err != nil {
+		return credentials.Value{}, fmt.Errorf("creating S3 Express session (%s): %w", p.bucket, err)
+	}
+
+	if output == nil || output.Credentials == nil {
+		return credentials.Value{}, fmt.Errorf("creating S3 Express session (%s): empty result", p.bucket)
+	}
+
+	creds := output.Credentials
+	p.SetExpiration(aws.TimeValue(creds.Expiration), 1*time.Minute)
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(creds.AccessKeyId),
+		SecretAccessKey: aws.StringValue(creds.SecretAccessKey),
+		SessionToken:    aws.StringValue(creds.SessionToken),
+		ProviderName:    "S3ExpressSessionProvider",
+	}, nil
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"testing"
+)
+
+func TestDirectoryBucketAvailabilityZoneID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName    string
+		Bucket      string
+		ExpectError bool
+		Expected    string
+	}{
+		{
+			TestName: "simple",
+			Bucket:   "example--usw2-az1--x-s3",
+			Expected: "usw2-az1",
+		},
+		{
+			TestName: "Local Zone",
+			Bucket:   "example--usw2-lax1-az1--x-s3",
+			Expected: "usw2-lax1-az1",
+		},
+		{
+			TestName: "base name with double hyphen",
+			Bucket:   "tf-acc--test--use1-az4--x-s3",
+			Expected: "use1-az4",
+		},
+		{
+			TestName:    "general purpose bucket",
+			Bucket:      "example-bucket",
+			ExpectError: true,
+		},
+		{
+			TestName:    "no Availability Zone ID",
+			Bucket:      "example--x-s3",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := directoryBucketAvailabilityZoneID(testCase.Bucket)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -17,17 +17,18 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kms"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -77,6 +78,27 @@ func ResourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"checksum_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumAlgorithm_Values(), false),
+			},
+			"checksum_crc32": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_crc32c": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -200,12 +222,17 @@ func resourceObjectCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).S3Conn(ctx)
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
+	conn, err := connForBucket(ctx, meta, bucket)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Object (%s): %s", d.Id(), err)
+	}
+
 	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, objectCreationTimeout, func() (interface{}, error) {
-		return FindObjectByThreePartKey(ctx, conn, bucket, key, "")
+		return findObjectByBucketAndKey(ctx, conn, bucket, key, "", d.Get("checksum_algorithm").(string))
 	}, d.IsNewResource())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -222,6 +249,10 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.Set("bucket_key_enabled", output.BucketKeyEnabled)
 	d.Set("cache_control", output.CacheControl)
+	d.Set("checksum_crc32", output.ChecksumCRC32)
+	d.Set("checksum_crc32c", output.ChecksumCRC32C)
+	d.Set("checksum_sha1", output.ChecksumSHA1)
+	d.Set("checksum_sha256", output.ChecksumSHA256)
 	d.Set("content_disposition", output.ContentDisposition)
 	d.Set("content_encoding", output.ContentEncoding)
 	d.Set("content_language", output.ContentLanguage)
@@ -259,6 +290,11 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 		d.Set("storage_class", output.StorageClass)
 	}
 
+	// Objects in directory buckets don't support tagging.
+	if isDirectoryBucket(bucket) {
+		return diags
+	}
+
 	// Retry due to S3 eventual consistency
 	tagsRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
 		return ObjectListTags(ctx, conn, bucket, key)
@@ -285,10 +321,13 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		return append(diags, resourceObjectUpload(ctx, d, meta)...)
 	}
 
-	conn := meta.(*conns.AWSClient).S3Conn(ctx)
-
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
+	conn, err := connForBucket(ctx, meta, bucket)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Object (%s): %s", d.Id(), err)
+	}
 
 	if d.HasChange("acl") {
 		_, err := conn.PutObjectAclWithContext(ctx, &s3.PutObjectAclInput{
@@ -340,7 +379,7 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
-	if d.HasChange("tags_all") {
+	if d.HasChange("tags_all") && !isDirectoryBucket(bucket) {
 		o, n := d.GetChange("tags_all")
 
 		if err := ObjectUpdateTags(ctx, conn, bucket, key, o, n); err != nil {
@@ -353,17 +392,22 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 func resourceObjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).S3Conn(ctx)
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
+	conn, err := connForBucket(ctx, meta, bucket)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+	}
+
 	// We are effectively ignoring all leading '/'s in the key name and
 	// treating multiple '/'s as a single '/' as aws.Config.DisableRestProtocolURICleaning is false
 	key = strings.TrimLeft(key, "/")
 	key = regexp.MustCompile(`/+`).ReplaceAllString(key, "/")
 
-	var err error
-	if _, ok := d.GetOk("version_id"); ok {
+	// Directory buckets don't support versioning.
+	if _, ok := d.GetOk("version_id"); ok && !isDirectoryBucket(bucket) {
 		_, err = DeleteAllObjectVersions(ctx, conn, bucket, key, d.Get("force_destroy").(bool), false)
 	} else {
 		err = deleteObjectVersion(ctx, conn, bucket, key, "", false)
@@ -397,49 +441,26 @@ func resourceObjectImport(ctx context.Context, d *schema.ResourceData, meta inte
 
 func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).S3Conn(ctx)
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	conn, err := connForBucket(ctx, meta, bucket)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "uploading object to S3 bucket (%s): %s", bucket, err)
+	}
+
 	uploader := s3manager.NewUploaderWithClient(conn)
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig(ctx)
 	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
-	var body io.ReadSeeker
+	body, closeBody, err := objectBody(d)
 
-	if v, ok := d.GetOk("source"); ok {
-		source := v.(string)
-		path, err := homedir.Expand(source)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "expanding homedir in source (%s): %s", source, err)
-		}
-		file, err := os.Open(path)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "opening S3 object source (%s): %s", path, err)
-		}
-
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
-			}
-		}()
-	} else if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		content := v.(string)
-		// We can't do streaming decoding here (with base64.NewDecoder) because
-		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
-		contentRaw, err := base64.StdEncoding.DecodeString(content)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "decoding content_base64: %s", err)
-		}
-		body = bytes.NewReader(contentRaw)
-	} else {
-		body = bytes.NewReader([]byte{})
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	bucket := d.Get("bucket").(string)
-	key := d.Get("key").(string)
+	defer closeBody()
 
 	input := &s3manager.UploadInput{
 		Body:   body,
@@ -459,6 +480,45 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.CacheControl = aws.String(v.(string))
 	}
 
+	var checksumAlgorithm, checksum string
+	var size, partSize int64
+	var partChecksums []string
+
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		checksumAlgorithm = v.(string)
+
+		size, err = objectContentSize(body)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 object content: %s", err)
+		}
+
+		if size > objectPutMaxSize {
+			// S3 verifies the pre-computed checksum of each part
+			// and the stored checksum is a composite of the parts' checksums.
+			partSize = objectChecksumPartSize(size)
+			partChecksums, err = objectPartChecksums(body, size, partSize, checksumAlgorithm)
+
+			if err == nil {
+				checksum, err = compositeObjectChecksum(partChecksums, checksumAlgorithm)
+			}
+		} else {
+			// The content is uploaded in a single part so that S3 verifies the pre-computed checksum
+			// and the stored checksum is that of the whole object.
+			uploader.PartSize = max(size+1, s3manager.MinUploadPartSize)
+
+			checksum, err = objectChecksum(body, checksumAlgorithm)
+
+			if err == nil {
+				setUploadInputChecksum(input, checksumAlgorithm, checksum)
+			}
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "computing S3 object %s checksum: %s", checksumAlgorithm, err)
+		}
+	}
+
 	if v, ok := d.GetOk("content_type"); ok {
 		input.ContentType = aws.String(v.(string))
 	}
@@ -492,7 +552,12 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
 	}
 
-	if len(tags) > 0 {
+	if isDirectoryBucket(bucket) {
+		// Objects in directory buckets don't support tagging, so any default tags are not applied.
+		if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+			return sdkdiag.AppendErrorf(diags, "tags are not supported for objects in directory bucket (%s)", bucket)
+		}
+	} else if len(tags) > 0 {
 		// The tag-set must be encoded as URL Query parameters.
 		input.Tagging = aws.String(tags.IgnoreAWS().URLEncode())
 	}
@@ -513,13 +578,148 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ObjectLockRetainUntilDate = expandObjectDate(v.(string))
 	}
 
-	if _, err := uploader.Upload(input); err != nil {
+	if len(partChecksums) > 0 {
+		err = uploadObjectParts(ctx, conn, input, body, size, partSize, checksumAlgorithm, partChecksums)
+	} else {
+		_, err = uploader.Upload(input)
+	}
+
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading object to S3 bucket (%s): %s", bucket, err)
 	}
 
 	d.SetId(key)
 
-	return append(diags, resourceObjectRead(ctx, d, meta)...)
+	diags = append(diags, resourceObjectRead(ctx, d, meta)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if checksum != "" {
+		if v := d.Get(objectChecksumAttribute(checksumAlgorithm)).(string); v != checksum {
+			return sdkdiag.AppendErrorf(diags, "verifying S3 Bucket (%s) Object (%s) upload: %s checksum (%s) does not match expected checksum (%s)", bucket, key, checksumAlgorithm, v, checksum)
+		}
+	}
+
+	return diags
+}
+
+// uploadObjectParts uploads an object in multiple parts with pre-computed checksums, which S3 verifies for each part.
+// The s3manager Uploader does not send checksums for multipart uploads.
+func uploadObjectParts(ctx context.Context, conn *s3.S3, input *s3manager.UploadInput, body io.ReaderAt, size, partSize int64, algorithm string, partChecksums []string) error {
+	createInput := &s3.CreateMultipartUploadInput{}
+	awsutil.Copy(createInput, input)
+	createInput.ChecksumAlgorithm = aws.String(algorithm)
+
+	output, err := conn.CreateMultipartUploadWithContext(ctx, createInput)
+
+	if err != nil {
+		return fmt.Errorf("creating multipart upload: %w", err)
+	}
+
+	uploadID := output.UploadId
+	abort := func() {
+		abortInput := &s3.AbortMultipartUploadInput{
+			Bucket:   input.Bucket,
+			Key:      input.Key,
+			UploadId: uploadID,
+		}
+
+		if _, err := conn.AbortMultipartUploadWithContext(ctx, abortInput); err != nil {
+			log.Printf("[WARN] Error aborting S3 Bucket (%s) Object (%s) multipart upload (%s): %s", aws.StringValue(input.Bucket), aws.StringValue(input.Key), aws.StringValue(uploadID), err)
+		}
+	}
+
+	parts := make([]*s3.CompletedPart, 0, len(partChecksums))
+
+	for i, checksum := range partChecksums {
+		offset := int64(i) * partSize
+		partInput := &s3.UploadPartInput{
+			Body:       io.NewSectionReader(body, offset, min(partSize, size-offset)),
+			Bucket:     input.Bucket,
+			Key:        input.Key,
+			PartNumber: aws.Int64(int64(i + 1)),
+			UploadId:   uploadID,
+		}
+		setUploadPartInputChecksum(partInput, algorithm, checksum)
+
+		partOutput, err := conn.UploadPartWithContext(ctx, partInput)
+
+		if err != nil {
+			abort()
+			return fmt.Errorf("uploading part %d of %d: %w", i+1, len(partChecksums), err)
+		}
+
+		part := &s3.CompletedPart{
+			ETag:       partOutput.ETag,
+			PartNumber: partInput.PartNumber,
+		}
+		setCompletedPartChecksum(part, algorithm, checksum)
+
+		parts = append(parts, part)
+	}
+
+	completeInput := &s3.CompleteMultipartUploadInput{
+		Bucket: input.Bucket,
+		Key:    input.Key,
+		MultipartUpload: &s3.CompletedMultipartUpload{
+			Parts: parts,
+		},
+		UploadId: uploadID,
+	}
+
+	if _, err := conn.CompleteMultipartUploadWithContext(ctx, completeInput); err != nil {
+		abort()
+		return fmt.Errorf("completing multipart upload: %w", err)
+	}
+
+	return nil
+}
+
+// resourceGetter is implemented by both schema.ResourceData and schema.ResourceDiff.
+type resourceGetter interface {
+	GetOk(string) (interface{}, bool)
+}
+
+// objectBody returns the configured content of an S3 object and a function which releases any underlying file.
+func objectBody(d resourceGetter) (objectReader, func(), error) {
+	if v, ok := d.GetOk("source"); ok {
+		source := v.(string)
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return nil, nil, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("opening S3 object source (%s): %w", path, err)
+		}
+
+		return file, func() {
+			err := file.Close()
+			if err != nil {
+				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
+			}
+		}, nil
+	}
+
+	if v, ok := d.GetOk("content"); ok {
+		content := v.(string)
+		return bytes.NewReader([]byte(content)), func() {}, nil
+	}
+
+	if v, ok := d.GetOk("content_base64"); ok {
+		content := v.(string)
+		// We can't do streaming decoding here (with base64.NewDecoder) because
+		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
+		contentRaw, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			return nil, nil, fmt.Errorf("decoding content_base64: %w", err)
+		}
+		return bytes.NewReader(contentRaw), func() {}, nil
+	}
+
+	return bytes.NewReader([]byte{}), func() {}, nil
 }
 
 func resourceObjectSetKMS(ctx context.Context, d *schema.ResourceData, meta interface{}, sseKMSKeyId *string) error {
@@ -555,6 +755,12 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	if hasObjectContentChanges(d) {
+		for _, key := range objectChecksumAttributes() {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+
 		return d.SetNewComputed("version_id")
 	}
 
@@ -563,13 +769,103 @@ func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta
 		d.SetNewComputed("etag")
 	}
 
+	drift, err := hasObjectChecksumDrift(d)
+
+	if err != nil {
+		return err
+	}
+
+	if drift {
+		// The object's content has changed locally or remotely: re-upload it.
+		for _, key := range objectChecksumAttributes() {
+			d.SetNewComputed(key)
+		}
+		d.SetNewComputed("version_id")
+		d.SetNewComputed("etag")
+	}
+
 	return nil
 }
 
+// hasObjectChecksumDrift returns whether the checksum of the configured content differs from the object's stored checksum.
+// ETags can't be used to detect drift for objects encrypted with SSE-KMS or uploaded in multiple parts.
+func hasObjectChecksumDrift(d *schema.ResourceDiff) (bool, error) {
+	if d.Id() == "" {
+		return false, nil
+	}
+
+	v, ok := d.GetOk("checksum_algorithm")
+
+	if !ok {
+		return false, nil
+	}
+
+	for _, key := range []string{"content", "content_base64", "source"} {
+		if !d.NewValueKnown(key) {
+			return false, nil
+		}
+	}
+
+	algorithm := v.(string)
+	old := d.Get(objectChecksumAttribute(algorithm)).(string)
+
+	if old == "" {
+		return false, nil
+	}
+
+	body, closeBody, err := objectBody(d)
+
+	if err != nil {
+		return false, err
+	}
+
+	defer closeBody()
+
+	var checksum string
+
+	if isCompositeObjectChecksum(old) {
+		size, err := objectContentSize(body)
+
+		if err != nil {
+			return false, fmt.Errorf("reading S3 object content: %w", err)
+		}
+
+		partChecksums, err := objectPartChecksums(body, size, objectChecksumPartSize(size), algorithm)
+
+		if err != nil {
+			return false, fmt.Errorf("computing S3 object %s checksum: %w", algorithm, err)
+		}
+
+		// Only objects uploaded with the same number of parts, e.g. by this resource, can be compared.
+		if n, ok := compositeObjectChecksumParts(old); !ok || n != len(partChecksums) {
+			return false, nil
+		}
+
+		checksum, err = compositeObjectChecksum(partChecksums, algorithm)
+
+		if err != nil {
+			return false, fmt.Errorf("computing S3 object %s checksum: %w", algorithm, err)
+		}
+	} else {
+		checksum, err = objectChecksum(body, algorithm)
+
+		if err != nil {
+			return false, fmt.Errorf("computing S3 object %s checksum: %w", algorithm, err)
+		}
+	}
+
+	return checksum != old, nil
+}
+
 func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
 		"cache_control",
+		"checksum_algorithm",
+		"checksum_crc32",
+		"checksum_crc32c",
+		"checksum_sha1",
+		"checksum_sha256",
 		"content_base64",
 		"content_disposition",
 		"content_encoding",
@@ -790,10 +1086,19 @@ func flattenObjectDate(t *time.Time) string {
 }
 
 func FindObjectByThreePartKey(ctx context.Context, conn *s3.S3, bucket, key, etag string) (*s3.HeadObjectOutput, error) {
+	return findObjectByBucketAndKey(ctx, conn, bucket, key, etag, "")
+}
+
+// findObjectByBucketAndKey returns an object's metadata.
+// If checksumAlgorithm is not empty the object's additional checksums are also returned.
+func findObjectByBucketAndKey(ctx context.Context, conn *s3.S3, bucket, key, etag, checksumAlgorithm string) (*s3.HeadObjectOutput, error) {
 	input := &s3.HeadObjectInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 	}
+	if checksumAlgorithm != "" {
+		input.ChecksumMode = aws.String(s3.ChecksumModeEnabled)
+	}
 	if etag != "" {
 		input.IfMatch = aws.String(etag)
 	}
@@ -56,6 +56,33 @@ func TestAccS3ObjectDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectDataSource_checksumMode(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	dataSourceName := "data.aws_s3_object.test"
+	resourceName := "aws_s3_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDataSourceConfig_checksumMode(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "checksum_crc32", resourceName, "checksum_crc32"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "checksum_crc32c", resourceName, "checksum_crc32c"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "checksum_sha1", resourceName, "checksum_sha1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "checksum_sha256", resourceName, "checksum_sha256"),
+					resource.TestCheckResourceAttr(dataSourceName, "checksum_mode", "ENABLED"),
+					resource.TestCheckResourceAttr(dataSourceName, "checksum_sha1", "Ck1VqNd45QIvq3AZd8XYQLvEhtA="),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3ObjectDataSource_basicViaAccessPoint(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dsObj, rObj s3.GetObjectOutput
@@ -490,6 +517,27 @@ data "aws_s3_object" "obj" {
 `, randInt)
 }
 
+func testAccObjectDataSourceConfig_checksumMode(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = %[1]q
+  content            = "Hello World"
+  checksum_algorithm = "SHA1"
+}
+
+data "aws_s3_object" "test" {
+  bucket        = aws_s3_bucket.test.bucket
+  key           = aws_s3_object.test.key
+  checksum_mode = "ENABLED"
+}
+`, rName)
+}
+
 func testAccObjectDataSourceConfig_basicViaAccessPoint(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
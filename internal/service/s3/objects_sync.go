@@ -0,0 +1,469 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	// The maximum number of objects uploaded concurrently by aws_s3_objects_sync.
+	objectsSyncUploadConcurrency = 10
+)
+
+var objectsSyncExtensionRegexp = regexp.MustCompile(`^\.[^./]+$`)
+
+// @SDKResource("aws_s3_objects_sync", name="Objects Sync")
+func ResourceObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceObjectsSyncCreate,
+		ReadWithoutTimeout:   resourceObjectsSyncRead,
+		UpdateWithoutTimeout: resourceObjectsSyncUpdate,
+		DeleteWithoutTimeout: resourceObjectsSyncDelete,
+
+		CustomizeDiff: resourceObjectsSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateSyncGlobPattern,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"content_types": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validation.MapKeyMatch(objectsSyncExtensionRegexp, "must be a file extension including the leading dot, e.g. \".md\""),
+			},
+			"delete_removed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateSyncGlobPattern,
+				},
+			},
+			"include": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateSyncGlobPattern,
+				},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"objects": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"source_dir": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceObjectsSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId(objectsSyncCreateResourceID(d.Get("bucket").(string), d.Get("key_prefix").(string)))
+
+	diags = append(diags, resourceObjectsSyncUpdate(ctx, d, meta)...)
+
+	return diags
+}
+
+func resourceObjectsSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket := d.Get("bucket").(string)
+	conn, err := connForBucket(ctx, meta, bucket)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	keys, err := findObjectKeysByPrefix(ctx, conn, bucket, d.Get("key_prefix").(string))
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing S3 Objects Sync (%s) from state", bucket, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	// Objects deleted outside of Terraform are dropped so that they are uploaded again.
+	objects := make(map[string]interface{})
+
+	for k, v := range d.Get("objects").(map[string]interface{}) {
+		if _, ok := keys[k]; ok {
+			objects[k] = v
+		}
+	}
+
+	d.Set("objects", objects)
+
+	return diags
+}
+
+func resourceObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket := d.Get("bucket").(string)
+	conn, err := connForBucket(ctx, meta, bucket)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "synchronizing S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	dir, err := homedir.Expand(d.Get("source_dir").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "expanding homedir in source_dir (%s): %s", d.Get("source_dir").(string), err)
+	}
+
+	files, err := syncFiles(dir, expandSyncOptions(d))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "synchronizing S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	// Objects uploaded by an earlier apply are recorded with their hash.
+	o, _ := d.GetChange("objects")
+	old := flex.ExpandStringValueMap(o.(map[string]interface{}))
+	objects := make(map[string]string, len(files))
+
+	var toUpload []*syncFile
+
+	for key, file := range files {
+		if old[key] == file.Hash {
+			objects[key] = file.Hash
+		} else {
+			toUpload = append(toUpload, file)
+		}
+	}
+
+	log.Printf("[DEBUG] Uploading %d S3 objects to S3 Bucket (%s)", len(toUpload), bucket)
+	uploadErrs := uploadSyncFiles(ctx, conn, bucket, toUpload)
+
+	for _, file := range toUpload {
+		if _, ok := uploadErrs[file.Key]; !ok {
+			objects[file.Key] = file.Hash
+		} else if v, ok := old[file.Key]; ok {
+			objects[file.Key] = v
+		}
+	}
+
+	var toDelete []string
+
+	for key := range old {
+		if _, ok := files[key]; !ok {
+			toDelete = append(toDelete, key)
+		}
+	}
+
+	var deleteErr error
+
+	// Objects whose files have been removed are no longer managed unless they are deleted.
+	if d.Get("delete_removed").(bool) && len(toDelete) > 0 {
+		sort.Strings(toDelete)
+
+		log.Printf("[DEBUG] Deleting %d S3 objects from S3 Bucket (%s)", len(toDelete), bucket)
+		var deleted []string
+		if deleted, deleteErr = deleteObjects(ctx, conn, bucket, toDelete); deleteErr != nil {
+			// Objects that could not be deleted remain managed.
+			for _, key := range toDelete {
+				objects[key] = old[key]
+			}
+			for _, key := range deleted {
+				delete(objects, key)
+			}
+		}
+	}
+
+	if err := d.Set("objects", objects); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting objects: %s", err)
+	}
+
+	for _, err := range uploadErrs {
+		diags = sdkdiag.AppendErrorf(diags, "synchronizing S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	if deleteErr != nil {
+		diags = sdkdiag.AppendErrorf(diags, "synchronizing S3 Objects Sync (%s): %s", d.Id(), deleteErr)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceObjectsSyncRead(ctx, d, meta)...)
+}
+
+func resourceObjectsSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket := d.Get("bucket").(string)
+	conn, err := connForBucket(ctx, meta, bucket)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	var keys []string
+
+	for key := range d.Get("objects").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	log.Printf("[DEBUG] Deleting S3 Objects Sync (%s): %d objects", d.Id(), len(keys))
+	if deleted, err := deleteObjects(ctx, conn, bucket, keys); err != nil {
+		// Objects that could not be deleted remain managed.
+		objects := d.Get("objects").(map[string]interface{})
+		for _, key := range deleted {
+			delete(objects, key)
+		}
+
+		if err := d.Set("objects", objects); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "setting objects: %s", err)
+		}
+
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Objects Sync (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceObjectsSyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"cache_control", "content_types", "exclude", "include", "key_prefix", "source_dir"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("objects")
+		}
+	}
+
+	dir, err := homedir.Expand(d.Get("source_dir").(string))
+
+	if err != nil {
+		return fmt.Errorf("expanding homedir in source_dir (%s): %w", d.Get("source_dir").(string), err)
+	}
+
+	// The source directory may not exist until apply, e.g. when it is populated by a build step.
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return d.SetNewComputed("objects")
+	}
+
+	files, err := syncFiles(dir, expandSyncOptions(d))
+
+	if err != nil {
+		return err
+	}
+
+	objects := make(map[string]string, len(files))
+
+	for key, file := range files {
+		objects[key] = file.Hash
+	}
+
+	if old := flex.ExpandStringValueMap(d.Get("objects").(map[string]interface{})); d.Id() != "" && maps.Equal(old, objects) {
+		return nil
+	}
+
+	return d.SetNew("objects", objects)
+}
+
+func expandSyncOptions(d resourceGetter) syncOptions {
+	opts := syncOptions{
+		ContentTypes: make(map[string]string),
+	}
+
+	if v, ok := d.GetOk("cache_control"); ok {
+		for _, tfMapRaw := range v.([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			opts.CacheControlRules = append(opts.CacheControlRules, syncCacheControlRule{
+				Pattern: tfMap["pattern"].(string),
+				Value:   tfMap["value"].(string),
+			})
+		}
+	}
+
+	if v, ok := d.GetOk("content_types"); ok {
+		for k, v := range flex.ExpandStringValueMap(v.(map[string]interface{})) {
+			opts.ContentTypes[strings.ToLower(k)] = v
+		}
+	}
+
+	if v, ok := d.GetOk("exclude"); ok {
+		opts.Exclude = flex.ExpandStringValueList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("include"); ok {
+		opts.Include = flex.ExpandStringValueList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("key_prefix"); ok {
+		opts.KeyPrefix = v.(string)
+	}
+
+	return opts
+}
+
+// uploadSyncFiles uploads the specified files concurrently.
+// Returns the errors for the files that could not be uploaded, keyed by S3 object key.
+func uploadSyncFiles(ctx context.Context, conn *s3.S3, bucket string, files []*syncFile) map[string]error {
+	uploader := s3manager.NewUploaderWithClient(conn)
+	errs := make(map[string]error)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, objectsSyncUploadConcurrency)
+
+	for _, file := range files {
+		file := file
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := uploadSyncFile(ctx, uploader, bucket, file); err != nil {
+				mu.Lock()
+				errs[file.Key] = err
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errs
+}
+
+func uploadSyncFile(ctx context.Context, uploader *s3manager.Uploader, bucket string, file *syncFile) error {
+	f, err := os.Open(file.Path)
+
+	if err != nil {
+		return fmt.Errorf("opening S3 object source (%s): %w", file.Path, err)
+	}
+
+	defer f.Close()
+
+	input := &s3manager.UploadInput{
+		Body:        f,
+		Bucket:      aws.String(bucket),
+		ContentType: aws.String(file.ContentType),
+		Key:         aws.String(file.Key),
+	}
+
+	if file.CacheControl != "" {
+		input.CacheControl = aws.String(file.CacheControl)
+	}
+
+	if _, err := uploader.UploadWithContext(ctx, input); err != nil {
+		return fmt.Errorf("uploading S3 object (%s) to S3 Bucket (%s): %w", file.Key, bucket, err)
+	}
+
+	return nil
+}
+
+// findObjectKeysByPrefix returns the keys of all objects in the bucket whose keys begin with the prefix.
+func findObjectKeysByPrefix(ctx context.Context, conn *s3.S3, bucket, prefix string) (map[string]struct{}, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	keys := make(map[string]struct{})
+
+	err := conn.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Contents {
+			keys[aws.StringValue(v.Key)] = struct{}{}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func objectsSyncCreateResourceID(bucket, keyPrefix string) string {
+	if keyPrefix == "" {
+		return bucket
+	}
+
+	return bucket + "/" + keyPrefix
+}
@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccS3ObjectsSync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_objects_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := testAccObjectsSyncCreateDir(t, map[string]string{
+		"index.html":      "<html></html>",
+		"assets/site.css": "body {}",
+		"drafts/wip.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectsSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsSyncConfig_basic(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delete_removed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.site/index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.site/assets/site.css"),
+					testAccCheckObjectsSyncObject(ctx, resourceName, "site/index.html", "text/html; charset=utf-8", "max-age=0"),
+					testAccCheckObjectsSyncObject(ctx, resourceName, "site/assets/site.css", "text/css; charset=utf-8", "max-age=31536000"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectsSync_deleteRemoved(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_objects_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := testAccObjectsSyncCreateDir(t, map[string]string{
+		"index.html": "<html></html>",
+		"about.html": "<html></html>",
+	})
+
+	var hash string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectsSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsSyncConfig_deleteRemoved(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "2"),
+					testAccCheckObjectsSyncObject(ctx, resourceName, "about.html", "text/html; charset=utf-8", ""),
+					func(s *terraform.State) error {
+						hash = s.RootModule().Resources[resourceName].Primary.Attributes["objects.index.html"]
+
+						return nil
+					},
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(dir, "about.html")); err != nil {
+						t.Fatal(err)
+					}
+
+					if err := os.WriteFile(filepath.Join(dir, "index.html"), []byte("<html><body></body></html>"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectsSyncConfig_deleteRemoved(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "1"),
+					testAccCheckObjectsSyncObjectNotExists(ctx, resourceName, "about.html"),
+					func(s *terraform.State) error {
+						if v := s.RootModule().Resources[resourceName].Primary.Attributes["objects.index.html"]; v == hash {
+							return fmt.Errorf("expected index.html hash to change")
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccObjectsSyncCreateDir(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func testAccCheckObjectsSyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_objects_sync" {
+				continue
+			}
+
+			conn, err := tfs3.ConnForBucket(ctx, acctest.Provider.Meta(), rs.Primary.Attributes["bucket"])
+
+			if err != nil {
+				return err
+			}
+
+			keys, err := tfs3.FindObjectKeysByPrefix(ctx, conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key_prefix"])
+
+			if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(keys) > 0 {
+				return fmt.Errorf("S3 Objects Sync %s objects still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectsSyncObject(ctx context.Context, n, key, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := tfs3.ConnForBucket(ctx, acctest.Provider.Meta(), rs.Primary.Attributes["bucket"])
+
+		if err != nil {
+			return err
+		}
+
+		output, err := tfs3.FindObjectByThreePartKey(ctx, conn, rs.Primary.Attributes["bucket"], key, "")
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Object (%s) Content-Type: got %s, expected %s", key, got, contentType)
+		}
+
+		if got := aws.StringValue(output.CacheControl); got != cacheControl {
+			return fmt.Errorf("S3 Object (%s) Cache-Control: got %s, expected %s", key, got, cacheControl)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectsSyncObjectNotExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := tfs3.ConnForBucket(ctx, acctest.Provider.Meta(), rs.Primary.Attributes["bucket"])
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfs3.FindObjectByThreePartKey(ctx, conn, rs.Primary.Attributes["bucket"], key, "")
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object (%s) still exists", key)
+	}
+}
+
+func testAccObjectsSyncConfig_basic(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_objects_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source_dir = %[2]q
+  key_prefix = "site/"
+  exclude    = ["drafts/**"]
+
+  cache_control {
+    pattern = "**/*.html"
+    value   = "max-age=0"
+  }
+
+  cache_control {
+    pattern = "assets/**"
+    value   = "max-age=31536000"
+  }
+}
+`, rName, dir)
+}
+
+func testAccObjectsSyncConfig_deleteRemoved(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_objects_sync" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  source_dir     = %[2]q
+  delete_removed = true
+}
+`, rName, dir)
+}
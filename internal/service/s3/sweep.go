@@ -15,9 +15,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/s3"
-	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -26,12 +24,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_s3_object", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_object", &resource.Sweeper{
 		Name: "aws_s3_object",
 		F:    sweepObjects,
 	})
 
-	resource.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
 		Name: "aws_s3_bucket",
 		F:    sweepBuckets,
 		Dependencies: []string{
@@ -173,21 +171,6 @@ func sweepBuckets(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func bucketRegion(ctx context.Context, conn *s3.S3, bucket string) (string, error) {
-	region, err := s3manager.GetBucketRegionWithClient(ctx, conn, bucket, func(r *request.Request) {
-		// By default, GetBucketRegion forces virtual host addressing, which
-		// is not compatible with many non-AWS implementations. Instead, pass
-		// the provider s3_force_path_style configuration, which defaults to
-		// false, but allows override.
-		r.Config.S3ForcePathStyle = conn.Config.S3ForcePathStyle
-	})
-	if err != nil {
-		return "", err
-	}
-
-	return region, nil
-}
-
 func objectLockEnabled(ctx context.Context, conn *s3.S3, bucket string) (bool, error) {
 	output, err := FindObjectLockConfiguration(ctx, conn, bucket, "")
 
@@ -202,23 +185,6 @@ func objectLockEnabled(ctx context.Context, conn *s3.S3, bucket string) (bool, e
 	return aws.StringValue(output.ObjectLockEnabled) == s3.ObjectLockEnabledEnabled, nil
 }
 
-type bucketFilter func(*s3.Bucket) (bool, error)
-
-func filterBuckets(in []*s3.Bucket, f bucketFilter) ([]*s3.Bucket, error) {
-	var errs *multierror.Error
-	var out []*s3.Bucket
-
-	for _, b := range in {
-		if ok, err := f(b); err != nil {
-			errs = multierror.Append(errs, err)
-		} else if ok {
-			out = append(out, b)
-		}
-	}
-
-	return out, errs.ErrorOrNil()
-}
-
 func bucketNameFilter(bucket *s3.Bucket) (bool, error) {
 	name := aws.StringValue(bucket.Name)
 
@@ -245,20 +211,3 @@ func bucketNameFilter(bucket *s3.Bucket) (bool, error) {
 var (
 	defaultNameRegexp = regexp.MustCompile(fmt.Sprintf(`^%s\d+$`, id.UniqueIdPrefix))
 )
-
-func bucketRegionFilter(ctx context.Context, conn *s3.S3, region string) bucketFilter {
-	return func(bucket *s3.Bucket) (bool, error) {
-		name := aws.StringValue(bucket.Name)
-
-		bucketRegion, err := bucketRegion(ctx, conn, name)
-		if err != nil {
-			return false, err
-		}
-		if bucketRegion != region {
-			log.Printf("[INFO] Skipping S3 Bucket (%s): not in %s", name, region)
-			return false, nil
-		}
-
-		return true, nil
-	}
-}
@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// syncFile is a local file to be synchronized to an S3 object.
+type syncFile struct {
+	CacheControl string
+	ContentType  string
+	Hash         string
+	Key          string
+	Path         string
+}
+
+// syncCacheControlRule sets the Cache-Control of the objects whose relative path matches Pattern.
+type syncCacheControlRule struct {
+	Pattern string
+	Value   string
+}
+
+// syncOptions configures how a local directory is mapped to S3 objects.
+type syncOptions struct {
+	CacheControlRules []syncCacheControlRule
+	ContentTypes      map[string]string // File extension, e.g. ".md", to Content-Type.
+	Exclude           []string
+	Include           []string
+	KeyPrefix         string
+}
+
+// syncFiles walks the specified directory and returns the files to be synchronized, keyed by S3 object key.
+// Each file's hash covers its content and the object metadata derived from the options,
+// so that a change to either causes the object to be uploaded again.
+func syncFiles(dir string, opts syncOptions) (map[string]*syncFile, error) {
+	files := make(map[string]*syncFile)
+
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		if !syncPathIncluded(rel, opts.Include, opts.Exclude) {
+			return nil
+		}
+
+		file, err := newSyncFile(p, rel, opts)
+
+		if err != nil {
+			return err
+		}
+
+		files[file.Key] = file
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading directory (%s): %w", dir, err)
+	}
+
+	return files, nil
+}
+
+func newSyncFile(p, rel string, opts syncOptions) (*syncFile, error) {
+	f, err := os.Open(p)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	contentType, err := syncContentType(f, rel, opts.ContentTypes)
+
+	if err != nil {
+		return nil, fmt.Errorf("detecting content type (%s): %w", p, err)
+	}
+
+	file := &syncFile{
+		CacheControl: syncCacheControl(rel, opts.CacheControlRules),
+		ContentType:  contentType,
+		Key:          opts.KeyPrefix + rel,
+		Path:         p,
+	}
+
+	h := sha256.New()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, fmt.Errorf("hashing (%s): %w", p, err)
+	}
+
+	// Separate the metadata from the content so that the hashes of different files can't collide.
+	fmt.Fprintf(h, "\x00%s\x00%s", file.ContentType, file.CacheControl)
+	file.Hash = hex.EncodeToString(h.Sum(nil))
+
+	return file, nil
+}
+
+// syncContentType returns the Content-Type of a file, from the configured extensions first, then from the
+// standard MIME types and lastly by sniffing the file's content. r is rewound afterwards.
+func syncContentType(r io.ReadSeeker, rel string, contentTypes map[string]string) (string, error) {
+	ext := strings.ToLower(path.Ext(rel))
+
+	if v, ok := contentTypes[ext]; ok {
+		return v, nil
+	}
+
+	if v := mime.TypeByExtension(ext); v != "" {
+		return v, nil
+	}
+
+	// DetectContentType considers at most the first 512 bytes.
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(r, buf)
+
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return http.DetectContentType(buf[:n]), nil
+}
+
+// syncCacheControl returns the Cache-Control of the first rule whose pattern matches the relative path, if any.
+func syncCacheControl(rel string, rules []syncCacheControlRule) string {
+	for _, rule := range rules {
+		if syncGlobMatch(rule.Pattern, rel) {
+			return rule.Value
+		}
+	}
+
+	return ""
+}
+
+// syncPathIncluded returns whether the relative path matches any of the include patterns (all paths if none)
+// and none of the exclude patterns.
+func syncPathIncluded(rel string, include, exclude []string) bool {
+	for _, pattern := range exclude {
+		if syncGlobMatch(pattern, rel) {
+			return false
+		}
+	}
+
+	if len(include) == 0 {
+		return true
+	}
+
+	for _, pattern := range include {
+		if syncGlobMatch(pattern, rel) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// syncGlobMatch reports whether the slash-separated path matches the pattern.
+// Patterns use path.Match syntax for each path element, and the element "**" matches zero or more elements,
+// e.g. "**/*.html" matches "index.html" and "docs/guide/index.html".
+// Malformed patterns match nothing.
+func syncGlobMatch(pattern, name string) bool {
+	return syncGlobMatchElements(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func syncGlobMatchElements(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if syncGlobMatchElements(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+
+		if ok, err := path.Match(pattern[0], name[0]); err != nil || !ok {
+			return false
+		}
+
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
+
+// validateSyncGlobPattern validates that a string is a well-formed glob pattern.
+func validateSyncGlobPattern(v interface{}, k string) (ws []string, errors []error) {
+	for _, element := range strings.Split(v.(string), "/") {
+		if _, err := path.Match(element, ""); err != nil {
+			errors = append(errors, fmt.Errorf("%q (%s) is not a valid glob pattern: %w", k, v.(string), err))
+
+			return
+		}
+	}
+
+	return
+}
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSyncGlobMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Pattern  string
+		Name     string
+		Expected bool
+	}{
+		{Pattern: "*.html", Name: "index.html", Expected: true},
+		{Pattern: "*.html", Name: "docs/index.html", Expected: false},
+		{Pattern: "**/*.html", Name: "index.html", Expected: true},
+		{Pattern: "**/*.html", Name: "docs/guide/index.html", Expected: true},
+		{Pattern: "**/*.html", Name: "docs/guide/index.htm", Expected: false},
+		{Pattern: "assets/**", Name: "assets/css/site.css", Expected: true},
+		{Pattern: "assets/**", Name: "images/logo.png", Expected: false},
+		{Pattern: "**/.git/**", Name: ".git/HEAD", Expected: true},
+		{Pattern: "**/.git/**", Name: "vendor/lib/.git/config", Expected: true},
+		{Pattern: "docs/*/index.html", Name: "docs/guide/index.html", Expected: true},
+		{Pattern: "docs/*/index.html", Name: "docs/index.html", Expected: false},
+		{Pattern: "**", Name: "a/b/c", Expected: true},
+		{Pattern: "[", Name: "[", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		if got := syncGlobMatch(testCase.Pattern, testCase.Name); got != testCase.Expected {
+			t.Errorf("syncGlobMatch(%q, %q) = %t, expected %t", testCase.Pattern, testCase.Name, got, testCase.Expected)
+		}
+	}
+}
+
+func TestSyncPathIncluded(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Name     string
+		Include  []string
+		Exclude  []string
+		Expected bool
+	}{
+		{
+			TestName: "no patterns",
+			Name:     "index.html",
+			Expected: true,
+		},
+		{
+			TestName: "included",
+			Name:     "docs/index.html",
+			Include:  []string{"**/*.html"},
+			Expected: true,
+		},
+		{
+			TestName: "not included",
+			Name:     "docs/index.md",
+			Include:  []string{"**/*.html"},
+			Expected: false,
+		},
+		{
+			TestName: "excluded",
+			Name:     "drafts/index.html",
+			Include:  []string{"**/*.html"},
+			Exclude:  []string{"drafts/**"},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got := syncPathIncluded(testCase.Name, testCase.Include, testCase.Exclude); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestSyncContentType(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName     string
+		Name         string
+		Content      string
+		ContentTypes map[string]string
+		Expected     string
+	}{
+		{
+			TestName: "standard extension",
+			Name:     "site.css",
+			Content:  "body {}",
+			Expected: "text/css; charset=utf-8",
+		},
+		{
+			TestName:     "configured extension",
+			Name:         "README.md",
+			Content:      "# Title",
+			ContentTypes: map[string]string{".md": "text/markdown"},
+			Expected:     "text/markdown",
+		},
+		{
+			TestName:     "configured extension overrides standard",
+			Name:         "index.html",
+			Content:      "<html></html>",
+			ContentTypes: map[string]string{".html": "text/html"},
+			Expected:     "text/html",
+		},
+		{
+			TestName: "sniffed",
+			Name:     "LICENSE",
+			Content:  "Mozilla Public License Version 2.0",
+			Expected: "text/plain; charset=utf-8",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			r := strings.NewReader(testCase.Content)
+			got, err := syncContentType(r, testCase.Name, testCase.ContentTypes)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+
+			if r.Len() != len(testCase.Content) {
+				t.Errorf("content not rewound")
+			}
+		})
+	}
+}
+
+func TestSyncFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	for name, content := range map[string]string{
+		"index.html":         "<html></html>",
+		"assets/site.css":    "body {}",
+		"assets/app.js":      "console.log()",
+		"drafts/wip.html":    "<html></html>",
+		"docs/guide/a.html":  "<html></html>",
+		"docs/guide/notes.x": "notes",
+	} {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	opts := syncOptions{
+		CacheControlRules: []syncCacheControlRule{
+			{Pattern: "**/*.html", Value: "max-age=0"},
+			{Pattern: "assets/**", Value: "max-age=31536000"},
+		},
+		Exclude:   []string{"drafts/**", "**/*.x"},
+		KeyPrefix: "site/",
+	}
+
+	files, err := syncFiles(dir, opts)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var keys []string
+
+	for k := range files {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	if diff := cmp.Diff(keys, []string{"site/assets/app.js", "site/assets/site.css", "site/docs/guide/a.html", "site/index.html"}); diff != "" {
+		t.Errorf("unexpected keys (+wanted, -got): %s", diff)
+	}
+
+	if got, want := files["site/index.html"].CacheControl, "max-age=0"; got != want {
+		t.Errorf("got Cache-Control %s, expected %s", got, want)
+	}
+
+	if got, want := files["site/assets/site.css"].CacheControl, "max-age=31536000"; got != want {
+		t.Errorf("got Cache-Control %s, expected %s", got, want)
+	}
+
+	// Identical content with identical metadata hashes identically.
+	if files["site/index.html"].Hash != files["site/docs/guide/a.html"].Hash {
+		t.Errorf("expected identical hashes")
+	}
+
+	// A change to the object metadata changes the hash.
+	opts.CacheControlRules = nil
+
+	updated, err := syncFiles(dir, opts)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if files["site/index.html"].Hash == updated["site/index.html"].Hash {
+		t.Errorf("expected hash to change with Cache-Control")
+	}
+}
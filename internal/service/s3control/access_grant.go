@@ -0,0 +1,333 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3control_access_grant", name="Access Grant")
+// @Tags(identifierAttribute="access_grant_arn")
+func resourceAccessGrant() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessGrantCreate,
+		ReadWithoutTimeout:   resourceAccessGrantRead,
+		UpdateWithoutTimeout: resourceAccessGrantUpdate,
+		DeleteWithoutTimeout: resourceAccessGrantDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_grant_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_grant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_grants_location_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_sub_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"access_grants_location_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"grant_scope": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"grantee": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grantee_identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"grantee_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(s3control.GranteeType_Values(), false),
+						},
+					},
+				},
+			},
+			"permission": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(s3control.Permission_Values(), false),
+			},
+			"s3_prefix_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(s3control.S3PrefixType_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAccessGrantCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn(ctx)
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &s3control.CreateAccessGrantInput{
+		AccessGrantsLocationId: aws.String(d.Get("access_grants_location_id").(string)),
+		AccountId:              aws.String(accountID),
+		Permission:             aws.String(d.Get("permission").(string)),
+		Tags:                   getResourceTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("access_grants_location_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AccessGrantsLocationConfiguration = expandAccessGrantsLocationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("grantee"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Grantee = expandGrantee(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("s3_prefix_type"); ok {
+		input.S3PrefixType = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAccessGrantWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating S3 Access Grant: %s", err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{accountID, aws.StringValue(output.AccessGrantId)}, accessGrantResourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	return resourceAccessGrantRead(ctx, d, meta)
+}
+
+func resourceAccessGrantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), accessGrantResourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID, grantID := parts[0], parts[1]
+	output, err := FindAccessGrantByTwoPartKey(ctx, conn, accountID, grantID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Access Grant (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Access Grant (%s): %s", d.Id(), err)
+	}
+
+	d.Set("access_grant_arn", output.AccessGrantArn)
+	d.Set("access_grant_id", output.AccessGrantId)
+	if output.AccessGrantsLocationConfiguration != nil {
+		if err := d.Set("access_grants_location_configuration", []interface{}{flattenAccessGrantsLocationConfiguration(output.AccessGrantsLocationConfiguration)}); err != nil {
+			return diag.Errorf("setting access_grants_location_configuration: %s", err)
+		}
+	} else {
+		d.Set("access_grants_location_configuration", nil)
+	}
+	d.Set("access_grants_location_id", output.AccessGrantsLocationId)
+	d.Set("account_id", accountID)
+	d.Set("grant_scope", output.GrantScope)
+	if output.Grantee != nil {
+		if err := d.Set("grantee", []interface{}{flattenGrantee(output.Grantee)}); err != nil {
+			return diag.Errorf("setting grantee: %s", err)
+		}
+	} else {
+		d.Set("grantee", nil)
+	}
+	d.Set("permission", output.Permission)
+
+	return nil
+}
+
+func resourceAccessGrantUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+
+	return resourceAccessGrantRead(ctx, d, meta)
+}
+
+func resourceAccessGrantDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), accessGrantResourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Access Grant: %s", d.Id())
+	_, err = conn.DeleteAccessGrantWithContext(ctx, &s3control.DeleteAccessGrantInput{
+		AccessGrantId: aws.String(parts[1]),
+		AccountId:     aws.String(parts[0]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantNotExistsError, errCodeAccessGrantsInstanceNotExistsError) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting S3 Access Grant (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const (
+	accessGrantResourceIDPartCount = 2
+)
+
+func FindAccessGrantByTwoPartKey(ctx context.Context, conn *s3control.S3Control, accountID, grantID string) (*s3control.GetAccessGrantOutput, error) {
+	input := &s3control.GetAccessGrantInput{
+		AccessGrantId: aws.String(grantID),
+		AccountId:     aws.String(accountID),
+	}
+
+	output, err := conn.GetAccessGrantWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantNotExistsError, errCodeAccessGrantsInstanceNotExistsError) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandAccessGrantsLocationConfiguration(tfMap map[string]interface{}) *s3control.AccessGrantsLocationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.AccessGrantsLocationConfiguration{}
+
+	if v, ok := tfMap["s3_sub_prefix"].(string); ok && v != "" {
+		apiObject.S3SubPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAccessGrantsLocationConfiguration(apiObject *s3control.AccessGrantsLocationConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3SubPrefix; v != nil {
+		tfMap["s3_sub_prefix"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func expandGrantee(tfMap map[string]interface{}) *s3control.Grantee {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.Grantee{}
+
+	if v, ok := tfMap["grantee_identifier"].(string); ok && v != "" {
+		apiObject.GranteeIdentifier = aws.String(v)
+	}
+
+	if v, ok := tfMap["grantee_type"].(string); ok && v != "" {
+		apiObject.GranteeType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenGrantee(apiObject *s3control.Grantee) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.GranteeIdentifier; v != nil {
+		tfMap["grantee_identifier"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.GranteeType; v != nil {
+		tfMap["grantee_type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
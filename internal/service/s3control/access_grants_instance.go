@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3control_access_grants_instance", name="Access Grants Instance")
+// @Tags(identifierAttribute="access_grants_instance_arn")
+func resourceAccessGrantsInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessGrantsInstanceCreate,
+		ReadWithoutTimeout:   resourceAccessGrantsInstanceRead,
+		UpdateWithoutTimeout: resourceAccessGrantsInstanceUpdate,
+		DeleteWithoutTimeout: resourceAccessGrantsInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_grants_instance_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_grants_instance_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"identity_center_application_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_center_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAccessGrantsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn(ctx)
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &s3control.CreateAccessGrantsInstanceInput{
+		AccountId: aws.String(accountID),
+		Tags:      getResourceTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("identity_center_arn"); ok {
+		input.IdentityCenterArn = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateAccessGrantsInstanceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating S3 Access Grants Instance (%s): %s", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	return resourceAccessGrantsInstanceRead(ctx, d, meta)
+}
+
+func resourceAccessGrantsInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn(ctx)
+
+	output, err := FindAccessGrantsInstance(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Access Grants Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Access Grants Instance (%s): %s", d.Id(), err)
+	}
+
+	d.Set("access_grants_instance_arn", output.AccessGrantsInstanceArn)
+	d.Set("access_grants_instance_id", output.AccessGrantsInstanceId)
+	d.Set("account_id", d.Id())
+	// The API returns the ARN of the IAM Identity Center application that S3 Access Grants creates,
+	// not that of the associated IAM Identity Center instance.
+	d.Set("identity_center_application_arn", output.IdentityCenterArn)
+	if output.IdentityCenterArn == nil {
+		d.Set("identity_center_arn", nil)
+	}
+
+	return nil
+}
+
+func resourceAccessGrantsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn(ctx)
+
+	if d.HasChange("identity_center_arn") {
+		o, n := d.GetChange("identity_center_arn")
+
+		if o.(string) != "" {
+			if err := dissociateAccessGrantsIdentityCenter(ctx, conn, d.Id()); err != nil {
+				return diag.Errorf("dissociating S3 Access Grants Instance (%s) IAM Identity Center (%s): %s", d.Id(), o, err)
+			}
+		}
+
+		if n.(string) != "" {
+			input := &s3control.AssociateAccessGrantsIdentityCenterInput{
+				AccountId:         aws.String(d.Id()),
+				IdentityCenterArn: aws.String(n.(string)),
+			}
+
+			_, err := conn.AssociateAccessGrantsIdentityCenterWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("associating S3 Access Grants Instance (%s) IAM Identity Center (%s): %s", d.Id(), n, err)
+			}
+		}
+	}
+
+	return resourceAccessGrantsInstanceRead(ctx, d, meta)
+}
+
+func resourceAccessGrantsInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn(ctx)
+
+	// An associated IAM Identity Center instance must be dissociated before the S3 Access Grants instance can be deleted.
+	if _, ok := d.GetOk("identity_center_application_arn"); ok {
+		err := dissociateAccessGrantsIdentityCenter(ctx, conn, d.Id())
+
+		if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceNotExistsError) {
+			return nil
+		}
+
+		if err != nil {
+			return diag.Errorf("dissociating S3 Access Grants Instance (%s) IAM Identity Center: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Access Grants Instance: %s", d.Id())
+	_, err := conn.DeleteAccessGrantsInstanceWithContext(ctx, &s3control.DeleteAccessGrantsInstanceInput{
+		AccountId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceNotExistsError) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting S3 Access Grants Instance (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func dissociateAccessGrantsIdentityCenter(ctx context.Context, conn *s3control.S3Control, accountID string) error {
+	input := &s3control.DissociateAccessGrantsIdentityCenterInput{
+		AccountId: aws.String(accountID),
+	}
+
+	_, err := conn.DissociateAccessGrantsIdentityCenterWithContext(ctx, input)
+
+	return err
+}
+
+func FindAccessGrantsInstance(ctx context.Context, conn *s3control.S3Control, accountID string) (*s3control.GetAccessGrantsInstanceOutput, error) {
+	input := &s3control.GetAccessGrantsInstanceInput{
+		AccountId: aws.String(accountID),
+	}
+
+	output, err := conn.GetAccessGrantsInstanceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceNotExistsError) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3control_access_grants_location", name="Access Grants Location")
+// @Tags(identifierAttribute="access_grants_location_arn")
+func resourceAccessGrantsLocation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessGrantsLocationCreate,
+		ReadWithoutTimeout:   resourceAccessGrantsLocationRead,
+		UpdateWithoutTimeout: resourceAccessGrantsLocationUpdate,
+		DeleteWithoutTimeout: resourceAccessGrantsLocationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_grants_location_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_grants_location_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"location_scope": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAccessGrantsLocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn(ctx)
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &s3control.CreateAccessGrantsLocationInput{
+		AccountId:     aws.String(accountID),
+		IAMRoleArn:    aws.String(d.Get("iam_role_arn").(string)),
+		LocationScope: aws.String(d.Get("location_scope").(string)),
+		Tags:          getResourceTagsIn(ctx),
+	}
+
+	// S3 Access Grants must be able to assume the newly created IAM role.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateAccessGrantsLocationWithContext(ctx, input)
+	}, errCodeInvalidIAMRole)
+
+	if err != nil {
+		return diag.Errorf("creating S3 Access Grants Location (%s): %s", d.Get("location_scope").(string), err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{accountID, aws.StringValue(outputRaw.(*s3control.CreateAccessGrantsLocationOutput).AccessGrantsLocationId)}, accessGrantsLocationResourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	return resourceAccessGrantsLocationRead(ctx, d, meta)
+}
+
+func resourceAccessGrantsLocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), accessGrantsLocationResourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID, locationID := parts[0], parts[1]
+	output, err := FindAccessGrantsLocationByTwoPartKey(ctx, conn, accountID, locationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Access Grants Location (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Access Grants Location (%s): %s", d.Id(), err)
+	}
+
+	d.Set("access_grants_location_arn", output.AccessGrantsLocationArn)
+	d.Set("access_grants_location_id", output.AccessGrantsLocationId)
+	d.Set("account_id", accountID)
+	d.Set("iam_role_arn", output.IAMRoleArn)
+	d.Set("location_scope", output.LocationScope)
+
+	return nil
+}
+
+func resourceAccessGrantsLocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn(ctx)
+
+	if d.HasChange("iam_role_arn") {
+		parts, err := flex.ExpandResourceId(d.Id(), accessGrantsLocationResourceIDPartCount, false)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &s3control.UpdateAccessGrantsLocationInput{
+			AccessGrantsLocationId: aws.String(parts[1]),
+			AccountId:              aws.String(parts[0]),
+			IAMRoleArn:             aws.String(d.Get("iam_role_arn").(string)),
+		}
+
+		_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.UpdateAccessGrantsLocationWithContext(ctx, input)
+		}, errCodeInvalidIAMRole)
+
+		if err != nil {
+			return diag.Errorf("updating S3 Access Grants Location (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAccessGrantsLocationRead(ctx, d, meta)
+}
+
+func resourceAccessGrantsLocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ControlConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), accessGrantsLocationResourceIDPartCount, false)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Access Grants Location: %s", d.Id())
+	_, err = conn.DeleteAccessGrantsLocationWithContext(ctx, &s3control.DeleteAccessGrantsLocationInput{
+		AccessGrantsLocationId: aws.String(parts[1]),
+		AccountId:              aws.String(parts[0]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceNotExistsError, errCodeAccessGrantsLocationNotExistsError) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting S3 Access Grants Location (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const (
+	accessGrantsLocationResourceIDPartCount = 2
+)
+
+func FindAccessGrantsLocationByTwoPartKey(ctx context.Context, conn *s3control.S3Control, accountID, locationID string) (*s3control.GetAccessGrantsLocationOutput, error) {
+	input := &s3control.GetAccessGrantsLocationInput{
+		AccessGrantsLocationId: aws.String(locationID),
+		AccountId:              aws.String(accountID),
+	}
+
+	output, err := conn.GetAccessGrantsLocationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceNotExistsError, errCodeAccessGrantsLocationNotExistsError) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
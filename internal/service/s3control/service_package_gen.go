@@ -21,6 +21,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 	return []*types.ServicePackageFrameworkDataSource{}
 }
 
+func (p *servicePackage) FrameworkEphemeralResources(ctx context.Context) []*types.ServicePackageFrameworkEphemeralResource {
+	return []*types.ServicePackageFrameworkEphemeralResource{}
+}
+
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{}
 }
@@ -38,6 +42,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 	}
 }
 
+func (p *servicePackage) SDKListResources(ctx context.Context) []*types.ServicePackageSDKListResource {
+	return []*types.ServicePackageSDKListResource{}
+}
+
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
 		{
@@ -48,6 +56,35 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceAccountPublicAccessBlock,
 			TypeName: "aws_s3_account_public_access_block",
 		},
+		{
+			Factory:  resourceAccessGrant,
+			TypeName: "aws_s3control_access_grant",
+			Name:     "Access Grant",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "access_grant_arn",
+			},
+		},
+		{
+			Factory:  resourceAccessGrantsInstance,
+			TypeName: "aws_s3control_access_grants_instance",
+			Name:     "Access Grants Instance",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "access_grants_instance_arn",
+			},
+		},
+		{
+			Factory:  resourceAccessGrantsInstanceResourcePolicy,
+			TypeName: "aws_s3control_access_grants_instance_resource_policy",
+			Name:     "Access Grants Instance Resource Policy",
+		},
+		{
+			Factory:  resourceAccessGrantsLocation,
+			TypeName: "aws_s3control_access_grants_location",
+			Name:     "Access Grants Location",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "access_grants_location_arn",
+			},
+		},
 		{
 			Factory:  resourceAccessPointPolicy,
 			TypeName: "aws_s3control_access_point_policy",
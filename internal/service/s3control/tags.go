@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Custom S3control tagging functions using similar formatting as other service generated code.
+// These are used by the resources, e.g. S3 Access Grants, that are tagged via the ListTagsForResource,
+// TagResource and UntagResource APIs. Those APIs use the Tag type rather than the S3Tag type and
+// require the owning account ID, which is taken from the resource ARN.
+
+// listTags lists s3control service tags.
+// The identifier is the resource ARN.
+func listTags(ctx context.Context, conn *s3control.S3Control, identifier string) (tftags.KeyValueTags, error) {
+	parsedARN, err := arn.Parse(identifier)
+
+	if err != nil {
+		return tftags.New(ctx, nil), err
+	}
+
+	input := &s3control.ListTagsForResourceInput{
+		AccountId:   aws.String(parsedARN.AccountID),
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResourceWithContext(ctx, input)
+
+	if err != nil {
+		return tftags.New(ctx, nil), err
+	}
+
+	return keyValueTagsFromResourceTags(ctx, output.Tags), nil
+}
+
+// ListTags lists s3control service tags and set them in Context.
+// It is called from outside this package.
+func (p *servicePackage) ListTags(ctx context.Context, meta any, identifier string) error {
+	tags, err := listTags(ctx, meta.(*conns.AWSClient).S3ControlConn(ctx), identifier)
+
+	if err != nil {
+		return err
+	}
+
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.TagsOut = types.Some(tags)
+	}
+
+	return nil
+}
+
+// resourceTags returns s3control service tags of the Tag type.
+func resourceTags(tags tftags.KeyValueTags) []*s3control.Tag {
+	result := make([]*s3control.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &s3control.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// keyValueTagsFromResourceTags creates tftags.KeyValueTags from s3control service tags of the Tag type.
+func keyValueTagsFromResourceTags(ctx context.Context, tags []*s3control.Tag) tftags.KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return tftags.New(ctx, m)
+}
+
+// getResourceTagsIn returns s3control service tags of the Tag type from Context.
+// nil is returned if there are no input tags.
+func getResourceTagsIn(ctx context.Context) []*s3control.Tag {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		if tags := resourceTags(inContext.TagsIn.UnwrapOrDefault()); len(tags) > 0 {
+			return tags
+		}
+	}
+
+	return nil
+}
+
+// updateTags updates s3control service tags.
+// The identifier is the resource ARN.
+func updateTags(ctx context.Context, conn *s3control.S3Control, identifier string, oldTagsMap, newTagsMap any) error {
+	parsedARN, err := arn.Parse(identifier)
+
+	if err != nil {
+		return err
+	}
+
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	removedTags := oldTags.Removed(newTags)
+	removedTags = removedTags.IgnoreSystem(names.S3Control)
+	if len(removedTags) > 0 {
+		input := &s3control.UntagResourceInput{
+			AccountId:   aws.String(parsedARN.AccountID),
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.Keys()),
+		}
+
+		_, err := conn.UntagResourceWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	updatedTags := oldTags.Updated(newTags)
+	updatedTags = updatedTags.IgnoreSystem(names.S3Control)
+	if len(updatedTags) > 0 {
+		input := &s3control.TagResourceInput{
+			AccountId:   aws.String(parsedARN.AccountID),
+			ResourceArn: aws.String(identifier),
+			Tags:        resourceTags(updatedTags),
+		}
+
+		_, err := conn.TagResourceWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateTags updates s3control service tags.
+// It is called from outside this package.
+func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier string, oldTags, newTags any) error {
+	return updateTags(ctx, meta.(*conns.AWSClient).S3ControlConn(ctx), identifier, oldTags, newTags)
+}
@@ -176,7 +176,7 @@ func TestAccSignerSigningProfilePermission_statementPrefix(t *testing.T) {
 }
 
 func testAccSigningProfilePermissionConfig_basic(profileName string) string {
-	return fmt.Sprintf(testAccSigningProfilePermissionConfig_base(profileName) + `
+	return acctest.ConfigCompose(testAccSigningProfilePermissionConfig_base(profileName), `
 data "aws_caller_identity" "current" {}
 
 resource "aws_signer_signing_profile_permission" "test_sp_permission" {
@@ -187,7 +187,7 @@ resource "aws_signer_signing_profile_permission" "test_sp_permission" {
 }
 
 func testAccSigningProfilePermissionConfig_startJobGetSP(profileName string) string {
-	return fmt.Sprintf(testAccSigningProfilePermissionConfig_base(profileName) + `
+	return acctest.ConfigCompose(testAccSigningProfilePermissionConfig_base(profileName), `
 data "aws_caller_identity" "current" {}
 
 resource "aws_signer_signing_profile_permission" "sp1_perm" {
@@ -218,7 +218,7 @@ resource "aws_signer_signing_profile_permission" "sp1_perm" {
 }
 
 func testAccSigningProfilePermissionConfig_getSP(profileName string) string {
-	return fmt.Sprintf(testAccSigningProfilePermissionConfig_base(profileName) + `
+	return acctest.ConfigCompose(testAccSigningProfilePermissionConfig_base(profileName), `
 data "aws_caller_identity" "current" {}
 
 resource "aws_signer_signing_profile_permission" "test_sp_permission" {
@@ -229,7 +229,7 @@ resource "aws_signer_signing_profile_permission" "test_sp_permission" {
 }
 
 func testAccSigningProfilePermissionConfig_revokeSignature(profileName string) string {
-	return fmt.Sprintf(testAccSigningProfilePermissionConfig_base(profileName) + `
+	return acctest.ConfigCompose(testAccSigningProfilePermissionConfig_base(profileName), `
 data "aws_caller_identity" "current" {}
 
 resource "aws_signer_signing_profile_permission" "test_sp_permission" {
@@ -11,6 +11,11 @@ const (
 	FIFOTopicNameSuffix = ".fifo"
 )
 
+const (
+	// Reference: https://docs.aws.amazon.com/general/latest/gr/sns.html#limits_sns.
+	topicPolicyMaxSize = 30720
+)
+
 const (
 	PlatformApplicationAttributeNameAppleCertificateExpiryDate = "AppleCertificateExpiryDate"
 	PlatformApplicationAttributeNameApplePlatformBundleID      = "ApplePlatformBundleID"
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -144,10 +145,13 @@ var (
 			Computed: true,
 		},
 		"policy": {
-			Type:                  schema.TypeString,
-			Optional:              true,
-			Computed:              true,
-			ValidateFunc:          validation.StringIsJSON,
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateDiagFunc: iampolicy.ValidDocument(iampolicy.Options{
+				Type:    iampolicy.ResourceBasedPolicy,
+				MaxSize: topicPolicyMaxSize,
+			}),
 			DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 			DiffSuppressOnRefresh: true,
 			StateFunc: func(v interface{}) string {
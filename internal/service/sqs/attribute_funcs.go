@@ -104,7 +104,7 @@ func (h *queueAttributeHandler) Delete(ctx context.Context, d *schema.ResourceDa
 		QueueUrl:   aws.String(d.Id()),
 	})
 
-	if tfawserr.ErrCodeEquals(err, sqs.ErrCodeQueueDoesNotExist) {
+	if tfawserr.ErrCodeEquals(err, errCodeQueueDoesNotExist) {
 		return nil
 	}
 
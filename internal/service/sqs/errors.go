@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs
+
+// SQS uses the JSON protocol but returns the error codes of its former Query protocol,
+// which the AWS SDK for Go reports instead of the sqs.ErrCode* constants.
+const (
+	errCodeQueueDeletedRecently = "AWS.SimpleQueueService.QueueDeletedRecently"
+	errCodeQueueDoesNotExist    = "AWS.SimpleQueueService.NonExistentQueue"
+)
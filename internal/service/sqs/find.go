@@ -9,7 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -21,7 +21,7 @@ func FindQueueAttributesByURL(ctx context.Context, conn *sqs.SQS, url string) (m
 
 	output, err := conn.GetQueueAttributesWithContext(ctx, input)
 
-	if tfawserr.ErrCodeEquals(err, sqs.ErrCodeQueueDoesNotExist) {
+	if tfawserr.ErrCodeEquals(err, errCodeQueueDoesNotExist) {
 		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -47,7 +47,7 @@ func FindQueueAttributeByURL(ctx context.Context, conn *sqs.SQS, url string, att
 
 	output, err := conn.GetQueueAttributesWithContext(ctx, input)
 
-	if tfawserr.ErrCodeEquals(err, sqs.ErrCodeQueueDoesNotExist) {
+	if tfawserr.ErrCodeEquals(err, errCodeQueueDoesNotExist) {
 		return "", &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
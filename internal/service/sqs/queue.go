@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -100,10 +101,12 @@ var (
 			ConflictsWith: []string{"name"},
 		},
 		"policy": {
-			Type:                  schema.TypeString,
-			Optional:              true,
-			Computed:              true,
-			ValidateFunc:          validation.StringIsJSON,
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateDiagFunc: iampolicy.ValidDocument(iampolicy.Options{
+				Type: iampolicy.ResourceBasedPolicy,
+			}),
 			DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
 			DiffSuppressOnRefresh: true,
 			StateFunc: func(v interface{}) string {
@@ -224,7 +227,7 @@ func resourceQueueCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, queueCreatedTimeout, func() (interface{}, error) {
 		return conn.CreateQueueWithContext(ctx, input)
-	}, sqs.ErrCodeQueueDeletedRecently)
+	}, errCodeQueueDeletedRecently)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
 	if input.Tags != nil && errs.IsUnsupportedOperationInPartitionError(conn.PartitionID, err) {
@@ -232,7 +235,7 @@ func resourceQueueCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 		outputRaw, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, queueCreatedTimeout, func() (interface{}, error) {
 			return conn.CreateQueueWithContext(ctx, input)
-		}, sqs.ErrCodeQueueDeletedRecently)
+		}, errCodeQueueDeletedRecently)
 	}
 
 	if err != nil {
@@ -349,7 +352,7 @@ func resourceQueueDelete(ctx context.Context, d *schema.ResourceData, meta inter
 		QueueUrl: aws.String(d.Id()),
 	})
 
-	if tfawserr.ErrCodeEquals(err, sqs.ErrCodeQueueDoesNotExist) {
+	if tfawserr.ErrCodeEquals(err, errCodeQueueDoesNotExist) {
 		return nil
 	}
 
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/sqs"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/awsmock"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -32,6 +34,117 @@ func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
 	)
 }
 
+// errQueueDoesNotExist is the error returned by SQS for a queue that does not exist.
+// SQS uses the JSON protocol but is compatible with its former Query protocol.
+var errQueueDoesNotExist = &awsmock.Error{
+	Code:           sqs.ErrCodeQueueDoesNotExist,
+	Message:        "The specified queue does not exist.",
+	QueryErrorCode: "AWS.SimpleQueueService.NonExistentQueue",
+}
+
+// queueFake is a minimal stateful SQS queue API for unit tests.
+type queueFake struct {
+	mu     sync.Mutex
+	queues map[string]map[string]string
+}
+
+func newQueueFake(s *awsmock.Server) *queueFake {
+	f := &queueFake{
+		queues: make(map[string]map[string]string),
+	}
+
+	s.Handle("sqs", "CreateQueue", func(r *awsmock.Request) (*awsmock.Response, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		url := s.URL + "/" + awsmock.AccountID + "/" + r.Param("QueueName")
+		attributes := make(map[string]string)
+		if v, ok := r.Params["Attributes"].(map[string]any); ok {
+			for k, v := range v {
+				attributes[k] = fmt.Sprint(v)
+			}
+		}
+		attributes[sqs.QueueAttributeNameQueueArn] = "arn:aws:sqs:" + awsmock.Region + ":" + awsmock.AccountID + ":" + r.Param("QueueName")
+		f.queues[url] = attributes
+
+		return &awsmock.Response{Body: map[string]any{"QueueUrl": url}}, nil
+	})
+	s.Handle("sqs", "GetQueueAttributes", func(r *awsmock.Request) (*awsmock.Response, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		attributes, ok := f.queues[r.Param("QueueUrl")]
+		if !ok {
+			return nil, errQueueDoesNotExist
+		}
+
+		return &awsmock.Response{Body: map[string]any{"Attributes": attributes}}, nil
+	})
+	s.Handle("sqs", "ListQueueTags", awsmock.Respond(nil))
+	s.Handle("sqs", "DeleteQueue", func(r *awsmock.Request) (*awsmock.Response, error) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if _, ok := f.queues[r.Param("QueueUrl")]; !ok {
+			return nil, errQueueDoesNotExist
+		}
+		delete(f.queues, r.Param("QueueUrl"))
+
+		return nil, nil
+	})
+
+	return f
+}
+
+func (f *queueFake) attributes(url string) map[string]string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.queues[url]
+}
+
+func TestQueueCreateDelete(t *testing.T) {
+	t.Parallel()
+
+	ctx := awsmock.Context(context.Background())
+	s := awsmock.NewServer(t)
+	f := newQueueFake(s)
+	p := s.Provider(ctx, t)
+
+	state, diags := awsmock.CreateResource(ctx, t, p, "aws_sqs_queue", map[string]any{
+		"name":          "test",
+		"delay_seconds": 90,
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := state.ID, s.URL+"/"+awsmock.AccountID+"/test"; got != want {
+		t.Errorf("ID = %q, want %q", got, want)
+	}
+
+	if got, want := f.attributes(state.ID)[sqs.QueueAttributeNameDelaySeconds], "90"; got != want {
+		t.Errorf("DelaySeconds = %q, want %q", got, want)
+	}
+
+	if got, want := state.Attributes["arn"], "arn:aws:sqs:"+awsmock.Region+":"+awsmock.AccountID+":test"; got != want {
+		t.Errorf("arn = %q, want %q", got, want)
+	}
+
+	if diags := awsmock.DeleteResource(ctx, t, p, "aws_sqs_queue", state); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := f.attributes(state.ID); got != nil {
+		t.Errorf("queue %s exists after delete", state.ID)
+	}
+
+	if got := len(s.Requests("sqs", "DeleteQueue")); got != 1 {
+		t.Errorf("DeleteQueue requests = %d, want 1", got)
+	}
+}
+
 func TestAccSQSQueue_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var queueAttributes map[string]string
@@ -19,7 +19,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sqs_queue", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sqs_queue", &resource.Sweeper{
 		Name: "aws_sqs_queue",
 		F:    sweepQueues,
 		Dependencies: []string{
@@ -14,12 +14,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite"
 	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -353,11 +353,11 @@ func expandRetentionProperties(tfList []interface{}) *types.RetentionProperties
 	apiObject := &types.RetentionProperties{}
 
 	if v, ok := tfMap["magnetic_store_retention_period_in_days"].(int); ok {
-		apiObject.MagneticStoreRetentionPeriodInDays = int64(v)
+		apiObject.MagneticStoreRetentionPeriodInDays = aws.Int64(int64(v))
 	}
 
 	if v, ok := tfMap["memory_store_retention_period_in_hours"].(int); ok {
-		apiObject.MemoryStoreRetentionPeriodInHours = int64(v)
+		apiObject.MemoryStoreRetentionPeriodInHours = aws.Int64(int64(v))
 	}
 
 	return apiObject
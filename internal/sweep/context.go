@@ -5,6 +5,29 @@ package sweep
 
 import "context"
 
+type contextKeyType int
+
+var contextKey contextKeyType
+
+// inContext represents the sweeper information stored in a Context.
+type inContext struct {
+	region  string
+	sweeper string // Only set in dry-run mode.
+}
+
 func Context(region string) context.Context {
-	return context.Background()
+	v := inContext{
+		region: region,
+	}
+
+	if dryRun() {
+		v.sweeper = dryRunSweeper(region)
+	}
+
+	return context.WithValue(context.Background(), contextKey, &v)
+}
+
+func fromContext(ctx context.Context) (*inContext, bool) {
+	v, ok := ctx.Value(contextKey).(*inContext)
+	return v, ok
 }
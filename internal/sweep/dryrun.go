@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"log"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// dryRunState records, per region, the sweeper currently running and the resources each sweeper would delete.
+// In dry-run mode sweepers are run sequentially in each region.
+var dryRunState = struct {
+	sync.Mutex
+	sweepers  map[string]string              // Keyed by region.
+	resources map[string]map[string][]string // Keyed by region and sweeper name.
+}{
+	sweepers:  make(map[string]string),
+	resources: make(map[string]map[string][]string),
+}
+
+// dryRun returns whether sweepers only report the resources that they would delete.
+func dryRun() bool {
+	return os.Getenv(envvar.SweepDryRun) != ""
+}
+
+func dryRunSweeper(region string) string {
+	dryRunState.Lock()
+	defer dryRunState.Unlock()
+
+	return dryRunState.sweepers[region]
+}
+
+func setDryRunSweeper(region, sweeper string) {
+	dryRunState.Lock()
+	defer dryRunState.Unlock()
+
+	dryRunState.sweepers[region] = sweeper
+}
+
+func recordDryRun(region, sweeper, resource string) {
+	dryRunState.Lock()
+	defer dryRunState.Unlock()
+
+	if _, ok := dryRunState.resources[region]; !ok {
+		dryRunState.resources[region] = make(map[string][]string)
+	}
+	dryRunState.resources[region][sweeper] = append(dryRunState.resources[region][sweeper], resource)
+}
+
+// recordDryRunSweepable records a resource that would be deleted by the sweeper running in Context.
+func recordDryRunSweepable(ctx context.Context, sweepable Sweepable) {
+	region, sweeper := "", ""
+	if v, ok := fromContext(ctx); ok {
+		region, sweeper = v.region, v.sweeper
+	}
+
+	recordDryRun(region, sweeper, sweepableString(sweepable))
+}
+
+func logDryRunReport(region string) {
+	dryRunState.Lock()
+	defer dryRunState.Unlock()
+
+	resources := dryRunState.resources[region]
+	names := make([]string, 0, len(resources))
+	for sweeper := range resources {
+		names = append(names, sweeper)
+	}
+	sort.Strings(names)
+
+	log.Printf("Sweepers for region (%s) would delete (dry run):\n", region)
+	for _, sweeper := range names {
+		log.Printf("\t- %s:\n", sweeper)
+		for _, resource := range resources[sweeper] {
+			log.Printf("\t\t- %s\n", resource)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+// filterable is implemented by Sweepables that can be evaluated against sweeper filter criteria.
+type filterable interface {
+	FilterResource(ctx context.Context) (*filter.Resource, error)
+}
+
+// skippedState records, per region, the resources and sweepers skipped as they could not be evaluated against sweeper filter criteria.
+var skippedState = struct {
+	sync.Mutex
+	resources map[string][]string // Keyed by region.
+}{
+	resources: make(map[string][]string),
+}
+
+func recordSkipped(ctx context.Context, sweepable Sweepable, err error) {
+	region := ""
+	if v, ok := fromContext(ctx); ok {
+		region = v.region
+	}
+
+	resource := sweepableString(sweepable)
+	log.Printf("[WARN] Skipping resource (%s) in region (%s): %s", resource, region, err)
+
+	skippedState.Lock()
+	defer skippedState.Unlock()
+
+	skippedState.resources[region] = append(skippedState.resources[region], fmt.Sprintf("%s: %s", resource, err))
+}
+
+// recordSkippedSweeper records a sweeper that was stopped from deleting resources directly, rather than via SweepOrchestratorWithContext,
+// as the resources could not be evaluated against sweeper filter criteria.
+func recordSkippedSweeper(region, sweeper string, err error) {
+	log.Printf("[WARN] Skipping Sweeper (%s) in region (%s): sweeper filters not supported: %s", sweeper, region, err)
+
+	skippedState.Lock()
+	defer skippedState.Unlock()
+
+	skippedState.resources[region] = append(skippedState.resources[region], fmt.Sprintf("sweeper %s: sweeper filters not supported (%s)", sweeper, err))
+}
+
+func logSkippedReport(region string) {
+	skippedState.Lock()
+	defer skippedState.Unlock()
+
+	resources := skippedState.resources[region]
+	if len(resources) == 0 {
+		return
+	}
+
+	log.Printf("Sweepers for region (%s) skipped resources and sweepers that could not be evaluated against sweeper filters:\n", region)
+	for _, resource := range resources {
+		log.Printf("\t- %s\n", resource)
+	}
+}
+
+// filtered returns whether any sweeper filter criteria are set in environment variables.
+func filtered() bool {
+	criteria, err := filter.FromEnv()
+
+	return err == nil && !criteria.IsEmpty()
+}
+
+// filterSweepables returns the Sweepables matching the sweeper filter criteria set in environment variables.
+// Sweepables that cannot be evaluated against the criteria are skipped and reported.
+func filterSweepables(ctx context.Context, sweepables []Sweepable) ([]Sweepable, error) {
+	criteria, err := filter.FromEnv()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if criteria.IsEmpty() {
+		return sweepables, nil
+	}
+
+	var wg sync.WaitGroup
+	matches := make([]bool, len(sweepables))
+	semaphore := tfsync.InitializeSemaphore(envvar.SweepParallelism, defaultSweepParallelism)
+	if cap(semaphore) == 0 {
+		semaphore = make(tfsync.Semaphore, 1)
+	}
+
+	for i, sweepable := range sweepables {
+		i, sweepable := i, sweepable
+
+		v, ok := sweepable.(filterable)
+		if !ok {
+			recordSkipped(ctx, sweepable, fmt.Errorf("sweeper filters not supported for %T", sweepable))
+			continue
+		}
+
+		if err := semaphore.Wait(ctx); err != nil {
+			recordSkipped(ctx, sweepable, fmt.Errorf("reading resource: %w", err))
+			continue
+		}
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer semaphore.Notify()
+
+			r, err := v.FilterResource(ctx)
+
+			if err != nil {
+				recordSkipped(ctx, sweepable, fmt.Errorf("reading resource: %w", err))
+				return
+			}
+
+			// Resource no longer exists.
+			if r == nil {
+				return
+			}
+
+			match, err := criteria.Match(r)
+
+			if err != nil {
+				recordSkipped(ctx, sweepable, err)
+				return
+			}
+
+			if !match {
+				log.Printf("[DEBUG] Skipping resource (%s): does not match sweeper filters", sweepableString(sweepable))
+				return
+			}
+
+			matches[i] = true
+		}()
+	}
+
+	wg.Wait()
+
+	var filtered []Sweepable
+	for i, sweepable := range sweepables {
+		if matches[i] {
+			filtered = append(filtered, sweepable)
+		}
+	}
+
+	return filtered, nil
+}
+
+func sweepableString(sweepable Sweepable) string {
+	if v, ok := sweepable.(fmt.Stringer); ok {
+		return v.String()
+	}
+
+	return fmt.Sprintf("%T", sweepable)
+}
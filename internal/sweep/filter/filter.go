@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// Resource is the information about a resource that is evaluated against sweeper filter criteria.
+// Zero values are unknown.
+type Resource struct {
+	ARN          string
+	CreationTime time.Time
+	Name         string
+	Tags         map[string]string
+}
+
+// Criteria are the criteria that a resource must match to be swept.
+// All the specified criteria must match.
+type Criteria struct {
+	AllowARNs  map[string]struct{}
+	DenyARNs   map[string]struct{}
+	MinimumAge time.Duration
+	NameRegex  *regexp.Regexp
+	TagKey     string
+	TagValue   string
+
+	now func() time.Time
+}
+
+// UnevaluableError is returned when a resource cannot be evaluated against a criterion
+// as the required information about the resource is not known.
+type UnevaluableError struct {
+	Criterion string
+}
+
+func (e *UnevaluableError) Error() string {
+	return fmt.Sprintf("resource cannot be evaluated against sweeper filter (%s)", e.Criterion)
+}
+
+// FromEnv returns the sweeper filter criteria set in environment variables.
+func FromEnv() (*Criteria, error) {
+	c := &Criteria{
+		AllowARNs: arnSet(os.Getenv(envvar.SweepAllowARNs)),
+		DenyARNs:  arnSet(os.Getenv(envvar.SweepDenyARNs)),
+		TagKey:    os.Getenv(envvar.SweepTagKey),
+		TagValue:  os.Getenv(envvar.SweepTagValue),
+		now:       time.Now,
+	}
+
+	if c.TagValue != "" && c.TagKey == "" {
+		return nil, fmt.Errorf("environment variable %s requires %s", envvar.SweepTagValue, envvar.SweepTagKey)
+	}
+
+	if v := os.Getenv(envvar.SweepMinimumAge); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepMinimumAge, err)
+		}
+		c.MinimumAge = d
+	}
+
+	if v := os.Getenv(envvar.SweepNameRegex); v != "" {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepNameRegex, err)
+		}
+		c.NameRegex = re
+	}
+
+	return c, nil
+}
+
+func arnSet(s string) map[string]struct{} {
+	set := make(map[string]struct{})
+
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			set[v] = struct{}{}
+		}
+	}
+
+	return set
+}
+
+// IsEmpty returns whether no criteria are specified, in which case all resources are swept.
+func (c *Criteria) IsEmpty() bool {
+	return len(c.AllowARNs) == 0 && len(c.DenyARNs) == 0 && c.MinimumAge == 0 && c.NameRegex == nil && c.TagKey == ""
+}
+
+// Match returns whether the specified resource matches all the criteria.
+// Returns an UnevaluableError if the information about the resource required by a criterion is not known.
+func (c *Criteria) Match(r *Resource) (bool, error) {
+	if len(c.AllowARNs) > 0 || len(c.DenyARNs) > 0 {
+		if r.ARN == "" {
+			return false, &UnevaluableError{Criterion: "ARN"}
+		}
+
+		if _, ok := c.DenyARNs[r.ARN]; ok {
+			return false, nil
+		}
+
+		if _, ok := c.AllowARNs[r.ARN]; len(c.AllowARNs) > 0 && !ok {
+			return false, nil
+		}
+	}
+
+	if c.TagKey != "" {
+		if r.Tags == nil {
+			return false, &UnevaluableError{Criterion: "tag"}
+		}
+
+		v, ok := r.Tags[c.TagKey]
+		if !ok || (c.TagValue != "" && v != c.TagValue) {
+			return false, nil
+		}
+	}
+
+	if c.MinimumAge > 0 {
+		if r.CreationTime.IsZero() {
+			return false, &UnevaluableError{Criterion: "minimum age"}
+		}
+
+		now := time.Now
+		if c.now != nil {
+			now = c.now
+		}
+
+		if now().Sub(r.CreationTime) < c.MinimumAge {
+			return false, nil
+		}
+	}
+
+	if c.NameRegex != nil {
+		if r.Name == "" {
+			return false, &UnevaluableError{Criterion: "name"}
+		}
+
+		if !c.NameRegex.MatchString(r.Name) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// CreationTimeAttributes are the names of resource attributes commonly holding a resource's creation timestamp.
+var CreationTimeAttributes = []string{
+	"create_date",
+	"create_time",
+	"created_at",
+	"created_date",
+	"created_time",
+	"creation_date",
+	"creation_time",
+}
+
+// ParseCreationTime parses a creation timestamp attribute value.
+func ParseCreationTime(s string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05Z0700", "2006-01-02 15:04:05 -0700 MST"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestCriteriaMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC)
+	resource := &Resource{
+		ARN:          "arn:aws:s3:::tf-acc-test-1",
+		CreationTime: now.Add(-48 * time.Hour),
+		Name:         "tf-acc-test-1",
+		Tags:         map[string]string{"Owner": "sweeper"},
+	}
+
+	testCases := []struct {
+		name            string
+		criteria        *Criteria
+		resource        *Resource
+		expected        bool
+		expectUnevalErr bool
+	}{
+		{
+			name:     "no criteria",
+			criteria: &Criteria{},
+			resource: &Resource{},
+			expected: true,
+		},
+		{
+			name:     "allowed ARN",
+			criteria: &Criteria{AllowARNs: map[string]struct{}{"arn:aws:s3:::tf-acc-test-1": {}}},
+			resource: resource,
+			expected: true,
+		},
+		{
+			name:     "not allowed ARN",
+			criteria: &Criteria{AllowARNs: map[string]struct{}{"arn:aws:s3:::tf-acc-test-2": {}}},
+			resource: resource,
+		},
+		{
+			name: "denied ARN",
+			criteria: &Criteria{
+				AllowARNs: map[string]struct{}{"arn:aws:s3:::tf-acc-test-1": {}},
+				DenyARNs:  map[string]struct{}{"arn:aws:s3:::tf-acc-test-1": {}},
+			},
+			resource: resource,
+		},
+		{
+			name:            "unknown ARN",
+			criteria:        &Criteria{DenyARNs: map[string]struct{}{"arn:aws:s3:::tf-acc-test-2": {}}},
+			resource:        &Resource{Name: "tf-acc-test-1"},
+			expectUnevalErr: true,
+		},
+		{
+			name:     "tag key",
+			criteria: &Criteria{TagKey: "Owner"},
+			resource: resource,
+			expected: true,
+		},
+		{
+			name:     "tag key and value",
+			criteria: &Criteria{TagKey: "Owner", TagValue: "sweeper"},
+			resource: resource,
+			expected: true,
+		},
+		{
+			name:     "tag value mismatch",
+			criteria: &Criteria{TagKey: "Owner", TagValue: "someone-else"},
+			resource: resource,
+		},
+		{
+			name:     "no tags",
+			criteria: &Criteria{TagKey: "Owner"},
+			resource: &Resource{Tags: map[string]string{}},
+		},
+		{
+			name:            "unknown tags",
+			criteria:        &Criteria{TagKey: "Owner"},
+			resource:        &Resource{},
+			expectUnevalErr: true,
+		},
+		{
+			name:     "old enough",
+			criteria: &Criteria{MinimumAge: 24 * time.Hour, now: func() time.Time { return now }},
+			resource: resource,
+			expected: true,
+		},
+		{
+			name:     "too new",
+			criteria: &Criteria{MinimumAge: 72 * time.Hour, now: func() time.Time { return now }},
+			resource: resource,
+		},
+		{
+			name:            "unknown creation time",
+			criteria:        &Criteria{MinimumAge: 24 * time.Hour, now: func() time.Time { return now }},
+			resource:        &Resource{Name: "tf-acc-test-1"},
+			expectUnevalErr: true,
+		},
+		{
+			name:     "name matches",
+			criteria: &Criteria{NameRegex: regexp.MustCompile(`^tf-acc-test-`)},
+			resource: resource,
+			expected: true,
+		},
+		{
+			name:     "name does not match",
+			criteria: &Criteria{NameRegex: regexp.MustCompile(`^tf-test-`)},
+			resource: resource,
+		},
+		{
+			name:            "unknown name",
+			criteria:        &Criteria{NameRegex: regexp.MustCompile(`^tf-acc-test-`)},
+			resource:        &Resource{ARN: "arn:aws:s3:::tf-acc-test-1"},
+			expectUnevalErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.criteria.Match(testCase.resource)
+
+			var unevalErr *UnevaluableError
+			if testCase.expectUnevalErr {
+				if !errors.As(err, &unevalErr) {
+					t.Fatalf("expected UnevaluableError, got: %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("TF_AWS_SWEEP_ALLOW_ARNS", "arn:aws:s3:::a, arn:aws:s3:::b,")
+	t.Setenv("TF_AWS_SWEEP_MINIMUM_AGE", "36h")
+	t.Setenv("TF_AWS_SWEEP_NAME_REGEX", "^tf-acc-test-")
+
+	c, err := FromEnv()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if c.IsEmpty() {
+		t.Fatal("expected criteria")
+	}
+	if got, expected := len(c.AllowARNs), 2; got != expected {
+		t.Errorf("got %d allowed ARNs, expected %d", got, expected)
+	}
+	if got, expected := c.MinimumAge, 36*time.Hour; got != expected {
+		t.Errorf("got minimum age %s, expected %s", got, expected)
+	}
+
+	t.Setenv("TF_AWS_SWEEP_MINIMUM_AGE", "1 day")
+
+	if _, err := FromEnv(); err == nil {
+		t.Fatal("expected error")
+	}
+}
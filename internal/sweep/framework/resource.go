@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -13,9 +14,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -45,11 +47,22 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
-func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+// String returns the identifying attributes of the resource to be deleted.
+func (sr *sweepResource) String() string {
+	attributes := make([]string, len(sr.attributes))
+	for i, attr := range sr.attributes {
+		attributes[i] = fmt.Sprintf("%s=%v", attr.path, attr.value)
+	}
+
+	return strings.Join(attributes, ", ")
+}
+
+// configure returns the configured resource and its state, with only the identifying attributes set.
+func (sr *sweepResource) configure(ctx context.Context) (context.Context, fwresource.Resource, tfsdk.State, error) {
 	resource, err := sr.factory(ctx)
 
 	if err != nil {
-		return err
+		return ctx, nil, tfsdk.State{}, err
 	}
 
 	metadata := resourceMetadata(ctx, resource)
@@ -68,11 +81,91 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	for _, attr := range sr.attributes {
 		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
 		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
+			return ctx, nil, tfsdk.State{}, fwdiag.DiagnosticsError(d)
 		}
 		ctx = tflog.SetField(ctx, attr.path, attr.value)
 	}
 
+	return ctx, resource, state, nil
+}
+
+// FilterResource reads the resource and returns the information used to evaluate it against sweeper filter criteria.
+// A nil value is returned if the resource no longer exists.
+func (sr *sweepResource) FilterResource(ctx context.Context) (*filter.Resource, error) {
+	ctx, resource, state, err := sr.configure(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	if err := fwdiag.DiagnosticsError(response.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	if response.State.Raw.IsNull() {
+		return nil, nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := response.State.Raw.As(&attributes); err != nil {
+		return nil, err
+	}
+
+	r := &filter.Resource{
+		ARN:  stringValue(attributes["arn"]),
+		Name: stringValue(attributes["name"]),
+	}
+
+	for _, k := range []string{"tags_all", "tags"} {
+		if v, ok := attributes[k]; ok {
+			var tags map[string]tftypes.Value
+			if err := v.As(&tags); err != nil {
+				return nil, err
+			}
+
+			r.Tags = make(map[string]string, len(tags))
+			for k, v := range tags {
+				r.Tags[k] = stringValue(v)
+			}
+			break
+		}
+	}
+
+	for _, k := range filter.CreationTimeAttributes {
+		if t, ok := filter.ParseCreationTime(stringValue(attributes[k])); ok {
+			r.CreationTime = t
+			break
+		}
+	}
+
+	return r, nil
+}
+
+// stringValue returns the value of a known, non-null, string attribute, otherwise "".
+func stringValue(v tftypes.Value) string {
+	var s string
+
+	if !v.IsKnown() || v.IsNull() || !v.Type().Is(tftypes.String) {
+		return ""
+	}
+
+	if err := v.As(&s); err != nil {
+		return ""
+	}
+
+	return s
+}
+
+func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	ctx, resource, state, err := sr.configure(ctx)
+
+	if err != nil {
+		return err
+	}
+
 	tflog.Info(ctx, "Sweeping resource")
 
 	err = tfresource.Retry(ctx, timeout, func() *retry.RetryError {
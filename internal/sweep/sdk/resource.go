@@ -10,10 +10,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -31,6 +33,69 @@ func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *c
 	}
 }
 
+// String returns the ID of the resource to be deleted.
+func (sr *sweepResource) String() string {
+	return sr.d.Id()
+}
+
+// FilterResource reads the resource and returns the information used to evaluate it against sweeper filter criteria.
+// A nil value is returned if the resource no longer exists.
+func (sr *sweepResource) FilterResource(ctx context.Context) (*filter.Resource, error) {
+	// Resources using transparent tagging return tags via Context.
+	ctx = tftags.NewContext(ctx, sr.meta.DefaultTagsConfig(ctx), sr.meta.IgnoreTagsConfig)
+
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return nil, err
+	}
+
+	if sr.d.Id() == "" {
+		return nil, nil
+	}
+
+	r := &filter.Resource{}
+	schema := sr.resource.SchemaMap()
+
+	if _, ok := schema["arn"]; ok {
+		r.ARN = sr.d.Get("arn").(string)
+	}
+
+	if _, ok := schema["name"]; ok {
+		r.Name = sr.d.Get("name").(string)
+	}
+
+	if tagsInContext, ok := tftags.FromContext(ctx); ok && tagsInContext.TagsOut.IsSome() {
+		r.Tags = tagsInContext.TagsOut.UnwrapOrDefault().IgnoreAWS().Map()
+	} else {
+		for _, k := range []string{"tags_all", "tags"} {
+			if _, ok := schema[k]; ok {
+				// A resource whose tags are not returned by Read, e.g. those using transparent tagging that
+				// do not set tags in Context, cannot be distinguished from an untagged resource.
+				// Leave its tags unknown so that it is reported as not evaluable rather than as not matching.
+				if v := sr.d.Get(k).(map[string]any); len(v) > 0 {
+					r.Tags = make(map[string]string, len(v))
+					for k, v := range v {
+						r.Tags[k] = v.(string)
+					}
+				}
+				break
+			}
+		}
+	}
+
+	for _, k := range filter.CreationTimeAttributes {
+		if _, ok := schema[k]; ok {
+			if v, ok := sr.d.Get(k).(string); ok {
+				if t, ok := filter.ParseCreationTime(v); ok {
+					r.CreationTime = t
+					break
+				}
+			}
+		}
+	}
+
+	return r, nil
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	ctx = tflog.SetField(ctx, "id", sr.d.Id())
 
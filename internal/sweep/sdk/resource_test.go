@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func TestSweepResourceFilterResource(t *testing.T) {
+	t.Parallel()
+
+	creationTime := time.Date(2023, time.November, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name                string
+		resource            *schema.Resource
+		expected            *filter.Resource
+		expectedUnevaluable bool
+	}{
+		{
+			name: "SchemaFunc",
+			resource: &schema.Resource{
+				SchemaFunc: func() map[string]*schema.Schema {
+					return map[string]*schema.Schema{
+						"arn":          {Type: schema.TypeString, Computed: true},
+						"created_time": {Type: schema.TypeString, Computed: true},
+						"name":         {Type: schema.TypeString, Required: true},
+						"tags":         {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+					}
+				},
+				ReadWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+					d.Set("arn", "arn:aws:test:us-west-2:123456789012:thing/test") //lintignore:AWSAT003,AWSAT005
+					d.Set("created_time", creationTime.Format(time.RFC3339))
+					d.Set("name", "test")
+					d.Set("tags", map[string]any{"sweep": "yes"})
+					return nil
+				},
+			},
+			expected: &filter.Resource{
+				ARN:          "arn:aws:test:us-west-2:123456789012:thing/test", //lintignore:AWSAT003,AWSAT005
+				CreationTime: creationTime,
+				Name:         "test",
+				Tags:         map[string]string{"sweep": "yes"},
+			},
+		},
+		{
+			name: "transparent tagging",
+			resource: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"tags":     {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+					"tags_all": {Type: schema.TypeMap, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				},
+				ReadWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+					if inContext, ok := tftags.FromContext(ctx); ok {
+						inContext.TagsOut = types.Some(tftags.New(ctx, map[string]string{"aws:cloudformation:stack-name": "test", "sweep": "yes"}))
+					}
+					return nil
+				},
+			},
+			expected: &filter.Resource{
+				Tags: map[string]string{"sweep": "yes"},
+			},
+		},
+		{
+			name: "tags not returned",
+			resource: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"tags":     {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+					"tags_all": {Type: schema.TypeMap, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				},
+				ReadWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+					return nil
+				},
+			},
+			expected:            &filter.Resource{},
+			expectedUnevaluable: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			d := testCase.resource.Data(nil)
+			d.SetId("test")
+
+			got, err := NewSweepResource(testCase.resource, d, &conns.AWSClient{}).FilterResource(ctx)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			_, err = (&filter.Criteria{TagKey: "sweep"}).Match(got)
+			var unevaluableErr *filter.UnevaluableError
+			if got, want := errors.As(err, &unevaluableErr), testCase.expectedUnevaluable; got != want {
+				t.Errorf("Match unevaluable = %v (%v), want %v", got, err, want)
+			}
+		})
+	}
+}
@@ -13,10 +13,12 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -62,30 +64,37 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	meta.ServicePackages = servicePackageMap
 
 	conf := &conns.Config{
-		MaxRetries:       5,
+		MaxRetries: 5,
+		// In dry-run mode nothing is deleted.
+		// When sweeper filters are set only the resources deleted via SweepOrchestratorWithContext, which are filtered, are deleted.
+		ReadOnly:         dryRun() || filtered(),
 		Region:           region,
 		SuppressDebugLog: true,
 	}
 
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
-		conf.AssumeRole.RoleARN = role
+		assumeRole := &awsbase.AssumeRole{
+			RoleARN: role,
+		}
 
-		conf.AssumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
+		assumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
 		if v := os.Getenv(envvar.AssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", envvar.AssumeRoleDuration, err)
 			}
-			conf.AssumeRole.Duration = time.Duration(d) * time.Second
+			assumeRole.Duration = time.Duration(d) * time.Second
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleExternalID); v != "" {
-			conf.AssumeRole.ExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleSessionName); v != "" {
-			conf.AssumeRole.SessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRole = []*awsbase.AssumeRole{assumeRole}
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -104,14 +113,42 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestratorWithContext deletes the specified resources, with bounded parallelism.
+// Only resources matching any sweeper filter criteria set in environment variables are deleted.
+// In dry-run mode the resources are only recorded.
 func SweepOrchestratorWithContext(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	sweepables, err := filterSweepables(ctx, sweepables)
+
+	if err != nil {
+		return fmt.Errorf("filtering resources: %w", err)
+	}
+
+	if dryRun() {
+		for _, sweepable := range sweepables {
+			recordDryRunSweepable(ctx, sweepable)
+		}
+
+		return nil
+	}
+
 	var g multierror.Group
+	semaphore := tfsync.InitializeSemaphore(envvar.SweepParallelism, defaultSweepParallelism)
+	if cap(semaphore) == 0 {
+		semaphore = make(tfsync.Semaphore, 1)
+	}
 
 	for _, sweepable := range sweepables {
 		sweepable := sweepable
 
+		if err := semaphore.Wait(ctx); err != nil {
+			g.Go(func() error { return err })
+			break
+		}
 		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			defer semaphore.Notify()
+
+			// The sweeper client is read-only when sweeper filters are set.
+			return sweepable.Delete(conns.WithReadOnlyOverride(ctx), ThrottlingRetryTimeout, optFns...)
 		})
 	}
 
@@ -0,0 +1,342 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+const (
+	defaultSweepParallelism = 10
+
+	// Sweepers failing with dependency violation errors are retried after the other sweepers in their layer have run.
+	dependencyViolationRetries = 3
+	dependencyViolationDelay   = 30 * time.Second
+)
+
+// dependencyViolationErrorCodes are the AWS API error codes returned when deleting a resource that is still in use.
+var dependencyViolationErrorCodes = []string{
+	"DependencyViolation",
+	"ResourceInUse",
+	"ResourceInUseException",
+}
+
+// sweepers are all the registered sweepers, keyed by name.
+var sweepers = make(map[string]*resource.Sweeper)
+
+// AddTestSweepers registers a sweeper.
+// Sweepers are run in dependency order by TestMain.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	if _, ok := sweepers[name]; ok {
+		log.Fatalf("[ERR] Error adding (%s) to sweepers: sweeper already exists", name)
+	}
+
+	sweepers[name] = s
+	resource.AddTestSweepers(name, s)
+}
+
+// TestMain adds dependency-ordered sweeper functionality to the "go test" command, otherwise tests are executed as normal.
+// The Terraform Plugin Testing framework's sweeper flags (-sweep, -sweep-allow-failures and -sweep-run) are honored.
+//
+// A dependency graph of all registered sweepers is built and sweepers are run a layer at a time in dependency order,
+// with the sweepers in each layer run concurrently.
+func TestMain(m *testing.M) {
+	flag.Parse()
+
+	if v := flagValue("sweep"); v != "" {
+		regions := strings.Split(v, ",")
+		allowFailures := flagValue("sweep-allow-failures") == "true"
+
+		if err := runSweepers(regions, flagValue("sweep-run"), allowFailures); err != nil {
+			log.Printf("[ERROR] %s", err)
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	}
+
+	os.Exit(m.Run())
+}
+
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+// sweeperGraph returns the dependency graph of all registered sweepers.
+func sweeperGraph() *depgraph.Graph {
+	g := depgraph.New()
+
+	for name := range sweepers {
+		g.AddNode(name)
+	}
+
+	for name, s := range sweepers {
+		for _, dependency := range s.Dependencies {
+			if err := g.AddDependency(name, dependency); err != nil {
+				log.Printf("[WARN] Sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+			}
+		}
+	}
+
+	return g
+}
+
+// filterSweepers returns the names of the sweepers matching the comma-separated run filter, and all their dependencies.
+// An empty run filter matches all sweepers.
+func filterSweepers(g *depgraph.Graph, runFilter string) (map[string]struct{}, error) {
+	names := make(map[string]struct{})
+	filters := strings.Split(strings.ToLower(runFilter), ",")
+
+	for name := range sweepers {
+		for _, f := range filters {
+			if !strings.Contains(strings.ToLower(name), f) {
+				continue
+			}
+
+			names[name] = struct{}{}
+
+			dependencies, err := g.DependenciesOf(name)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, dependency := range dependencies {
+				names[dependency] = struct{}{}
+			}
+		}
+	}
+
+	return names, nil
+}
+
+// runSweepers runs the filtered sweepers in each region, a dependency layer at a time.
+func runSweepers(regions []string, runFilter string, allowFailures bool) error {
+	if _, err := filter.FromEnv(); err != nil {
+		return fmt.Errorf("sweeper filters: %w", err)
+	}
+
+	g := sweeperGraph()
+
+	layers, err := g.Layers()
+	if err != nil {
+		return fmt.Errorf("ordering sweepers: %w", err)
+	}
+
+	names, err := filterSweepers(g, runFilter)
+	if err != nil {
+		return fmt.Errorf("ordering sweepers: %w", err)
+	}
+
+	var sweeperErrorFound bool
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+		results := make(map[string]error)
+
+		start := time.Now()
+		log.Printf("[DEBUG] Running Sweepers for region (%s):\n", region)
+
+		for i, layer := range layers {
+			layer = tfslices.Filter(layer, func(name string) bool {
+				_, ok := names[name]
+				return ok
+			})
+
+			if len(layer) == 0 {
+				continue
+			}
+
+			log.Printf("[DEBUG] Running Sweeper layer %d of %d in region (%s): %s", i+1, len(layers), region, strings.Join(layer, ", "))
+
+			layerErrorFound := false
+			for name, err := range runSweeperLayer(region, layer) {
+				results[name] = err
+
+				if err != nil {
+					layerErrorFound = true
+				}
+			}
+
+			// Later layers depend on this layer.
+			if layerErrorFound && !allowFailures {
+				break
+			}
+		}
+
+		log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+		logSweeperResults(region, results)
+
+		if dryRun() {
+			logDryRunReport(region)
+		}
+		logSkippedReport(region)
+
+		for _, err := range results {
+			if err != nil {
+				sweeperErrorFound = true
+			}
+		}
+
+		if sweeperErrorFound && !allowFailures {
+			return fmt.Errorf("sweepers for region (%s) failed", region)
+		}
+	}
+
+	if sweeperErrorFound {
+		return errors.New("at least one sweeper failed")
+	}
+
+	return nil
+}
+
+// runSweeperLayer runs the specified sweepers concurrently in a region.
+// Sweepers failing with a dependency violation error are retried once the other sweepers in the layer have run.
+// In dry-run mode sweepers are run sequentially.
+func runSweeperLayer(region string, names []string) map[string]error {
+	var lock sync.Mutex
+	results := make(map[string]error, len(names))
+
+	semaphore := tfsync.InitializeSemaphore(envvar.SweepParallelism, defaultSweepParallelism)
+	if dryRun() || cap(semaphore) == 0 {
+		semaphore = make(tfsync.Semaphore, 1)
+	}
+
+	pending := names
+	for attempt := 0; len(pending) > 0; attempt++ {
+		if attempt > 0 {
+			log.Printf("[DEBUG] Retrying Sweepers with dependency violations in region (%s) in %s: %s", region, dependencyViolationDelay, strings.Join(pending, ", "))
+			time.Sleep(dependencyViolationDelay)
+		}
+
+		var wg sync.WaitGroup
+
+		for _, name := range pending {
+			name := name
+			s := sweepers[name]
+
+			// Sweepers are not cancellable, so waiting cannot fail.
+			_ = semaphore.Wait(context.Background())
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+				defer semaphore.Notify()
+
+				err := runSweeper(region, s)
+
+				lock.Lock()
+				results[name] = err
+				lock.Unlock()
+			}()
+		}
+
+		wg.Wait()
+
+		if attempt == dependencyViolationRetries {
+			break
+		}
+
+		pending = tfslices.Filter(pending, func(name string) bool {
+			return isDependencyViolation(results[name])
+		})
+	}
+
+	return results
+}
+
+func runSweeper(region string, s *resource.Sweeper) error {
+	log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", s.Name, region)
+
+	if dryRun() {
+		setDryRunSweeper(region, s.Name)
+	}
+
+	start := time.Now()
+	err := s.F(region)
+
+	log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", s.Name, region, time.Since(start))
+
+	// In dry-run mode, and when sweeper filters are set, the sweeper client is read-only.
+	if readOnlyErr := (*conns.ReadOnlyError)(nil); errors.As(err, &readOnlyErr) {
+		switch {
+		case dryRun():
+			recordDryRun(region, s.Name, fmt.Sprintf("(not supported in dry-run mode: %s)", readOnlyErr))
+			return nil
+		case filtered():
+			recordSkippedSweeper(region, s.Name, readOnlyErr)
+			return nil
+		}
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", s.Name, region, err)
+	}
+
+	return err
+}
+
+// isDependencyViolation returns whether the specified sweeper error was caused by a resource still being in use.
+// Errors returned by sweepable resources are flattened diagnostics, so error codes are matched in the error message.
+func isDependencyViolation(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	for _, code := range dependencyViolationErrorCodes {
+		if strings.Contains(err.Error(), code) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func logSweeperResults(region string, results map[string]error) {
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs *multierror.Error
+
+	log.Printf("Sweeper Tests for region (%s) ran successfully:\n", region)
+	for _, name := range names {
+		if err := results[name]; err == nil {
+			log.Printf("\t- %s\n", name)
+		} else {
+			errs = multierror.Append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+
+	if errs != nil {
+		log.Printf("Sweeper Tests for region (%s) ran unsuccessfully:\n", region)
+		for _, err := range errs.Errors {
+			log.Printf("\t- %s\n", err)
+		}
+	}
+}
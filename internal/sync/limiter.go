@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limiter limits the rate or concurrency of operations.
+type Limiter interface {
+	// Wait blocks until an operation is permitted or the Context is done.
+	Wait(ctx context.Context) error
+}
+
+// TokenBucket is a Limiter that permits operations at a steady rate, with bursts of up to the bucket size.
+type TokenBucket struct {
+	lock   sync.Mutex
+	rate   float64 // Tokens per second.
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+var _ Limiter = &TokenBucket{}
+
+// NewTokenBucket returns a new, full, token bucket that permits rate operations per second with bursts of up to burst operations.
+// A burst of less than 1 is treated as 1.
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	return newTokenBucket(rate, burst, time.Now)
+}
+
+func newTokenBucket(rate float64, burst int, now func() time.Time) *TokenBucket {
+	b := math.Max(float64(burst), 1)
+
+	return &TokenBucket{
+		rate:   rate,
+		burst:  b,
+		tokens: b,
+		last:   now(),
+		now:    now,
+	}
+}
+
+// Rate returns the bucket's rate, in operations per second.
+func (tb *TokenBucket) Rate() float64 {
+	tb.lock.Lock()
+	defer tb.lock.Unlock()
+
+	return tb.rate
+}
+
+// SetRate changes the bucket's rate, in operations per second.
+func (tb *TokenBucket) SetRate(rate float64) {
+	tb.lock.Lock()
+	defer tb.lock.Unlock()
+
+	tb.refill()
+	tb.rate = rate
+}
+
+// Wait blocks until a token is available or the Context is done.
+func (tb *TokenBucket) Wait(ctx context.Context) error {
+	for {
+		delay := tb.reserve()
+		if delay == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token, returning 0, or returns how long to wait for a token to become available.
+func (tb *TokenBucket) reserve() time.Duration {
+	tb.lock.Lock()
+	defer tb.lock.Unlock()
+
+	tb.refill()
+
+	if tb.tokens >= 1 {
+		tb.tokens--
+		return 0
+	}
+
+	if tb.rate <= 0 {
+		return time.Second
+	}
+
+	return time.Duration((1 - tb.tokens) / tb.rate * float64(time.Second))
+}
+
+func (tb *TokenBucket) refill() {
+	now := tb.now()
+	tb.tokens = math.Min(tb.burst, tb.tokens+now.Sub(tb.last).Seconds()*tb.rate)
+	tb.last = now
+}
+
+const (
+	adaptiveMinRate        = 1.0 // Operations per second.
+	adaptiveBackoffFactor  = 0.5
+	adaptiveRecoveryFactor = 2.0 // Multiple of the backed off rate at which the limiter is again unlimited.
+)
+
+// AdaptiveLimiter is a Limiter that is unlimited until throttling is observed.
+// On throttling its rate is reduced multiplicatively and while operations then succeed its rate is increased additively,
+// until it has recovered and is again unlimited.
+type AdaptiveLimiter struct {
+	lock        sync.Mutex
+	bucket      *TokenBucket // nil when unlimited.
+	ceiling     float64      // Rate at which the limiter is again unlimited.
+	lastBackoff time.Time
+	windowStart time.Time // Measured operation rate.
+	windowCount int
+	measured    float64
+	now         func() time.Time
+}
+
+var _ Limiter = &AdaptiveLimiter{}
+
+// NewAdaptiveLimiter returns a new, unlimited, adaptive limiter.
+func NewAdaptiveLimiter() *AdaptiveLimiter {
+	return newAdaptiveLimiter(time.Now)
+}
+
+func newAdaptiveLimiter(now func() time.Time) *AdaptiveLimiter {
+	return &AdaptiveLimiter{
+		windowStart: now(),
+		now:         now,
+	}
+}
+
+// Rate returns the limiter's current rate, in operations per second, or +Inf if unlimited.
+func (l *AdaptiveLimiter) Rate() float64 {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.bucket == nil {
+		return math.Inf(1)
+	}
+
+	return l.bucket.Rate()
+}
+
+// Wait blocks until an operation is permitted or the Context is done.
+func (l *AdaptiveLimiter) Wait(ctx context.Context) error {
+	l.lock.Lock()
+	l.measure()
+	bucket := l.bucket
+	l.lock.Unlock()
+
+	if bucket == nil {
+		return nil
+	}
+
+	return bucket.Wait(ctx)
+}
+
+// Throttled records that an operation was throttled, reducing the limiter's rate.
+func (l *AdaptiveLimiter) Throttled() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+
+	if l.bucket == nil {
+		rate := math.Max(adaptiveMinRate, l.measured*adaptiveBackoffFactor)
+		l.bucket = newTokenBucket(rate, 1, l.now)
+		l.ceiling = rate * adaptiveRecoveryFactor
+		l.lastBackoff = now
+		return
+	}
+
+	// Concurrent operations throttled at the same time only reduce the rate once.
+	if now.Sub(l.lastBackoff) < time.Second {
+		return
+	}
+	l.lastBackoff = now
+
+	l.bucket.SetRate(math.Max(adaptiveMinRate, l.bucket.Rate()*adaptiveBackoffFactor))
+}
+
+// Succeeded records that an operation succeeded, increasing any reduced rate.
+func (l *AdaptiveLimiter) Succeeded() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.bucket == nil {
+		return
+	}
+
+	// Increase the rate by 1 operation per second for each second's worth of successful operations.
+	rate := l.bucket.Rate()
+	rate += 1 / rate
+	if rate >= l.ceiling {
+		l.bucket = nil
+		return
+	}
+
+	l.bucket.SetRate(rate)
+}
+
+// measure updates the measured operation rate, over 1 second windows.
+func (l *AdaptiveLimiter) measure() {
+	now := l.now()
+	if elapsed := now.Sub(l.windowStart); elapsed >= time.Second {
+		l.measured = float64(l.windowCount) / elapsed.Seconds()
+		l.windowStart = now
+		l.windowCount = 0
+	}
+	l.windowCount++
+}
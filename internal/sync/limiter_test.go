@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestTokenBucket(t *testing.T) {
+	t.Parallel()
+
+	clock := &testClock{now: time.Now()}
+	tb := newTokenBucket(2, 3, clock.Now)
+
+	// Full bucket permits a burst.
+	for i := 0; i < 3; i++ {
+		if got := tb.reserve(); got != 0 {
+			t.Fatalf("reserve %d: got %s, expected 0", i, got)
+		}
+	}
+
+	if got, want := tb.reserve(), 500*time.Millisecond; got != want {
+		t.Errorf("empty bucket: got %s, expected %s", got, want)
+	}
+
+	clock.Advance(500 * time.Millisecond)
+	if got := tb.reserve(); got != 0 {
+		t.Errorf("refilled bucket: got %s, expected 0", got)
+	}
+
+	// Bucket is never filled above its burst size.
+	clock.Advance(time.Hour)
+	for i := 0; i < 3; i++ {
+		if got := tb.reserve(); got != 0 {
+			t.Fatalf("reserve %d: got %s, expected 0", i, got)
+		}
+	}
+	if got := tb.reserve(); got == 0 {
+		t.Errorf("burst: got %s, expected non-zero", got)
+	}
+}
+
+func TestTokenBucketWaitContextDone(t *testing.T) {
+	t.Parallel()
+
+	tb := NewTokenBucket(0.001, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if err := tb.Wait(ctx); err != nil {
+		t.Fatalf("Wait: %s", err)
+	}
+
+	cancel()
+
+	if err := tb.Wait(ctx); err == nil {
+		t.Error("Wait: expected error")
+	}
+}
+
+func TestAdaptiveLimiter(t *testing.T) {
+	t.Parallel()
+
+	clock := &testClock{now: time.Now()}
+	l := newAdaptiveLimiter(clock.Now)
+	ctx := context.Background()
+
+	if got := l.Rate(); !math.IsInf(got, 1) {
+		t.Fatalf("initial rate: got %f, expected +Inf", got)
+	}
+
+	// 20 operations per second.
+	for i := 0; i < 21; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("Wait: %s", err)
+		}
+		clock.Advance(50 * time.Millisecond)
+	}
+
+	l.Throttled()
+
+	if got, want := l.Rate(), 10.0; got != want {
+		t.Fatalf("throttled rate: got %f, expected %f", got, want)
+	}
+
+	// Concurrent throttling only reduces the rate once.
+	l.Throttled()
+
+	if got, want := l.Rate(), 10.0; got != want {
+		t.Fatalf("concurrently throttled rate: got %f, expected %f", got, want)
+	}
+
+	clock.Advance(time.Second)
+	l.Throttled()
+
+	if got, want := l.Rate(), 5.0; got != want {
+		t.Fatalf("throttled again rate: got %f, expected %f", got, want)
+	}
+
+	for i := 0; i < 1000 && !math.IsInf(l.Rate(), 1); i++ {
+		l.Succeeded()
+	}
+
+	if got := l.Rate(); !math.IsInf(got, 1) {
+		t.Errorf("recovered rate: got %f, expected +Inf", got)
+	}
+}
+
+func TestSemaphoreWaitContextDone(t *testing.T) {
+	t.Parallel()
+
+	var l Limiter = make(Semaphore, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if err := l.Wait(ctx); err != nil {
+		t.Fatalf("Wait: %s", err)
+	}
+
+	cancel()
+
+	if err := l.Wait(ctx); err == nil {
+		t.Error("Wait: expected error")
+	}
+
+	l.(Semaphore).Notify()
+
+	if err := l.Wait(context.Background()); err != nil {
+		t.Errorf("Wait after Notify: %s", err)
+	}
+}
@@ -4,6 +4,7 @@
 package sync
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -11,11 +12,14 @@ import (
 	"testing"
 )
 
-// Semaphore can be used to limit concurrent executions. This can be used to work with resources with low quotas
+// Semaphore is a Limiter of concurrent executions. This can be used to work with resources with low quotas.
+// Each successful Wait must be paired with a Notify once the execution completes.
+// See also TokenBucket and AdaptiveLimiter, which limit the rate of executions.
 type Semaphore chan struct{}
 
+var _ Limiter = Semaphore(nil)
+
 // InitializeSemaphore initializes a semaphore with a default capacity or overrides it using an environment variable
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func InitializeSemaphore(envvar string, defaultLimit int) Semaphore {
 	limit := defaultLimit
 	x := os.Getenv(envvar)
@@ -29,14 +33,17 @@ func InitializeSemaphore(envvar string, defaultLimit int) Semaphore {
 	return make(Semaphore, limit)
 }
 
-// Wait waits for a semaphore before continuing
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
-func (s Semaphore) Wait() {
-	s <- struct{}{}
+// Wait waits for a semaphore before continuing, or until the Context is done.
+func (s Semaphore) Wait(ctx context.Context) error {
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 // Notify releases a semaphore
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func (s Semaphore) Notify() {
 	// Make the Notify non-blocking. This can happen if a Wait was never issued
 	select {
@@ -47,11 +54,12 @@ func (s Semaphore) Notify() {
 }
 
 // TestAccPreCheckSyncronized waits for a semaphore and skips the test if there is no capacity
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func TestAccPreCheckSyncronize(t *testing.T, semaphore Semaphore, resource string) {
 	if cap(semaphore) == 0 {
 		t.Skipf("concurrency for %s testing set to 0", resource)
 	}
 
-	semaphore.Wait()
+	if err := semaphore.Wait(context.Background()); err != nil {
+		t.Fatalf("waiting for %s testing concurrency: %s", resource, err)
+	}
 }
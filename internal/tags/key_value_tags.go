@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"sort"
@@ -33,6 +34,32 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// Rules contains tags to default across matching resources, in increasing priority order.
+	Rules []DefaultRule
+}
+
+// DefaultRule contains tags to default across resources matching any of the resource type globs or service package names.
+type DefaultRule struct {
+	ResourceTypes []string // Glob patterns, e.g. "aws_ec2_*".
+	Services      []string // Service package names, e.g. "ec2".
+	Tags          KeyValueTags
+}
+
+// matches returns whether the rule applies to the specified resource type in the specified service package.
+func (r DefaultRule) matches(servicePackageName, typeName string) bool {
+	for _, v := range r.Services {
+		if v == servicePackageName {
+			return true
+		}
+	}
+
+	for _, v := range r.ResourceTypes {
+		if ok, _ := filepath.Match(v, typeName); ok {
+			return true
+		}
+	}
+
+	return false
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -56,6 +83,26 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// ForResource returns the default tags configuration for the specified resource type in the specified service package.
+// The tags of all matching rules are merged, in priority order, onto the tags defaulted across all resources.
+// The returned configuration has no rules.
+func (dc *DefaultConfig) ForResource(servicePackageName, typeName string) *DefaultConfig {
+	if dc == nil || len(dc.Rules) == 0 {
+		return dc
+	}
+
+	tags := dc.Tags
+	for _, rule := range dc.Rules {
+		if rule.matches(servicePackageName, typeName) {
+			tags = tags.Merge(rule.Tags)
+		}
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
@@ -57,6 +57,105 @@ func TestKeyValueTagsDefaultConfigGetTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"Owner": "platform",
+		}),
+		Rules: []DefaultRule{
+			{
+				Services: []string{"ec2"},
+				Tags: New(ctx, map[string]string{
+					"CostCenter": "100",
+				}),
+			},
+			{
+				ResourceTypes: []string{"aws_ec2_*"},
+				Tags: New(ctx, map[string]string{
+					"CostCenter": "200",
+					"Owner":      "network",
+				}),
+			},
+			{
+				ResourceTypes: []string{"aws_iam_role"},
+				Services:      []string{"s3"},
+				Tags: New(ctx, map[string]string{
+					"Owner": "security",
+				}),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		defaultConfig      *DefaultConfig
+		servicePackageName string
+		typeName           string
+		want               KeyValueTags
+	}{
+		{
+			name:               "nil config",
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+		},
+		{
+			name:               "no matching rules",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "lambda",
+			typeName:           "aws_lambda_function",
+			want: New(ctx, map[string]string{
+				"Owner": "platform",
+			}),
+		},
+		{
+			name:               "service rule",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+			want: New(ctx, map[string]string{
+				"CostCenter": "100",
+				"Owner":      "platform",
+			}),
+		},
+		{
+			name:               "later rules take precedence",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "ec2",
+			typeName:           "aws_ec2_host",
+			want: New(ctx, map[string]string{
+				"CostCenter": "200",
+				"Owner":      "network",
+			}),
+		},
+		{
+			name:               "resource type or service",
+			defaultConfig:      defaultConfig,
+			servicePackageName: "iam",
+			typeName:           "aws_iam_role",
+			want: New(ctx, map[string]string{
+				"Owner": "security",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResource(testCase.servicePackageName, testCase.typeName)
+
+			if got != nil && len(got.Rules) != 0 {
+				t.Errorf("got %d rules, expected none", len(got.Rules))
+			}
+			testKeyValueTagsVerifyMap(t, got.GetTags().Map(), testCase.want.Map())
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TagPolicyCompliance is the action taken when a resource's tags do not comply with the effective tag policy.
+type TagPolicyCompliance string
+
+const (
+	TagPolicyComplianceDisabled TagPolicyCompliance = "disabled"
+	TagPolicyComplianceError    TagPolicyCompliance = "error"
+	TagPolicyComplianceWarning  TagPolicyCompliance = "warning"
+)
+
+func (TagPolicyCompliance) Values() []TagPolicyCompliance {
+	return []TagPolicyCompliance{
+		TagPolicyComplianceDisabled,
+		TagPolicyComplianceError,
+		TagPolicyComplianceWarning,
+	}
+}
+
+const (
+	tagPolicyAllSupported = "ALL_SUPPORTED"
+	tagPolicyWildcard     = "*"
+)
+
+// TagPolicy is an AWS Organizations effective tag policy.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_tag-policies-syntax-reference.html.
+type TagPolicy struct {
+	rules []tagPolicyRule
+}
+
+// tagPolicyRule is the policy for a single tag key.
+type tagPolicyRule struct {
+	key         string   // Required capitalization of the tag key.
+	values      []string // Allowed values, if any. A trailing "*" matches any suffix.
+	requiredFor []string // Resource types, as "service:type", that must have the tag.
+}
+
+// ParseTagPolicy parses the content of an effective tag policy.
+func ParseTagPolicy(content string) (*TagPolicy, error) {
+	var v struct {
+		Tags map[string]struct {
+			TagKey               tagPolicyValue[string]   `json:"tag_key"`
+			TagValue             tagPolicyValue[[]string] `json:"tag_value"`
+			ReportRequiredTagFor tagPolicyValue[[]string] `json:"report_required_tag_for"`
+		} `json:"tags"`
+	}
+
+	if err := json.Unmarshal([]byte(content), &v); err != nil {
+		return nil, fmt.Errorf("parsing tag policy: %w", err)
+	}
+
+	policy := &TagPolicy{}
+
+	for k, tag := range v.Tags {
+		rule := tagPolicyRule{
+			key:         tag.TagKey.value,
+			values:      tag.TagValue.value,
+			requiredFor: tag.ReportRequiredTagFor.value,
+		}
+		if rule.key == "" {
+			rule.key = k
+		}
+
+		policy.rules = append(policy.rules, rule)
+	}
+
+	sort.Slice(policy.rules, func(i, j int) bool {
+		return strings.ToLower(policy.rules[i].key) < strings.ToLower(policy.rules[j].key)
+	})
+
+	return policy, nil
+}
+
+// Violations returns a description of each way that the specified tags do not comply with the tag policy
+// for the specified resource type, as "service:type".
+func (p *TagPolicy) Violations(tags KeyValueTags, resourceType string) []string {
+	if p == nil {
+		return nil
+	}
+
+	var violations []string
+
+	for _, rule := range p.rules {
+		var key string
+		for k := range tags {
+			if strings.EqualFold(k, rule.key) {
+				key = k
+				break
+			}
+		}
+
+		if key == "" {
+			if rule.isRequiredFor(resourceType) {
+				violations = append(violations, fmt.Sprintf("required tag key %q is missing", rule.key))
+			}
+
+			continue
+		}
+
+		if key != rule.key {
+			violations = append(violations, fmt.Sprintf("tag key %q must be capitalized as %q", key, rule.key))
+		}
+
+		if value := tags.KeyValue(key); value != nil && !rule.isAllowedValue(*value) {
+			violations = append(violations, fmt.Sprintf("tag %q value %q is not one of the allowed values: %s", key, *value, strings.Join(rule.values, ", ")))
+		}
+	}
+
+	return violations
+}
+
+func (r tagPolicyRule) isRequiredFor(resourceType string) bool {
+	service, _, _ := strings.Cut(resourceType, ":")
+
+	for _, v := range r.requiredFor {
+		if strings.EqualFold(v, resourceType) {
+			return true
+		}
+
+		if s, t, ok := strings.Cut(v, ":"); ok && strings.EqualFold(s, service) && (t == tagPolicyAllSupported || t == tagPolicyWildcard) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r tagPolicyRule) isAllowedValue(value string) bool {
+	if len(r.values) == 0 {
+		return true
+	}
+
+	for _, v := range r.values {
+		if prefix, ok := strings.CutSuffix(v, tagPolicyWildcard); ok {
+			if strings.HasPrefix(value, prefix) {
+				return true
+			}
+		} else if value == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TagPolicyResourceType returns the tag policy resource type, as "service:type", for the specified service package and resource name,
+// e.g. "ec2" and "Security Group" returns "ec2:security-group".
+func TagPolicyResourceType(servicePackageName, resourceName string) string {
+	return servicePackageName + ":" + strings.ToLower(strings.ReplaceAll(resourceName, " ", "-"))
+}
+
+// tagPolicyValue is a tag policy value, either as it appears in an effective policy
+// or as it appears in a policy document, using the "@@assign" value-setting operator.
+type tagPolicyValue[T any] struct {
+	value T
+}
+
+func (v *tagPolicyValue[T]) UnmarshalJSON(b []byte) error {
+	var assign struct {
+		Assign *T `json:"@@assign"`
+	}
+
+	if err := json.Unmarshal(b, &assign); err == nil && assign.Assign != nil {
+		v.value = *assign.Assign
+		return nil
+	}
+
+	return json.Unmarshal(b, &v.value)
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTagPolicyViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	// Effective policy syntax.
+	policy, err := ParseTagPolicy(`{
+  "tags": {
+    "costcenter": {
+      "tag_key": "CostCenter",
+      "tag_value": ["100", "200", "300*"],
+      "report_required_tag_for": ["ec2:instance", "s3:ALL_SUPPORTED"]
+    },
+    "owner": {
+      "tag_key": "Owner"
+    }
+  }
+}`)
+	if err != nil {
+		t.Fatalf("parsing tag policy: %s", err)
+	}
+
+	testCases := []struct {
+		name         string
+		tags         KeyValueTags
+		resourceType string
+		expected     []string
+	}{
+		{
+			name:         "compliant",
+			tags:         New(ctx, map[string]string{"CostCenter": "100", "Owner": "platform"}),
+			resourceType: "ec2:instance",
+		},
+		{
+			name:         "wildcard value",
+			tags:         New(ctx, map[string]string{"CostCenter": "3001"}),
+			resourceType: "ec2:instance",
+		},
+		{
+			name:         "not required",
+			tags:         New(ctx, map[string]string{"Name": "test"}),
+			resourceType: "ec2:vpc",
+		},
+		{
+			name:         "missing required key",
+			tags:         New(ctx, map[string]string{"Name": "test"}),
+			resourceType: "ec2:instance",
+			expected:     []string{`required tag key "CostCenter" is missing`},
+		},
+		{
+			name:         "missing required key all supported",
+			tags:         New(ctx, map[string]string{}),
+			resourceType: "s3:bucket",
+			expected:     []string{`required tag key "CostCenter" is missing`},
+		},
+		{
+			name:         "disallowed value",
+			tags:         New(ctx, map[string]string{"CostCenter": "400"}),
+			resourceType: "ec2:vpc",
+			expected:     []string{`tag "CostCenter" value "400" is not one of the allowed values: 100, 200, 300*`},
+		},
+		{
+			name:         "key capitalization",
+			tags:         New(ctx, map[string]string{"CostCenter": "100", "owner": "platform"}),
+			resourceType: "ec2:instance",
+			expected:     []string{`tag key "owner" must be capitalized as "Owner"`},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := policy.Violations(testCase.tags, testCase.resourceType)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestParseTagPolicyAssignOperator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	// Policy document syntax.
+	policy, err := ParseTagPolicy(`{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100"]},
+      "report_required_tag_for": {"@@assign": ["ec2:*"]}
+    }
+  }
+}`)
+	if err != nil {
+		t.Fatalf("parsing tag policy: %s", err)
+	}
+
+	got := policy.Violations(New(ctx, map[string]string{"costcenter": "100"}), "ec2:volume")
+	expected := []string{`tag key "costcenter" must be capitalized as "CostCenter"`}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if got := (*TagPolicy)(nil).Violations(New(ctx, map[string]string{}), "ec2:volume"); got != nil {
+		t.Errorf("nil policy: got %v, expected nil", got)
+	}
+}
+
+func TestTagPolicyResourceType(t *testing.T) {
+	t.Parallel()
+
+	if got, want := TagPolicyResourceType("ec2", "Security Group"), "ec2:security-group"; got != want {
+		t.Errorf("got %q, expected %q", got, want)
+	}
+}
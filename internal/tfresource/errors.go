@@ -6,7 +6,7 @@ package tfresource
 import (
 	"errors"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 )
 
 // NotFound returns true if the error represents a "resource not found" condition.
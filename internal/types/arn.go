@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+var (
+	arnAccountIDRegexp = regexp.MustCompile(`^(aws|aws-managed|third-party|\d{12}|cw.{10})$`)
+	arnPartitionRegexp = regexp.MustCompile(`^aws(-[a-z]+)*$`)
+	arnRegionRegexp    = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+)
+
+// ParseARN parses and validates the specified ARN:
+// - The ARN has the expected number of sections
+// - The partition is present and well-formed
+// - Any Region and account ID are well-formed
+// - The resource is present
+func ParseARN(s string) (arn.ARN, error) {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return arn.ARN{}, fmt.Errorf("%q is not a valid ARN: %w", s, err)
+	}
+
+	var errs []error
+
+	if v.Partition == "" {
+		errs = append(errs, errors.New("missing partition value"))
+	} else if !arnPartitionRegexp.MatchString(v.Partition) {
+		errs = append(errs, fmt.Errorf("invalid partition value (expecting to match regular expression: %s)", arnPartitionRegexp))
+	}
+
+	if v.Region != "" && !arnRegionRegexp.MatchString(v.Region) {
+		errs = append(errs, fmt.Errorf("invalid region value (expecting to match regular expression: %s)", arnRegionRegexp))
+	}
+
+	if v.AccountID != "" && !arnAccountIDRegexp.MatchString(v.AccountID) {
+		errs = append(errs, fmt.Errorf("invalid account ID value (expecting to match regular expression: %s)", arnAccountIDRegexp))
+	}
+
+	if v.Resource == "" {
+		errs = append(errs, errors.New("missing resource value"))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return arn.ARN{}, fmt.Errorf("%q is not a valid ARN: %w", s, err)
+	}
+
+	return v, nil
+}
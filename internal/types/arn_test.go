@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import "testing"
+
+func TestParseARN(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		arn   string
+		valid bool
+	}{
+		{"arn:aws:iam::444455556666:role/example", true},   //lintignore:AWSAT005
+		{"arn:aws:sqs:us-west-2:444455556666:queue", true}, //lintignore:AWSAT003,AWSAT005
+		{"arn:aws:iam::aws:policy/ReadOnlyAccess", true},   //lintignore:AWSAT005
+		{"arn:aws-us-gov:s3:::bucket", true},               //lintignore:AWSAT005
+		{"arn:aws:iam::4444:role/example", false},          //lintignore:AWSAT005
+		{"arn::iam::444455556666:role/example", false},     //lintignore:AWSAT005
+		{"arn:aws:sqs:us-west:444455556666:queue", false},  //lintignore:AWSAT005
+		{"arn:aws:iam::444455556666:", false},              //lintignore:AWSAT005
+		{"arn:aws:iam", false},                             //lintignore:AWSAT005
+		{"not-an-arn", false},
+		{"", false},
+	} {
+		_, err := ParseARN(ts.arn)
+		if !ts.valid && err == nil {
+			t.Fatalf("Input '%s' should error but didn't!", ts.arn)
+		}
+		if ts.valid && err != nil {
+			t.Fatalf("Got unexpected error for '%s' input: %s", ts.arn, err)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"mime"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// idempotencyTokenNames are the (lowercased) names of request members whose values are generated uniquely for each request.
+var idempotencyTokenNames = map[string]struct{}{
+	"callerreference":    {},
+	"clientrequesttoken": {},
+	"clienttoken":        {},
+	"idempotencytoken":   {},
+}
+
+func isIdempotencyToken(name string) bool {
+	_, ok := idempotencyTokenNames[strings.ToLower(name)]
+	return ok
+}
+
+// BodyMatches returns whether an AWS API request body matches a recorded request body.
+// JSON, query string and XML bodies are normalized before comparison so that member ordering
+// and idempotency token values are ignored.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+func BodyMatches(contentType, body, recordedBody string) bool {
+	if body == recordedBody {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	var normalize func(string) (any, error)
+
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		normalize = normalizeJSON
+	case "application/x-www-form-urlencoded":
+		normalize = normalizeQuery
+	case "application/xml", "text/xml":
+		normalize = normalizeXML
+	default:
+		return false
+	}
+
+	v1, err := normalize(body)
+	if err != nil {
+		return false
+	}
+
+	v2, err := normalize(recordedBody)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(v1, v2)
+}
+
+func normalizeJSON(s string) (any, error) {
+	var v any
+
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, err
+	}
+
+	return removeJSONIdempotencyTokens(v), nil
+}
+
+func removeJSONIdempotencyTokens(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if isIdempotencyToken(k) {
+				delete(v, k)
+			} else {
+				v[k] = removeJSONIdempotencyTokens(e)
+			}
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = removeJSONIdempotencyTokens(e)
+		}
+	}
+
+	return v
+}
+
+func normalizeQuery(s string) (any, error) {
+	v, err := url.ParseQuery(s)
+	if err != nil {
+		return nil, err
+	}
+
+	for k := range v {
+		if isIdempotencyToken(k) {
+			delete(v, k)
+		}
+	}
+
+	return v, nil
+}
+
+// xmlNode is a normalized XML element.
+type xmlNode struct {
+	Name       string
+	Attributes []xml.Attr
+	Text       string
+	Children   []string // Serialized, sorted, normalized child elements.
+}
+
+func normalizeXML(s string) (any, error) {
+	decoder := xml.NewDecoder(strings.NewReader(s))
+
+	for {
+		token, err := decoder.Token()
+
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("no XML root element")
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if v, ok := token.(xml.StartElement); ok {
+			return decodeXMLNode(decoder, v)
+		}
+	}
+}
+
+func decodeXMLNode(decoder *xml.Decoder, start xml.StartElement) (*xmlNode, error) {
+	node := &xmlNode{
+		Name:       start.Name.Local,
+		Attributes: start.Attr,
+	}
+	sort.Slice(node.Attributes, func(i, j int) bool {
+		return node.Attributes[i].Name.Local < node.Attributes[j].Name.Local
+	})
+
+	var text strings.Builder
+
+	for {
+		token, err := decoder.Token()
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch v := token.(type) {
+		case xml.StartElement:
+			child, err := decodeXMLNode(decoder, v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if isIdempotencyToken(child.Name) {
+				continue
+			}
+
+			b, err := json.Marshal(child)
+
+			if err != nil {
+				return nil, err
+			}
+
+			node.Children = append(node.Children, string(b))
+		case xml.CharData:
+			text.Write(v)
+		case xml.EndElement:
+			node.Text = strings.TrimSpace(text.String())
+			sort.Strings(node.Children)
+
+			return node, nil
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"testing"
+)
+
+func TestBodyMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		contentType  string
+		body         string
+		recordedBody string
+		expected     bool
+	}{
+		{
+			name:         "identical",
+			contentType:  "application/octet-stream",
+			body:         "abc",
+			recordedBody: "abc",
+			expected:     true,
+		},
+		{
+			name:         "unsupported content type",
+			contentType:  "application/octet-stream",
+			body:         "abc",
+			recordedBody: "abd",
+		},
+		{
+			name:         "JSON reordered",
+			contentType:  "application/x-amz-json-1.1",
+			body:         `{"Name":"test","Tags":[{"Key":"k","Value":"v"}]}`,
+			recordedBody: `{"Tags":[{"Value":"v","Key":"k"}],"Name":"test"}`,
+			expected:     true,
+		},
+		{
+			name:         "JSON different",
+			contentType:  "application/json",
+			body:         `{"Name":"test1"}`,
+			recordedBody: `{"Name":"test2"}`,
+		},
+		{
+			name:         "JSON idempotency token",
+			contentType:  "application/x-amz-json-1.0",
+			body:         `{"Name":"test","ClientToken":"a"}`,
+			recordedBody: `{"clientToken":"b","Name":"test"}`,
+			expected:     true,
+		},
+		{
+			name:         "query reordered",
+			contentType:  "application/x-www-form-urlencoded; charset=utf-8",
+			body:         "Action=CreateVpc&CidrBlock=10.0.0.0%2F16&Version=2016-11-15",
+			recordedBody: "Action=CreateVpc&Version=2016-11-15&CidrBlock=10.0.0.0%2F16",
+			expected:     true,
+		},
+		{
+			name:         "query idempotency token",
+			contentType:  "application/x-www-form-urlencoded; charset=utf-8",
+			body:         "Action=RunInstances&ClientToken=a&Version=2016-11-15",
+			recordedBody: "Action=RunInstances&ClientToken=b&Version=2016-11-15",
+			expected:     true,
+		},
+		{
+			name:         "query different",
+			contentType:  "application/x-www-form-urlencoded",
+			body:         "Action=CreateVpc&CidrBlock=10.0.0.0%2F16",
+			recordedBody: "Action=CreateVpc&CidrBlock=10.1.0.0%2F16",
+		},
+		{
+			name:         "XML reordered",
+			contentType:  "application/xml",
+			body:         `<CreateHostedZoneRequest><Name>example.com</Name><HostedZoneConfig><Comment>c</Comment></HostedZoneConfig></CreateHostedZoneRequest>`,
+			recordedBody: `<CreateHostedZoneRequest><HostedZoneConfig><Comment>c</Comment></HostedZoneConfig><Name>example.com</Name></CreateHostedZoneRequest>`,
+			expected:     true,
+		},
+		{
+			name:         "XML idempotency token",
+			contentType:  "text/xml",
+			body:         `<CreateHostedZoneRequest><CallerReference>a</CallerReference><Name>example.com</Name></CreateHostedZoneRequest>`,
+			recordedBody: `<CreateHostedZoneRequest><Name>example.com</Name><CallerReference>b</CallerReference></CreateHostedZoneRequest>`,
+			expected:     true,
+		},
+		{
+			name:         "XML different",
+			contentType:  "application/xml",
+			body:         `<CreateHostedZoneRequest><Name>example.com</Name></CreateHostedZoneRequest>`,
+			recordedBody: `<CreateHostedZoneRequest><Name>example.org</Name></CreateHostedZoneRequest>`,
+		},
+		{
+			name:         "invalid JSON",
+			contentType:  "application/json",
+			body:         `{"Name":`,
+			recordedBody: `{"Name":"test"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := BodyMatches(testCase.contentType, testCase.body, testCase.recordedBody); got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
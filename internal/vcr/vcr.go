@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"os"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+const (
+	ModeRecording = "RECORDING"
+	ModeReplaying = "REPLAYING"
+)
+
+// IsEnabled returns whether AWS API interactions are being recorded or replayed.
+func IsEnabled() bool {
+	return os.Getenv(envvar.VCRMode) != "" && os.Getenv(envvar.VCRPath) != ""
+}
+
+// IsReplaying returns whether recorded AWS API interactions are being replayed.
+// When replaying there is no need to wait between AWS API calls.
+func IsReplaying() bool {
+	return IsEnabled() && os.Getenv(envvar.VCRMode) == ModeReplaying
+}
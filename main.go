@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -38,6 +39,10 @@ func main() {
 		serveOpts...,
 	)
 
+	if summary := conns.StopAPICallMetrics(); summary != "" {
+		log.Printf("[INFO] AWS API call summary:\n%s", summary)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
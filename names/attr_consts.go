@@ -4,12 +4,14 @@
 package names
 
 const (
+	AttrAccountID   = "account_id"
 	AttrARN         = "arn"
 	AttrDescription = "description"
 	AttrEnabled     = "enabled"
 	AttrID          = "id" // Should be explicitly declared only for Framework resources
 	AttrKMSKeyARN   = "kms_key_arn"
 	AttrName        = "name"
+	AttrRegion      = "region"
 	AttrTags        = "tags"
 	AttrTagsAll     = "tags_all"
 	AttrTimeouts    = "timeouts" // Should be explicitly declared only for Framework resources
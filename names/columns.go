@@ -27,5 +27,6 @@ const (
 	ColAllowedSubcategory      = 20
 	ColDeprecatedEnvVar        = 21
 	ColEnvVar                  = 22
-	ColNote                    = 23
+	ColSDKID                   = 23
+	ColNote                    = 24
 )
@@ -62,6 +62,12 @@ const (
 	XRayEndpointID                       = "xray"
 )
 
+// noteRetired is the names_data.csv Note of a service that AWS has retired.
+const noteRetired = "Retired"
+
+// AWSEndpointURLEnvVar is the environment variable used by the AWS SDKs and CLI to override the endpoint for all services.
+const AWSEndpointURLEnvVar = "AWS_ENDPOINT_URL"
+
 // Type ServiceDatum corresponds closely to columns in `names_data.csv` and are
 // described in detail in README.md.
 type ServiceDatum struct {
@@ -74,13 +80,22 @@ type ServiceDatum struct {
 	GoV2Package        string
 	HumanFriendly      string
 	ProviderNameUpper  string
+	SDKID              string
 }
 
 // serviceData key is the AWS provider service package
 var serviceData map[string]*ServiceDatum
 
+// serviceNames are all lowercase service names found in names_data.csv
+var serviceNames map[string]struct{}
+
+// retiredServices key is the AWS provider service package of an excluded service that AWS has retired, value is its human-friendly name
+var retiredServices map[string]string
+
 func init() {
 	serviceData = make(map[string]*ServiceDatum)
+	serviceNames = make(map[string]struct{})
+	retiredServices = make(map[string]string)
 
 	// Data from names_data.csv
 	if err := readCSVIntoServiceData(); err != nil {
@@ -107,7 +122,22 @@ func readCSVIntoServiceData() error {
 			continue
 		}
 
+		for _, col := range []int{ColAWSCLIV2Command, ColAWSCLIV2CommandNoDashes, ColGoV1Package, ColGoV2Package, ColProviderPackageActual, ColProviderPackageCorrect} {
+			if v := l[col]; v != "" {
+				serviceNames[strings.ToLower(v)] = struct{}{}
+			}
+		}
+		if l[ColAliases] != "" {
+			for _, v := range strings.Split(l[ColAliases], ";") {
+				serviceNames[strings.ToLower(v)] = struct{}{}
+			}
+		}
+
 		if l[ColExclude] != "" {
+			if l[ColNote] == noteRetired && l[ColProviderPackageCorrect] != "" {
+				retiredServices[l[ColProviderPackageCorrect]] = l[ColHumanFriendly]
+			}
+
 			continue
 		}
 
@@ -130,6 +160,7 @@ func readCSVIntoServiceData() error {
 			GoV2Package:        l[ColGoV2Package],
 			HumanFriendly:      l[ColHumanFriendly],
 			ProviderNameUpper:  l[ColProviderNameUpper],
+			SDKID:              l[ColSDKID],
 		}
 
 		a := []string{p}
@@ -178,6 +209,25 @@ func Aliases() []string {
 	return keys
 }
 
+// RetiredServices returns the AWS provider service packages of services that AWS has retired, mapped to their human-friendly names.
+// Their service endpoints are still accepted, but ignored, in provider configuration.
+func RetiredServices() map[string]string {
+	return retiredServices
+}
+
+// ServiceNames returns the lowercase service names found in names_data.csv, i.e. AWS CLI v2 commands,
+// AWS SDK for Go package names, provider package names and aliases, including those of excluded services.
+// It is used, for example, to recognize the service prefixes of IAM actions.
+func ServiceNames() []string {
+	names := make([]string, 0, len(serviceNames))
+
+	for k := range serviceNames {
+		names = append(names, k)
+	}
+
+	return names
+}
+
 func ProviderNameUpper(service string) (string, error) {
 	if v, ok := serviceData[service]; ok {
 		return v.ProviderNameUpper, nil
@@ -202,6 +252,17 @@ func EnvVar(service string) string {
 	return ""
 }
 
+// AWSServiceEnvVar returns the name of the service-specific AWS_ENDPOINT_URL_<SERVICE> environment variable
+// used by the AWS SDKs and CLI to override the endpoint for the specified service,
+// e.g. `AWS_ENDPOINT_URL_SECRETS_MANAGER`.
+func AWSServiceEnvVar(service string) string {
+	if v, ok := serviceData[service]; ok && v.SDKID != "" {
+		return AWSEndpointURLEnvVar + "_" + strings.ToUpper(strings.ReplaceAll(v.SDKID, " ", "_"))
+	}
+
+	return ""
+}
+
 func FullHumanFriendly(service string) (string, error) {
 	if v, ok := serviceData[service]; ok {
 		if v.Brand == "" {
@@ -140,7 +140,6 @@ func TestServicesForDirectories(t *testing.T) {
 		"lookoutmetrics",
 		"lookoutvision",
 		"machinelearning",
-		"macie",
 		"managedblockchain",
 		"marketplacecatalog",
 		"marketplacecommerceanalytics",
@@ -482,3 +481,66 @@ func TestAWSGoV1ClientName(t *testing.T) {
 		})
 	}
 }
+
+func TestAWSServiceEnvVar(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: "",
+		},
+		{
+			TestName: S3,
+			Input:    S3,
+			Expected: "AWS_ENDPOINT_URL_S3",
+		},
+		{
+			TestName: SecretsManager,
+			Input:    SecretsManager,
+			Expected: "AWS_ENDPOINT_URL_SECRETS_MANAGER",
+		},
+		{
+			TestName: ELBV2,
+			Input:    ELBV2,
+			Expected: "AWS_ENDPOINT_URL_ELASTIC_LOAD_BALANCING_V2",
+		},
+		{
+			TestName: "doesnotexist",
+			Input:    "doesnotexist",
+			Expected: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := AWSServiceEnvVar(testCase.Input), testCase.Expected; got != want {
+				t.Errorf("got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestRetiredServices(t *testing.T) {
+	t.Parallel()
+
+	services := RetiredServices()
+
+	if got, expected := services["macie"], "Macie Classic"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	for k := range services {
+		if _, err := ProviderPackageForAlias(k); err == nil {
+			t.Errorf("retired service %s is a provider package", k)
+		}
+	}
+}
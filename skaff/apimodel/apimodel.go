@@ -0,0 +1,456 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package apimodel reads the API model of an AWS service from the source of its AWS SDK for Go v2 package.
+// Operations, their input and output structures, nested structures and enumerations are recovered
+// from the SDK's generated Go code, which mirrors the service's Smithy model.
+package apimodel
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Kind is the kind of value held by a structure member.
+type Kind int
+
+const (
+	KindUnsupported Kind = iota
+	KindString
+	KindEnum
+	KindBool
+	KindInt
+	KindFloat
+	KindTimestamp
+	KindList    // List of scalars.
+	KindMap     // Map of strings to strings.
+	KindStruct  // Nested structure.
+	KindStructs // List of nested structures.
+)
+
+// maxDepth is the maximum depth of nested structures that are resolved.
+// Recursive structures are cut off at this depth.
+const maxDepth = 4
+
+// requiredMarker is the documentation line the SDK code generator adds to required members.
+const requiredMarker = "This member is required."
+
+// Member is a member of a structure.
+type Member struct {
+	Name       string // Go field name, e.g. "RoleArn".
+	Kind       Kind
+	Required   bool
+	Pointer    bool        // Whether the Go field is a pointer, e.g. *string.
+	TypeName   string      // Enumeration or structure type name, e.g. "WidgetStatus". For KindList, the element's enumeration type name, if any.
+	ElemKind   Kind        // For KindList, the kind of the elements.
+	EnumValues []EnumValue // For KindEnum, or KindList of enumerations.
+	Shape      *Shape      // For KindStruct and KindStructs.
+	Doc        string
+}
+
+// EnumValue is a value of an enumeration.
+type EnumValue struct {
+	Const string // Go constant name, e.g. "WidgetStatusActive".
+	Value string // e.g. "ACTIVE".
+}
+
+// Shape is a structure.
+type Shape struct {
+	Name    string
+	Members []*Member
+}
+
+// Member returns the named member, if any.
+func (s *Shape) Member(name string) (*Member, bool) {
+	if s == nil {
+		return nil, false
+	}
+
+	for _, m := range s.Members {
+		if m.Name == name {
+			return m, true
+		}
+	}
+
+	return nil, false
+}
+
+// Operation is an API operation.
+type Operation struct {
+	Name      string
+	Input     *Shape
+	Output    *Shape
+	Paginated bool // Whether the SDK has a paginator for the operation.
+}
+
+// Model is the API model of a single service.
+type Model struct {
+	Errors      []string // Sorted names of the service's error types, e.g. "ResourceNotFoundException".
+	PackageName string   // AWS SDK for Go v2 package name, e.g. "resourceexplorer2".
+	operations  map[string]*Operation
+}
+
+// HasError returns whether the service has the named error type.
+func (m *Model) HasError(name string) bool {
+	for _, v := range m.Errors {
+		if v == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Operation returns the named operation, if any.
+func (m *Model) Operation(name string) (*Operation, bool) {
+	op, ok := m.operations[name]
+
+	return op, ok
+}
+
+// OperationNames returns the sorted names of all operations.
+func (m *Model) OperationNames() []string {
+	names := make([]string, 0, len(m.operations))
+	for name := range m.operations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// PackageDir returns the directory containing the source of the specified AWS SDK for Go v2 service package,
+// as resolved by the Go module in the current working directory.
+func PackageDir(sdkPackage string) (string, error) {
+	path := "github.com/aws/aws-sdk-go-v2/service/" + sdkPackage
+	cmd := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", path)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+
+	if err != nil {
+		return "", fmt.Errorf("locating module %s: %w: %s", path, err, strings.TrimSpace(stderr.String()))
+	}
+
+	dir := strings.TrimSpace(string(output))
+	if dir == "" {
+		return "", fmt.Errorf("locating module %s: not downloaded", path)
+	}
+
+	return dir, nil
+}
+
+// Load reads the API model from the AWS SDK for Go v2 service package source in the specified directory.
+func Load(dir string) (*Model, error) {
+	l := &loader{
+		enums:   make(map[string][]EnumValue),
+		errors:  make(map[string]bool),
+		fset:    token.NewFileSet(),
+		structs: make(map[string]*ast.StructType),
+	}
+
+	typesFiles, err := l.parseDir(filepath.Join(dir, "types"))
+
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	for _, file := range typesFiles {
+		l.collectTypes(file)
+	}
+
+	files, err := l.parseDir(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	model := &Model{
+		operations: make(map[string]*Operation),
+	}
+
+	for name := range l.errors {
+		model.Errors = append(model.Errors, name)
+	}
+	sort.Strings(model.Errors)
+
+	// Operation input and output structures are in the package itself.
+	local := make(map[string]*ast.StructType)
+	var methods []string
+	paginators := make(map[string]bool)
+
+	for _, file := range files {
+		if model.PackageName == "" {
+			model.PackageName = file.Name.Name
+		}
+
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if isClientMethod(decl) {
+					methods = append(methods, decl.Name.Name)
+				}
+
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					spec, ok := spec.(*ast.TypeSpec)
+					if !ok {
+						continue
+					}
+
+					name := spec.Name.Name
+					if v, ok := spec.Type.(*ast.StructType); ok {
+						local[name] = v
+
+						if op, ok := strings.CutSuffix(name, "Paginator"); ok {
+							paginators[op] = true
+						}
+					}
+				}
+			}
+		}
+	}
+
+	for _, name := range methods {
+		input, ok := local[name+"Input"]
+		if !ok {
+			continue
+		}
+		output, ok := local[name+"Output"]
+		if !ok {
+			continue
+		}
+
+		model.operations[name] = &Operation{
+			Name:      name,
+			Input:     l.shape(name+"Input", input, true, 0),
+			Output:    l.shape(name+"Output", output, true, 0),
+			Paginated: paginators[name],
+		}
+	}
+
+	return model, nil
+}
+
+type loader struct {
+	enums   map[string][]EnumValue // Enumeration type name to values.
+	errors  map[string]bool        // Error type names.
+	fset    *token.FileSet
+	structs map[string]*ast.StructType
+}
+
+func (l *loader) parseDir(dir string) ([]*ast.File, error) {
+	entries, err := os.ReadDir(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*ast.File
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(l.fset, filepath.Join(dir, name), nil, parser.ParseComments|parser.SkipObjectResolution)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", name, err)
+		}
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// collectTypes records the structures, enumerations and errors declared in the SDK's "types" package.
+func (l *loader) collectTypes(file *ast.File) {
+	for _, decl := range file.Decls {
+		// Error types implement smithy.APIError.
+		if decl, ok := decl.(*ast.FuncDecl); ok {
+			if decl.Name.Name == "ErrorCode" && decl.Recv != nil && len(decl.Recv.List) == 1 {
+				if star, ok := decl.Recv.List[0].Type.(*ast.StarExpr); ok {
+					if ident, ok := star.X.(*ast.Ident); ok {
+						l.errors[ident.Name] = true
+					}
+				}
+			}
+
+			continue
+		}
+
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+
+		for _, spec := range decl.Specs {
+			switch spec := spec.(type) {
+			case *ast.TypeSpec:
+				switch typ := spec.Type.(type) {
+				case *ast.StructType:
+					l.structs[spec.Name.Name] = typ
+				case *ast.Ident:
+					if typ.Name == "string" {
+						if _, ok := l.enums[spec.Name.Name]; !ok {
+							l.enums[spec.Name.Name] = nil
+						}
+					}
+				}
+
+			case *ast.ValueSpec:
+				// Enumeration values, e.g. `WidgetStatusActive WidgetStatus = "ACTIVE"`.
+				ident, ok := spec.Type.(*ast.Ident)
+				if !ok {
+					continue
+				}
+
+				for i, value := range spec.Values {
+					if lit, ok := value.(*ast.BasicLit); ok && lit.Kind == token.STRING && i < len(spec.Names) {
+						if v, err := strconv.Unquote(lit.Value); err == nil {
+							l.enums[ident.Name] = append(l.enums[ident.Name], EnumValue{Const: spec.Names[i].Name, Value: v})
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// shape converts a Go structure to a Shape.
+// Types from the SDK's "types" package are qualified in the service package (local == true).
+func (l *loader) shape(name string, typ *ast.StructType, local bool, depth int) *Shape {
+	s := &Shape{
+		Name: name,
+	}
+
+	for _, field := range typ.Fields.List {
+		for _, ident := range field.Names {
+			if !ident.IsExported() || ident.Name == "ResultMetadata" {
+				continue
+			}
+
+			m := &Member{
+				Name: ident.Name,
+				Doc:  strings.TrimSpace(field.Doc.Text()),
+			}
+			m.Required = strings.Contains(m.Doc, requiredMarker)
+			l.resolve(m, field.Type, local, depth)
+
+			s.Members = append(s.Members, m)
+		}
+	}
+
+	return s
+}
+
+// resolve sets the member's kind and type information from its Go type expression.
+func (l *loader) resolve(m *Member, expr ast.Expr, local bool, depth int) {
+	if v, ok := expr.(*ast.StarExpr); ok {
+		m.Pointer = true
+		expr = v.X
+	}
+
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		m.Kind = scalarKind(expr.Name)
+		if m.Kind == KindUnsupported && !local {
+			l.resolveNamed(m, expr.Name, depth)
+		}
+
+	case *ast.SelectorExpr:
+		pkg, ok := expr.X.(*ast.Ident)
+		if !ok {
+			return
+		}
+
+		switch {
+		case pkg.Name == "time" && expr.Sel.Name == "Time":
+			m.Kind = KindTimestamp
+		case pkg.Name == "types" && local:
+			l.resolveNamed(m, expr.Sel.Name, depth)
+		}
+
+	case *ast.ArrayType:
+		elem := &Member{}
+		l.resolve(elem, expr.Elt, local, depth)
+
+		switch elem.Kind {
+		case KindString, KindEnum, KindBool, KindInt, KindFloat:
+			m.Kind = KindList
+			m.ElemKind = elem.Kind
+			m.TypeName = elem.TypeName
+			m.EnumValues = elem.EnumValues
+		case KindStruct:
+			m.Kind = KindStructs
+			m.TypeName = elem.TypeName
+			m.Shape = elem.Shape
+		}
+
+	case *ast.MapType:
+		if key, ok := expr.Key.(*ast.Ident); ok && key.Name == "string" {
+			if value, ok := expr.Value.(*ast.Ident); ok && value.Name == "string" {
+				m.Kind = KindMap
+			}
+		}
+	}
+}
+
+// resolveNamed resolves a type declared in the SDK's "types" package.
+func (l *loader) resolveNamed(m *Member, name string, depth int) {
+	if values, ok := l.enums[name]; ok {
+		m.Kind = KindEnum
+		m.TypeName = name
+		m.EnumValues = values
+		return
+	}
+
+	if typ, ok := l.structs[name]; ok && depth < maxDepth {
+		m.Kind = KindStruct
+		m.TypeName = name
+		m.Shape = l.shape(name, typ, false, depth+1)
+	}
+}
+
+func scalarKind(name string) Kind {
+	switch name {
+	case "string":
+		return KindString
+	case "bool":
+		return KindBool
+	case "int", "int32", "int64":
+		return KindInt
+	case "float32", "float64":
+		return KindFloat
+	default:
+		return KindUnsupported
+	}
+}
+
+// isClientMethod returns whether the function declaration is an API operation method on the SDK's Client.
+func isClientMethod(decl *ast.FuncDecl) bool {
+	if decl.Recv == nil || len(decl.Recv.List) != 1 || !decl.Name.IsExported() {
+		return false
+	}
+
+	star, ok := decl.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+
+	ident, ok := star.X.(*ast.Ident)
+
+	return ok && ident.Name == "Client"
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimodel
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	model, err := Load("testdata/example")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := model.PackageName, "example"; got != want {
+		t.Errorf("PackageName = %q, want %q", got, want)
+	}
+
+	if got, want := model.Errors, []string{"ResourceNotFoundException"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Errors = %v, want %v", got, want)
+	}
+	if !model.HasError("ResourceNotFoundException") {
+		t.Error("no ResourceNotFoundException")
+	}
+
+	if got, want := model.OperationNames(), []string{"CreateWidget", "DeleteWidget", "GetWidget", "ListWidgets", "UpdateWidget"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("OperationNames = %v, want %v", got, want)
+	}
+
+	create, ok := model.Operation("CreateWidget")
+	if !ok {
+		t.Fatal("CreateWidget not found")
+	}
+
+	if create.Paginated {
+		t.Error("CreateWidget is paginated")
+	}
+
+	testCases := []struct {
+		member   string
+		kind     Kind
+		required bool
+	}{
+		{"WidgetName", KindString, true},
+		{"ClientToken", KindString, false},
+		{"Configuration", KindStruct, false},
+		{"Size", KindInt, false},
+		{"Tags", KindMap, false},
+	}
+
+	if got, want := len(create.Input.Members), len(testCases); got != want {
+		t.Fatalf("CreateWidgetInput has %d members, want %d", got, want)
+	}
+
+	for _, testCase := range testCases {
+		m, ok := create.Input.Member(testCase.member)
+		if !ok {
+			t.Errorf("CreateWidgetInput.%s not found", testCase.member)
+			continue
+		}
+
+		if m.Kind != testCase.kind {
+			t.Errorf("CreateWidgetInput.%s kind = %d, want %d", testCase.member, m.Kind, testCase.kind)
+		}
+		if m.Required != testCase.required {
+			t.Errorf("CreateWidgetInput.%s required = %t, want %t", testCase.member, m.Required, testCase.required)
+		}
+	}
+
+	configuration, _ := create.Input.Member("Configuration")
+	if got, want := configuration.TypeName, "WidgetConfiguration"; got != want {
+		t.Errorf("Configuration type = %q, want %q", got, want)
+	}
+	if m, ok := configuration.Shape.Member("Enabled"); !ok || m.Kind != KindBool || !m.Required {
+		t.Errorf("WidgetConfiguration.Enabled = %+v", m)
+	}
+	if m, ok := configuration.Shape.Member("Labels"); !ok || m.Kind != KindList || m.ElemKind != KindString {
+		t.Errorf("WidgetConfiguration.Labels = %+v", m)
+	}
+
+	get, ok := model.Operation("GetWidget")
+	if !ok {
+		t.Fatal("GetWidget not found")
+	}
+
+	widget, ok := get.Output.Member("Widget")
+	if !ok || widget.Kind != KindStruct {
+		t.Fatalf("GetWidgetOutput.Widget = %+v", widget)
+	}
+	if m, ok := widget.Shape.Member("WidgetId"); !ok || m.Kind != KindString || !m.Pointer {
+		t.Errorf("Widget.WidgetId = %+v", m)
+	}
+	if m, ok := widget.Shape.Member("CreatedAt"); !ok || m.Kind != KindTimestamp {
+		t.Errorf("Widget.CreatedAt = %+v", m)
+	}
+	status, ok := widget.Shape.Member("Status")
+	if !ok || status.Kind != KindEnum || status.Pointer {
+		t.Fatalf("Widget.Status = %+v", status)
+	}
+	if got, want := status.EnumValues[1], (EnumValue{Const: "WidgetStatusActive", Value: "ACTIVE"}); len(status.EnumValues) != 5 || got != want {
+		t.Errorf("WidgetStatus values = %v, want %v", got, want)
+	}
+
+	list, ok := model.Operation("ListWidgets")
+	if !ok {
+		t.Fatal("ListWidgets not found")
+	}
+
+	if !list.Paginated {
+		t.Error("ListWidgets is not paginated")
+	}
+	if m, ok := list.Output.Member("Widgets"); !ok || m.Kind != KindStructs || m.TypeName != "WidgetSummary" {
+		t.Errorf("ListWidgetsOutput.Widgets = %+v", m)
+	}
+}
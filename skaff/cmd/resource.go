@@ -15,12 +15,18 @@ var (
 	force           bool
 	v1              bool
 	pluginFramework bool
+	apiModel        bool
+	apiOperations   resource.APIOperations
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if apiModel {
+			return resource.CreateFromAPIModel(name, snakeName, force, apiOperations)
+		}
+
 		return resource.Create(name, snakeName, !clearComments, force, !v1, pluginFramework)
 	},
 }
@@ -33,4 +39,10 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
 	resourceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
 	resourceCmd.Flags().BoolVarP(&pluginFramework, "plugin-framework", "p", false, "generate for Terraform Plugin-Framework")
+	resourceCmd.Flags().BoolVarP(&apiModel, "api-model", "m", false, "generate a complete Terraform Plugin-Framework resource, sweeper and tests from the AWS Go SDK v2 API model")
+	resourceCmd.Flags().StringVar(&apiOperations.Create, "create-operation", "", "with --api-model, the API operation that creates the resource (default Create<name>)")
+	resourceCmd.Flags().StringVar(&apiOperations.Read, "read-operation", "", "with --api-model, the API operation that reads the resource (default Get<name> or Describe<name>)")
+	resourceCmd.Flags().StringVar(&apiOperations.Update, "update-operation", "", "with --api-model, the API operation that updates the resource (default Update<name>, if any)")
+	resourceCmd.Flags().StringVar(&apiOperations.Delete, "delete-operation", "", "with --api-model, the API operation that deletes the resource (default Delete<name>)")
+	resourceCmd.Flags().StringVar(&apiOperations.List, "list-operation", "", "with --api-model, the API operation that lists resources for the sweeper (default List<name>s, if any)")
 }
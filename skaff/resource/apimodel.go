@@ -0,0 +1,934 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
+)
+
+// APIOperations names the AWS API operations that a resource is scaffolded from.
+// Empty names are defaulted from the resource name, e.g. for "Widget":
+//
+//	CreateWidget, GetWidget or DescribeWidget, UpdateWidget, DeleteWidget, ListWidgets
+type APIOperations struct {
+	Create string
+	Read   string
+	Update string
+	Delete string
+	List   string
+}
+
+// Members of operation inputs that are never exposed as resource attributes.
+var ignoredMembers = map[string]bool{
+	"ClientRequestToken": true,
+	"ClientToken":        true,
+	"DryRun":             true,
+	"IdempotencyToken":   true,
+	"MaxResults":         true,
+	"NextToken":          true,
+}
+
+// Members of operation inputs that are set from a new unique ID on create.
+var idempotencyTokenMembers = []string{"ClientToken", "ClientRequestToken", "IdempotencyToken"}
+
+// Enumeration values that indicate a resource is ready for use.
+var readyStatuses = map[string]bool{
+	"ACTIVE":     true,
+	"AVAILABLE":  true,
+	"COMPLETED":  true,
+	"CREATED":    true,
+	"ENABLED":    true,
+	"IN_SERVICE": true,
+	"READY":      true,
+	"RUNNING":    true,
+	"SUCCEEDED":  true,
+}
+
+// APIModelData is the template data derived from a service's API model.
+type APIModelData struct {
+	SDKPackage string // AWS SDK for Go v2 package name, e.g. "resourceexplorer2".
+	Plural     string // Plural resource name, e.g. "Widgets".
+
+	CreateOperation string
+	ReadOperation   string
+	UpdateOperation string // Empty if the resource can't be updated in-place.
+	DeleteOperation string
+	ListOperation   string // Empty if there's no list operation. No sweeper is generated.
+
+	IDMember          string // Read input member holding the resource ID, e.g. "WidgetId".
+	CreateIDExpr      string // Expression for the resource ID after create, e.g. "aws.ToString(output.Widget.WidgetId)". Empty if unknown.
+	DeleteIDMember    string
+	UpdateIDMember    string
+	IdempotencyToken  string // Create input member set to a unique ID, if any.
+	NotFoundError     string // Service error type returned for missing resources, if any.
+	ReadResultField   string // Read output member holding the resource, e.g. "Widget". Empty if the whole output is used.
+	ReadResultType    string // e.g. "awstypes.Widget" or "resourceexplorer2.GetWidgetOutput".
+	ReadResultTypeRef string // ReadResultType as referenced from the _test package.
+	DeletedStatus     string // Status constant meaning the resource is deleted, if any.
+
+	StatusField         string // Read result member holding the resource status, if any.
+	StatusCreatePending []string
+	StatusCreateTarget  []string
+	StatusUpdatePending []string
+	StatusDeletePending []string
+
+	Tags                    bool
+	TagsIdentifierAttribute string
+	TagsInReadOutput        bool
+
+	ListPaginated   bool
+	ListResultField string // e.g. "Widgets".
+	ListIDExpr      string // Expression for the resource ID of a list result element `v`. Empty if unknown.
+
+	Schema           string // Go source for the schema's attributes and blocks.
+	ModelStruct      string // Go source for the resource model's fields.
+	NestedStructs    string // Go source for nested block model structures.
+	UpdateCondition  string // Go expression that is true if any updatable attribute has changed.
+	UsesARNType      bool
+	UsesEnum         bool
+	UsesTimestamp    bool
+	UsesValidators   bool
+	UsesListValidate bool
+	PlanModifierPkgs []string // Plan modifier packages used by the schema, e.g. "stringplanmodifier".
+	UsesReflect      bool
+
+	BasicConfig  string // HCL arguments for the basic acceptance test configuration.
+	NameArgument string // Argument set to the random name in the basic configuration, if any.
+	ARNAttribute string // Computed ARN attribute, if any.
+
+	Warnings []string // Parts of the resource that could not be derived from the API model.
+}
+
+// HasWaiters returns whether status waiters are generated.
+func (d *APIModelData) HasWaiters() bool {
+	return d.StatusField != ""
+}
+
+// CreateUsesOutput returns whether the resource ID is read from the create operation's output.
+func (d *APIModelData) CreateUsesOutput() bool {
+	return strings.Contains(d.CreateIDExpr, "output.")
+}
+
+// SweepUsesAWS returns whether the sweeper uses the AWS SDK for Go v2 "aws" package.
+func (d *APIModelData) SweepUsesAWS() bool {
+	return strings.HasPrefix(d.ListIDExpr, "aws.")
+}
+
+// HasUpdateWaiter returns whether an update waiter is generated.
+func (d *APIModelData) HasUpdateWaiter() bool {
+	return d.HasWaiters() && d.UpdateOperation != "" && len(d.StatusUpdatePending) > 0
+}
+
+// HasDeleteWaiter returns whether a delete waiter is generated.
+func (d *APIModelData) HasDeleteWaiter() bool {
+	return d.HasWaiters() && len(d.StatusDeletePending) > 0
+}
+
+// apiModelAttribute is a resource attribute derived from an API structure member.
+type apiModelAttribute struct {
+	goName          string
+	tfName          string
+	member          *apimodel.Member
+	required        bool
+	optional        bool
+	computed        bool
+	requiresReplace bool
+	nested          []*apiModelAttribute
+}
+
+func (a *apiModelAttribute) computedOnly() bool {
+	return a.computed && !a.required && !a.optional
+}
+
+func (a *apiModelAttribute) isNested() bool {
+	return a.member.Kind == apimodel.KindStruct || a.member.Kind == apimodel.KindStructs
+}
+
+func (a *apiModelAttribute) isBlock() bool {
+	return a.isNested() && !a.computedOnly()
+}
+
+func (a *apiModelAttribute) isARN() bool {
+	return a.member.Kind == apimodel.KindString && (strings.HasSuffix(a.goName, "Arn") || strings.HasSuffix(a.goName, "ARN"))
+}
+
+// DefaultAPIOperations returns the operations for the named resource, defaulting any that are not specified.
+func DefaultAPIOperations(model *apimodel.Model, resName string, ops APIOperations) (APIOperations, error) {
+	var err error
+
+	if ops.Create, err = operationName(model, ops.Create, true, "Create"+resName); err != nil {
+		return ops, err
+	}
+	if ops.Read, err = operationName(model, ops.Read, true, "Get"+resName, "Describe"+resName); err != nil {
+		return ops, err
+	}
+	if ops.Update, err = operationName(model, ops.Update, false, "Update"+resName); err != nil {
+		return ops, err
+	}
+	if ops.Delete, err = operationName(model, ops.Delete, true, "Delete"+resName); err != nil {
+		return ops, err
+	}
+	if ops.List, err = operationName(model, ops.List, false, "List"+plural(resName), "Describe"+plural(resName)); err != nil {
+		return ops, err
+	}
+
+	return ops, nil
+}
+
+func operationName(model *apimodel.Model, name string, required bool, defaults ...string) (string, error) {
+	if name != "" {
+		if _, ok := model.Operation(name); !ok {
+			return "", fmt.Errorf("operation %s not found in the %s API model", name, model.PackageName)
+		}
+
+		return name, nil
+	}
+
+	for _, name := range defaults {
+		if _, ok := model.Operation(name); ok {
+			return name, nil
+		}
+	}
+
+	if required {
+		return "", fmt.Errorf("none of operations %s found in the %s API model, specify the operation name", strings.Join(defaults, ", "), model.PackageName)
+	}
+
+	return "", nil
+}
+
+// NewAPIModelData derives the template data for the named resource from the API model.
+func NewAPIModelData(model *apimodel.Model, resName string, ops APIOperations) (*APIModelData, error) {
+	ops, err := DefaultAPIOperations(model, resName, ops)
+
+	if err != nil {
+		return nil, err
+	}
+
+	create, _ := model.Operation(ops.Create)
+	read, _ := model.Operation(ops.Read)
+	del, _ := model.Operation(ops.Delete)
+	update, _ := model.Operation(ops.Update)
+	list, _ := model.Operation(ops.List)
+
+	d := &APIModelData{
+		SDKPackage:      model.PackageName,
+		Plural:          plural(resName),
+		CreateOperation: ops.Create,
+		ReadOperation:   ops.Read,
+		UpdateOperation: ops.Update,
+		DeleteOperation: ops.Delete,
+		ListOperation:   ops.List,
+	}
+
+	if d.IDMember = idMember(read.Input); d.IDMember == "" {
+		return nil, fmt.Errorf("%s input has no required string member to use as the resource ID", ops.Read)
+	}
+	d.DeleteIDMember = idMember(del.Input)
+	if update != nil {
+		d.UpdateIDMember = idMember(update.Input)
+	}
+
+	for _, name := range idempotencyTokenMembers {
+		if _, ok := create.Input.Member(name); ok {
+			d.IdempotencyToken = name
+			break
+		}
+	}
+
+	if model.HasError("ResourceNotFoundException") {
+		d.NotFoundError = "ResourceNotFoundException"
+	} else {
+		for _, name := range model.Errors {
+			if strings.Contains(name, "NotFound") {
+				d.NotFoundError = name
+				break
+			}
+		}
+	}
+
+	// The resource is described by the read output's structure member named for the resource or its only structure member.
+	resource := read.Output
+	d.ReadResultType = fmt.Sprintf("%s.%sOutput", model.PackageName, ops.Read)
+	d.ReadResultTypeRef = d.ReadResultType
+	if m := resultMember(read.Output, resName); m != nil {
+		resource = m.Shape
+		d.ReadResultField = m.Name
+		d.ReadResultType = "awstypes." + m.TypeName
+		d.ReadResultTypeRef = "awstypes." + m.TypeName
+	}
+
+	if _, ok := resource.Member("Tags"); ok {
+		d.TagsInReadOutput = true
+	}
+
+	d.CreateIDExpr = createIDExpr(create.Output, d.IDMember)
+	if _, ok := create.Input.Member(d.IDMember); ok && d.CreateIDExpr == "" {
+		d.CreateIDExpr = fmt.Sprintf("data.%s.ValueString()", d.IDMember)
+	}
+	if d.CreateIDExpr == "" {
+		d.Warnings = append(d.Warnings, fmt.Sprintf("%s output has no %s, set the resource ID", ops.Create, d.IDMember))
+	}
+	if d.DeleteIDMember == "" {
+		d.Warnings = append(d.Warnings, fmt.Sprintf("%s input has no required string member, set the resource ID", ops.Delete))
+	}
+	if update != nil && d.UpdateIDMember == "" {
+		d.Warnings = append(d.Warnings, fmt.Sprintf("%s input has no required string member, set the resource ID", ops.Update))
+	}
+	if d.NotFoundError == "" {
+		d.Warnings = append(d.Warnings, fmt.Sprintf("%s API model has no \"not found\" error, handle missing resources in the finder", model.PackageName))
+	}
+
+	var updateInput *apimodel.Shape
+	if update != nil {
+		updateInput = update.Input
+	}
+	attributes := deriveAttributes(create.Input, updateInput, resource)
+
+	if _, ok := create.Input.Member("Tags"); ok {
+		d.Tags = true
+		d.TagsIdentifierAttribute = "id"
+	}
+	// Prefer "arn", then "<resource>_arn".
+	for _, a := range attributes {
+		if !a.isARN() || !a.computedOnly() {
+			continue
+		}
+		if d.ARNAttribute == "" || a.tfName == "arn" || a.tfName == ToSnakeCase(resName, "")+"_arn" && d.ARNAttribute != "arn" {
+			d.ARNAttribute = a.tfName
+		}
+	}
+	if d.Tags && d.ARNAttribute != "" {
+		d.TagsIdentifierAttribute = d.ARNAttribute
+	}
+
+	d.status(resource)
+	d.list(list, resName)
+	d.render(attributes)
+	d.testConfig(attributes)
+
+	return d, nil
+}
+
+// idMember returns the name of the shape's first required string member.
+func idMember(s *apimodel.Shape) string {
+	for _, m := range s.Members {
+		if m.Required && m.Kind == apimodel.KindString {
+			return m.Name
+		}
+	}
+
+	return ""
+}
+
+// resultMember returns the structure member describing the resource, if any.
+// Otherwise the output describes the resource.
+func resultMember(s *apimodel.Shape, resName string) *apimodel.Member {
+	var structs, others []*apimodel.Member
+
+	for _, m := range s.Members {
+		if m.Kind == apimodel.KindStruct {
+			if m.Name == resName || m.Name == resName+"Description" || m.Name == resName+"Detail" {
+				return m
+			}
+			structs = append(structs, m)
+		} else if !skipMember(m) {
+			others = append(others, m)
+		}
+	}
+
+	if len(structs) == 1 && len(others) == 0 {
+		return structs[0]
+	}
+
+	return nil
+}
+
+func createIDExpr(output *apimodel.Shape, idMember string) string {
+	if m := findIDMember(output, idMember); m != nil {
+		return valueExpr("output."+m.Name, m)
+	}
+
+	for _, m := range output.Members {
+		if m.Kind != apimodel.KindStruct {
+			continue
+		}
+		if v := findIDMember(m.Shape, idMember); v != nil {
+			return valueExpr(fmt.Sprintf("output.%s.%s", m.Name, v.Name), v)
+		}
+	}
+
+	return ""
+}
+
+// findIDMember returns the shape's string member holding the resource ID.
+// An ID taken as "<X>Identifier" is returned as "<X>Id", "<X>Arn", "Id" or "Arn".
+func findIDMember(s *apimodel.Shape, idMember string) *apimodel.Member {
+	names := []string{idMember}
+	if prefix, ok := strings.CutSuffix(idMember, "Identifier"); ok {
+		names = append(names, prefix+"Id", prefix+"Arn", "Id", "Arn")
+	}
+
+	for _, name := range names {
+		if m, ok := s.Member(name); ok && m.Kind == apimodel.KindString {
+			return m
+		}
+	}
+
+	return nil
+}
+
+func valueExpr(expr string, m *apimodel.Member) string {
+	if m.Pointer {
+		return fmt.Sprintf("aws.ToString(%s)", expr)
+	}
+
+	return expr
+}
+
+// deriveAttributes returns the resource's attributes.
+// Create input members are arguments, computed if also returned by the read operation,
+// that force replacement unless they are also update input members.
+// Members only returned by the read operation are computed.
+// Members named "Id" are replaced by the resource's "id" attribute.
+func deriveAttributes(create, update, resource *apimodel.Shape) []*apiModelAttribute {
+	var attributes []*apiModelAttribute
+	seen := make(map[string]bool)
+
+	for _, m := range create.Members {
+		if skipMember(m) || ToSnakeCase(m.Name, "") == "id" {
+			continue
+		}
+
+		_, inResource := resource.Member(m.Name)
+		_, updatable := update.Member(m.Name)
+		a := &apiModelAttribute{
+			goName:          m.Name,
+			tfName:          ToSnakeCase(m.Name, ""),
+			member:          m,
+			required:        m.Required,
+			optional:        !m.Required,
+			computed:        !m.Required && inResource,
+			requiresReplace: !updatable,
+		}
+		if a.isNested() {
+			// Blocks can't be computed.
+			a.computed = false
+			a.nested = nestedAttributes(m.Shape, false)
+		}
+
+		seen[m.Name] = true
+		attributes = append(attributes, a)
+	}
+
+	for _, m := range resource.Members {
+		if seen[m.Name] || skipMember(m) || ToSnakeCase(m.Name, "") == "id" {
+			continue
+		}
+
+		a := &apiModelAttribute{
+			goName:   m.Name,
+			tfName:   ToSnakeCase(m.Name, ""),
+			member:   m,
+			computed: true,
+		}
+		if a.isNested() {
+			a.nested = nestedAttributes(m.Shape, true)
+		}
+
+		attributes = append(attributes, a)
+	}
+
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].tfName < attributes[j].tfName
+	})
+
+	return attributes
+}
+
+func nestedAttributes(s *apimodel.Shape, computed bool) []*apiModelAttribute {
+	var attributes []*apiModelAttribute
+
+	for _, m := range s.Members {
+		if skipMember(m) {
+			continue
+		}
+
+		a := &apiModelAttribute{
+			goName:   m.Name,
+			tfName:   ToSnakeCase(m.Name, ""),
+			member:   m,
+			required: !computed && m.Required,
+			optional: !computed && !m.Required,
+			computed: computed,
+		}
+		if a.isNested() {
+			a.nested = nestedAttributes(m.Shape, computed)
+		}
+
+		attributes = append(attributes, a)
+	}
+
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].tfName < attributes[j].tfName
+	})
+
+	return attributes
+}
+
+func skipMember(m *apimodel.Member) bool {
+	return ignoredMembers[m.Name] || m.Name == "Tags" || m.Kind == apimodel.KindUnsupported
+}
+
+// status sets the status waiter data if the resource has a status enumeration.
+func (d *APIModelData) status(resource *apimodel.Shape) {
+	var status *apimodel.Member
+
+	for _, name := range []string{"Status", "State"} {
+		if m, ok := resource.Member(name); ok && m.Kind == apimodel.KindEnum {
+			status = m
+			break
+		}
+	}
+	if status == nil {
+		for _, m := range resource.Members {
+			if m.Kind == apimodel.KindEnum && (strings.HasSuffix(m.Name, "Status") || strings.HasSuffix(m.Name, "State")) {
+				status = m
+				break
+			}
+		}
+	}
+	if status == nil {
+		return
+	}
+
+	for _, v := range status.EnumValues {
+		value := strings.ToUpper(v.Value)
+		c := "awstypes." + v.Const
+
+		switch {
+		case strings.Contains(value, "FAIL"):
+			// Failures aren't pending or target states.
+		case readyStatuses[value]:
+			d.StatusCreateTarget = append(d.StatusCreateTarget, c)
+		case value == "DELETED":
+			d.DeletedStatus = c
+		case strings.Contains(value, "DELET"):
+			d.StatusDeletePending = append(d.StatusDeletePending, c)
+		case strings.Contains(value, "UPDAT") || strings.Contains(value, "MODIFY"):
+			d.StatusUpdatePending = append(d.StatusUpdatePending, c)
+		case strings.HasSuffix(value, "ING") || strings.Contains(value, "PENDING") || strings.Contains(value, "IN_PROGRESS"):
+			d.StatusCreatePending = append(d.StatusCreatePending, c)
+		}
+	}
+
+	if len(d.StatusCreatePending) == 0 || len(d.StatusCreateTarget) == 0 {
+		// Not a lifecycle status.
+		d.StatusCreatePending, d.StatusCreateTarget, d.StatusUpdatePending, d.StatusDeletePending, d.DeletedStatus = nil, nil, nil, nil, ""
+		return
+	}
+
+	d.StatusField = status.Name
+}
+
+// list sets the sweeper data from the list operation.
+func (d *APIModelData) list(list *apimodel.Operation, resName string) {
+	if list == nil {
+		d.ListOperation = ""
+		return
+	}
+
+	d.ListPaginated = list.Paginated
+
+	var result *apimodel.Member
+	for _, m := range list.Output.Members {
+		if m.Kind == apimodel.KindStructs || m.Kind == apimodel.KindList && m.ElemKind == apimodel.KindString {
+			if result == nil || strings.Contains(m.Name, resName) {
+				result = m
+			}
+		}
+	}
+	if result == nil {
+		d.Warnings = append(d.Warnings, fmt.Sprintf("%s output has no list of %s, no sweeper generated", list.Name, d.Plural))
+		d.ListOperation = ""
+		return
+	}
+
+	d.ListResultField = result.Name
+
+	if result.Kind == apimodel.KindList {
+		d.ListIDExpr = "v"
+		return
+	}
+
+	if m := findIDMember(result.Shape, d.IDMember); m != nil {
+		d.ListIDExpr = valueExpr("v."+m.Name, m)
+	} else {
+		d.Warnings = append(d.Warnings, fmt.Sprintf("%s elements have no %s, set the sweeper's resource ID", list.Name, d.IDMember))
+	}
+}
+
+// render sets the Go source for the schema and resource model.
+func (d *APIModelData) render(attributes []*apiModelAttribute) {
+	r := &schemaRenderer{
+		data:             d,
+		planModifierPkgs: make(map[string]bool),
+		structs:          make(map[string]bool),
+	}
+
+	var schema, model strings.Builder
+
+	schema.WriteString("Attributes: map[string]schema.Attribute{\n")
+	for _, a := range attributes {
+		if !a.isBlock() {
+			r.attribute(&schema, a, true)
+		}
+	}
+	schema.WriteString("names.AttrID: framework.IDAttribute(),\n")
+	if d.Tags {
+		schema.WriteString("names.AttrTags: tftags.TagsAttribute(),\n")
+		schema.WriteString("names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),\n")
+	}
+	schema.WriteString("},\n")
+
+	var blocks strings.Builder
+	for _, a := range attributes {
+		if a.isBlock() {
+			r.block(&blocks, a, true)
+		}
+	}
+	if d.HasWaiters() {
+		blocks.WriteString("names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{\nCreate: true,\n")
+		if d.HasUpdateWaiter() {
+			blocks.WriteString("Update: true,\n")
+		}
+		if d.HasDeleteWaiter() {
+			blocks.WriteString("Delete: true,\n")
+		}
+		blocks.WriteString("}),\n")
+	}
+	if blocks.Len() > 0 {
+		fmt.Fprintf(&schema, "Blocks: map[string]schema.Block{\n%s},\n", blocks.String())
+	}
+
+	fields := []string{"ID types.String `tfsdk:\"id\"`"}
+	for _, a := range attributes {
+		fields = append(fields, fmt.Sprintf("%s %s `tfsdk:%q`", a.goName, r.modelType(a, true), a.tfName))
+	}
+	if d.Tags {
+		fields = append(fields, "Tags types.Map `tfsdk:\"tags\"`", "TagsAll types.Map `tfsdk:\"tags_all\"`")
+	}
+	if d.HasWaiters() {
+		fields = append(fields, "Timeouts timeouts.Value `tfsdk:\"timeouts\"`")
+	}
+	sort.Strings(fields)
+	for _, f := range fields {
+		model.WriteString(f + "\n")
+	}
+
+	var conditions []string
+	for _, a := range attributes {
+		if a.requiresReplace || a.computedOnly() {
+			continue
+		}
+		if a.isNested() {
+			d.UsesReflect = true
+			conditions = append(conditions, fmt.Sprintf("!reflect.DeepEqual(new.%[1]s, old.%[1]s)", a.goName))
+		} else {
+			conditions = append(conditions, fmt.Sprintf("!new.%[1]s.Equal(old.%[1]s)", a.goName))
+		}
+	}
+
+	d.Schema = schema.String()
+	d.ModelStruct = model.String()
+	d.NestedStructs = r.nestedStructs.String()
+	d.UpdateCondition = strings.Join(conditions, " ||\n")
+	for pkg := range r.planModifierPkgs {
+		d.PlanModifierPkgs = append(d.PlanModifierPkgs, pkg)
+	}
+	sort.Strings(d.PlanModifierPkgs)
+}
+
+type schemaRenderer struct {
+	data             *APIModelData
+	nestedStructs    strings.Builder
+	planModifierPkgs map[string]bool
+	structs          map[string]bool // Nested structure type names already rendered.
+}
+
+// scalarType returns the schema attribute type, plan modifier type and plan modifier package for a member kind.
+func scalarType(kind apimodel.Kind) (string, string, string) {
+	switch kind {
+	case apimodel.KindBool:
+		return "BoolAttribute", "Bool", "boolplanmodifier"
+	case apimodel.KindInt:
+		return "Int64Attribute", "Int64", "int64planmodifier"
+	case apimodel.KindFloat:
+		return "Float64Attribute", "Float64", "float64planmodifier"
+	case apimodel.KindList, apimodel.KindStruct, apimodel.KindStructs:
+		return "ListAttribute", "List", "listplanmodifier"
+	case apimodel.KindMap:
+		return "MapAttribute", "Map", "mapplanmodifier"
+	default:
+		return "StringAttribute", "String", "stringplanmodifier"
+	}
+}
+
+func elementType(kind apimodel.Kind) string {
+	switch kind {
+	case apimodel.KindBool:
+		return "types.BoolType"
+	case apimodel.KindInt:
+		return "types.Int64Type"
+	case apimodel.KindFloat:
+		return "types.Float64Type"
+	default:
+		return "types.StringType"
+	}
+}
+
+func (r *schemaRenderer) attribute(w *strings.Builder, a *apiModelAttribute, top bool) {
+	if top && a.computedOnly() && a.isARN() {
+		fmt.Fprintf(w, "%q: framework.ARNAttributeComputedOnly(),\n", a.tfName)
+		return
+	}
+
+	attrType, planModifierType, planModifierPkg := scalarType(a.member.Kind)
+	if a.isNested() {
+		attrType = "ListNestedAttribute"
+	}
+
+	fmt.Fprintf(w, "%q: schema.%s{\n", a.tfName, attrType)
+
+	switch {
+	case a.isARN():
+		r.data.UsesARNType = true
+		w.WriteString("CustomType: fwtypes.ARNType,\n")
+	case a.member.Kind == apimodel.KindTimestamp:
+		r.data.UsesTimestamp = true
+		w.WriteString("CustomType: fwtypes.TimestampType{},\n")
+	case a.member.Kind == apimodel.KindList || a.member.Kind == apimodel.KindMap:
+		fmt.Fprintf(w, "ElementType: %s,\n", elementType(a.member.ElemKind))
+	}
+
+	if a.required {
+		w.WriteString("Required: true,\n")
+	}
+	if a.optional {
+		w.WriteString("Optional: true,\n")
+	}
+	if a.computed {
+		w.WriteString("Computed: true,\n")
+	}
+
+	if a.isNested() {
+		w.WriteString("NestedObject: schema.NestedAttributeObject{\nAttributes: map[string]schema.Attribute{\n")
+		for _, v := range a.nested {
+			r.attribute(w, v, false)
+		}
+		w.WriteString("},\n},\n")
+	}
+
+	if top {
+		var planModifiers []string
+		if a.requiresReplace {
+			planModifiers = append(planModifiers, planModifierPkg+".RequiresReplace()")
+		}
+		if a.computed {
+			planModifiers = append(planModifiers, planModifierPkg+".UseStateForUnknown()")
+		}
+		if len(planModifiers) > 0 {
+			r.planModifierPkgs[planModifierPkg] = true
+			fmt.Fprintf(w, "PlanModifiers: []planmodifier.%s{\n%s,\n},\n", planModifierType, strings.Join(planModifiers, ",\n"))
+		}
+	}
+
+	if a.member.Kind == apimodel.KindEnum && !a.computedOnly() {
+		r.data.UsesEnum = true
+		r.data.UsesValidators = true
+		fmt.Fprintf(w, "Validators: []validator.String{\nenum.FrameworkValidate[awstypes.%s](),\n},\n", a.member.TypeName)
+	}
+
+	w.WriteString("},\n")
+}
+
+func (r *schemaRenderer) block(w *strings.Builder, a *apiModelAttribute, top bool) {
+	fmt.Fprintf(w, "%q: schema.ListNestedBlock{\n", a.tfName)
+
+	if top && a.requiresReplace {
+		r.planModifierPkgs["listplanmodifier"] = true
+		w.WriteString("PlanModifiers: []planmodifier.List{\nlistplanmodifier.RequiresReplace(),\n},\n")
+	}
+
+	var validators []string
+	if a.required {
+		validators = append(validators, "listvalidator.IsRequired()")
+	}
+	if a.member.Kind == apimodel.KindStruct {
+		validators = append(validators, "listvalidator.SizeAtMost(1)")
+	}
+	if len(validators) > 0 {
+		r.data.UsesListValidate = true
+		r.data.UsesValidators = true
+		fmt.Fprintf(w, "Validators: []validator.List{\n%s,\n},\n", strings.Join(validators, ",\n"))
+	}
+
+	w.WriteString("NestedObject: schema.NestedBlockObject{\n")
+	w.WriteString("Attributes: map[string]schema.Attribute{\n")
+	for _, v := range a.nested {
+		if !v.isBlock() {
+			r.attribute(w, v, false)
+		}
+	}
+	w.WriteString("},\n")
+	var blocks strings.Builder
+	for _, v := range a.nested {
+		if v.isBlock() {
+			r.block(&blocks, v, false)
+		}
+	}
+	if blocks.Len() > 0 {
+		fmt.Fprintf(w, "Blocks: map[string]schema.Block{\n%s},\n", blocks.String())
+	}
+	w.WriteString("},\n},\n")
+}
+
+// modelType returns the Go type of the attribute's resource model field, rendering any nested structure.
+func (r *schemaRenderer) modelType(a *apiModelAttribute, top bool) string {
+	switch {
+	case top && a.computedOnly() && a.isARN():
+		return "types.String"
+	case a.isARN():
+		return "fwtypes.ARN"
+	case a.isNested():
+		name := lowerFirst(a.member.TypeName) + "Data"
+		if !r.structs[name] {
+			r.structs[name] = true
+
+			var fields []string
+			for _, v := range a.nested {
+				fields = append(fields, fmt.Sprintf("%s %s `tfsdk:%q`", v.goName, r.modelType(v, false), v.tfName))
+			}
+			fmt.Fprintf(&r.nestedStructs, "\ntype %s struct {\n%s\n}\n", name, strings.Join(fields, "\n"))
+		}
+		return "[]" + name
+	}
+
+	switch a.member.Kind {
+	case apimodel.KindBool:
+		return "types.Bool"
+	case apimodel.KindInt:
+		return "types.Int64"
+	case apimodel.KindFloat:
+		return "types.Float64"
+	case apimodel.KindTimestamp:
+		return "fwtypes.TimestampValue"
+	case apimodel.KindList:
+		return "types.List"
+	case apimodel.KindMap:
+		return "types.Map"
+	default:
+		return "types.String"
+	}
+}
+
+// testConfig sets the basic acceptance test configuration from the required arguments.
+// The random name is `%[1]q`.
+func (d *APIModelData) testConfig(attributes []*apiModelAttribute) {
+	var config strings.Builder
+
+	for _, a := range attributes {
+		if a.required && a.member.Kind == apimodel.KindString && !a.isARN() && strings.HasSuffix(a.goName, "Name") {
+			d.NameArgument = a.tfName
+			break
+		}
+	}
+
+	writeTestArguments(&config, attributes, "  ", d.NameArgument)
+
+	// The random name is always a format argument.
+	if !strings.Contains(config.String(), "%[1]q") {
+		config.WriteString("  # TODO Use the random name, %[1]q.\n")
+	}
+
+	d.BasicConfig = config.String()
+}
+
+func writeTestArguments(w *strings.Builder, attributes []*apiModelAttribute, indent, nameArgument string) {
+	for _, a := range attributes {
+		if !a.required {
+			continue
+		}
+
+		if a.isNested() {
+			fmt.Fprintf(w, "\n%s%s {\n", indent, a.tfName)
+			writeTestArguments(w, a.nested, indent+"  ", "")
+			fmt.Fprintf(w, "%s}\n", indent)
+			continue
+		}
+
+		fmt.Fprintf(w, "%s%s = %s\n", indent, a.tfName, testValue(a, a.tfName == nameArgument))
+	}
+}
+
+func testValue(a *apiModelAttribute, isName bool) string {
+	m := a.member
+
+	switch {
+	case isName:
+		return "%[1]q"
+	case a.isARN():
+		return `"" # TODO Set a valid ARN.`
+	}
+
+	switch m.Kind {
+	case apimodel.KindEnum:
+		if len(m.EnumValues) > 0 {
+			return fmt.Sprintf("%q", m.EnumValues[0].Value)
+		}
+	case apimodel.KindBool:
+		return "true"
+	case apimodel.KindInt:
+		return "1"
+	case apimodel.KindFloat:
+		return "1.0"
+	case apimodel.KindTimestamp:
+		return `"" # TODO Set a valid RFC 3339 timestamp.`
+	case apimodel.KindList:
+		if m.ElemKind == apimodel.KindEnum && len(m.EnumValues) > 0 {
+			return fmt.Sprintf("[%q]", m.EnumValues[0].Value)
+		}
+		return `["test"]`
+	case apimodel.KindMap:
+		return `{
+    key1 = "value1"
+  }`
+	}
+
+	return "%[1]q"
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// plural returns the English plural of a resource name, e.g. "Index" -> "Indexes", "Policy" -> "Policies".
+func plural(s string) string {
+	switch {
+	case strings.HasSuffix(s, "s"), strings.HasSuffix(s, "x"), strings.HasSuffix(s, "ch"), strings.HasSuffix(s, "sh"):
+		return s + "es"
+	case strings.HasSuffix(s, "y") && len(s) > 1 && !strings.ContainsAny(s[len(s)-2:len(s)-1], "aeiou"):
+		return s[:len(s)-1] + "ies"
+	default:
+		return s + "s"
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"go/format"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
+)
+
+func testAPIModelTemplateData(t *testing.T) apiModelTemplateData {
+	t.Helper()
+
+	model, err := apimodel.Load("../apimodel/testdata/example")
+	if err != nil {
+		t.Fatalf("loading API model: %s", err)
+	}
+
+	d, err := NewAPIModelData(model, "Widget", APIOperations{})
+	if err != nil {
+		t.Fatalf("deriving template data: %s", err)
+	}
+
+	return apiModelTemplateData{
+		TemplateData: TemplateData{
+			Resource:             "Widget",
+			ResourceSnake:        "widget",
+			HumanFriendlyService: "Example",
+			ServicePackage:       "example",
+			Service:              "Example",
+			HumanResourceName:    "Widget",
+			ProviderResourceName: "aws_example_widget",
+		},
+		APIModelData:            d,
+		HumanResourceNamePlural: "Widgets",
+	}
+}
+
+func TestNewAPIModelData(t *testing.T) {
+	t.Parallel()
+
+	d := testAPIModelTemplateData(t).APIModelData
+
+	if got, want := []string{d.CreateOperation, d.ReadOperation, d.UpdateOperation, d.DeleteOperation, d.ListOperation}, []string{"CreateWidget", "GetWidget", "UpdateWidget", "DeleteWidget", "ListWidgets"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("operations = %v, want %v", got, want)
+	}
+	if got, want := d.IDMember, "WidgetId"; got != want {
+		t.Errorf("IDMember = %q, want %q", got, want)
+	}
+	if got, want := d.CreateIDExpr, "aws.ToString(output.Widget.WidgetId)"; got != want {
+		t.Errorf("CreateIDExpr = %q, want %q", got, want)
+	}
+	if got, want := d.ReadResultType, "awstypes.Widget"; got != want {
+		t.Errorf("ReadResultType = %q, want %q", got, want)
+	}
+	if got, want := d.IdempotencyToken, "ClientToken"; got != want {
+		t.Errorf("IdempotencyToken = %q, want %q", got, want)
+	}
+	if got, want := d.NotFoundError, "ResourceNotFoundException"; got != want {
+		t.Errorf("NotFoundError = %q, want %q", got, want)
+	}
+	if !d.Tags || d.TagsIdentifierAttribute != "widget_arn" {
+		t.Errorf("Tags = %t, TagsIdentifierAttribute = %q", d.Tags, d.TagsIdentifierAttribute)
+	}
+	if got, want := d.StatusField, "Status"; got != want {
+		t.Errorf("StatusField = %q, want %q", got, want)
+	}
+	if !d.HasDeleteWaiter() {
+		t.Error("no delete waiter")
+	}
+	if !d.ListPaginated || d.ListIDExpr != "aws.ToString(v.WidgetId)" {
+		t.Errorf("ListPaginated = %t, ListIDExpr = %q", d.ListPaginated, d.ListIDExpr)
+	}
+	if got, want := d.NameArgument, "widget_name"; got != want {
+		t.Errorf("NameArgument = %q, want %q", got, want)
+	}
+
+	for _, want := range []string{
+		`"configuration": schema.ListNestedBlock{`,
+		`"size": schema.Int64Attribute{`,
+		`"created_at": schema.StringAttribute{`,
+	} {
+		if !strings.Contains(d.Schema, want) {
+			t.Errorf("schema does not contain %s", want)
+		}
+	}
+
+	// Computed-only enumerations aren't validated.
+	if v := "enum.FrameworkValidate[awstypes.WidgetStatus]()"; strings.Contains(d.Schema, v) {
+		t.Errorf("schema contains %s", v)
+	}
+}
+
+func TestAPIModelTemplates(t *testing.T) {
+	t.Parallel()
+
+	data := testAPIModelTemplateData(t)
+
+	testCases := []struct {
+		TestName string
+		Template string
+	}{
+		{
+			TestName: "resource",
+			Template: resourceAPIModelTmpl,
+		},
+		{
+			TestName: "test",
+			Template: resourceAPIModelTestTmpl,
+		},
+		{
+			TestName: "sweep",
+			Template: sweepTmpl + `{{ template "file" . }}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			source, err := executeTemplate(testCase.TestName, testCase.Template, data)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := format.Source(source); err != nil {
+				t.Errorf("formatting generated source: %s\n%s", err, source)
+			}
+		})
+	}
+}
+
+func TestInsertIntoSource(t *testing.T) {
+	t.Parallel()
+
+	src := `package example
+
+import (
+	"fmt"
+)
+
+func init() {
+	register("a")
+}
+
+func sweepA() error {
+	return fmt.Errorf("a")
+}
+`
+
+	got, err := insertIntoSource("sweep.go", []byte(src), []string{"fmt", "log"}, `	register("b")`, "func sweepB() {}")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err = format.Source(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `package example
+
+import (
+	"fmt"
+	"log"
+)
+
+func init() {
+	register("a")
+
+	register("b")
+}
+
+func sweepA() error {
+	return fmt.Errorf("a")
+}
+
+func sweepB() {}
+`
+
+	if string(got) != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestExportLines(t *testing.T) {
+	t.Parallel()
+
+	existing := []string{
+		"FindIndex = findIndex",
+		"",
+		"ResourceIndex = newResourceIndex",
+	}
+	exports := map[string]string{
+		"FindWidgetByID": "findWidgetByID",
+		"ResourceIndex":  "newResourceIndex",
+		"ResourceWidget": "newResourceWidget",
+	}
+
+	got := exportLines(existing, exports)
+	want := []string{
+		"FindIndex = findIndex",
+		"FindWidgetByID = findWidgetByID",
+		"",
+		"ResourceIndex = newResourceIndex",
+		"ResourceWidget = newResourceWidget",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestPlural(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"Widget":  "Widgets",
+		"Index":   "Indexes",
+		"Policy":  "Policies",
+		"Gateway": "Gateways",
+		"Access":  "Accesses",
+	}
+
+	for input, want := range testCases {
+		if got := plural(input); got != want {
+			t.Errorf("plural(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
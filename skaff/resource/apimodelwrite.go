@@ -0,0 +1,409 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
+)
+
+//go:embed resourceapimodel.tmpl
+var resourceAPIModelTmpl string
+
+//go:embed resourceapimodeltest.tmpl
+var resourceAPIModelTestTmpl string
+
+//go:embed sweep.tmpl
+var sweepTmpl string
+
+const (
+	exportsFilename = "exports_test.go"
+	sweepFilename   = "sweep.go"
+)
+
+type apiModelTemplateData struct {
+	TemplateData
+	*APIModelData
+	HumanResourceNamePlural string
+}
+
+var templateFuncs = template.FuncMap{
+	"join": func(s []string) string {
+		return strings.Join(s, ", ")
+	},
+}
+
+// CreateFromAPIModel generates a Plugin Framework resource, its finder, status and waiter functions,
+// acceptance tests, sweeper and test exports from the service's AWS SDK for Go v2 API model.
+// Run `go generate` in the service package afterwards to register the resource.
+func CreateFromAPIModel(resName, snakeName string, force bool, ops APIOperations) error {
+	td, err := newTemplateData(resName, snakeName, false, true, true)
+	if err != nil {
+		return err
+	}
+
+	sdkPackage, err := names.AWSGoV2Package(td.ServicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS SDK for Go v2 package name: %w", err)
+	}
+
+	dir, err := apimodel.PackageDir(sdkPackage)
+	if err != nil {
+		return err
+	}
+
+	model, err := apimodel.Load(dir)
+	if err != nil {
+		return fmt.Errorf("reading %s API model: %w", sdkPackage, err)
+	}
+
+	d, err := NewAPIModelData(model, resName, ops)
+	if err != nil {
+		return err
+	}
+
+	data := apiModelTemplateData{
+		TemplateData:            td,
+		APIModelData:            d,
+		HumanResourceNamePlural: plural(td.HumanResourceName),
+	}
+
+	f := fmt.Sprintf("%s.go", td.ResourceSnake)
+	if err := writeGoTemplate("newres", f, resourceAPIModelTmpl, force, data); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", td.ResourceSnake)
+	if err := writeGoTemplate("restest", tf, resourceAPIModelTestTmpl, force, data); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	if err := writeWebsiteDoc(force, td); err != nil {
+		return err
+	}
+
+	if d.ListOperation != "" {
+		if err := addSweeper(sweepFilename, data); err != nil {
+			return fmt.Errorf("writing sweeper: %w", err)
+		}
+	} else {
+		d.Warnings = append(d.Warnings, "no list operation found, no sweeper generated")
+	}
+
+	exports := map[string]string{
+		"Find" + resName + "ByID": "find" + resName + "ByID",
+		"Resource" + resName:      "newResource" + resName,
+	}
+	if err := addExports(exportsFilename, td.ServicePackage, exports); err != nil {
+		return fmt.Errorf("writing test exports: %w", err)
+	}
+
+	for _, v := range d.Warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", v)
+	}
+	fmt.Printf("Run `go generate` in %s to register %s.\n", td.ServicePackage, td.ProviderResourceName)
+
+	return nil
+}
+
+func executeTemplate(templateName, tmpl string, data any) ([]byte, error) {
+	tplate, err := template.New(templateName).Funcs(templateFuncs).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tplate.Execute(&buffer, data); err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// writeGoTemplate executes the template and writes the gofmt'd result.
+// If the result can't be formatted it's written unformatted so that it can be fixed by hand.
+func writeGoTemplate(templateName, filename, tmpl string, force bool, data any) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	source, err := executeTemplate(templateName, tmpl, data)
+	if err != nil {
+		return err
+	}
+
+	return writeGoSource(filename, source)
+}
+
+func writeGoSource(filename string, source []byte) error {
+	contents, formatErr := format.Source(source)
+	if formatErr != nil {
+		contents = source
+	}
+
+	if err := os.WriteFile(filename, contents, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	if formatErr != nil {
+		return fmt.Errorf("error formatting generated file (%s): %s", filename, formatErr)
+	}
+
+	return nil
+}
+
+// addSweeper adds the resource's sweeper to the service package's sweeper file, creating the file if necessary.
+func addSweeper(filename string, data apiModelTemplateData) error {
+	src, err := os.ReadFile(filename)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		source, err := executeTemplate("sweep", sweepTmpl, data)
+		if err != nil {
+			return err
+		}
+
+		return writeGoSource(filename, source)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if bytes.Contains(src, []byte(strconv.Quote(data.ProviderResourceName))) {
+		return fmt.Errorf("sweeper for %s already exists in %s", data.ProviderResourceName, filename)
+	}
+
+	init, err := executeTemplate("sweep", sweepTmpl+`{{ template "init" . }}`, data)
+	if err != nil {
+		return err
+	}
+
+	sweeper, err := executeTemplate("sweep", sweepTmpl+`{{ template "func" . }}`, data)
+	if err != nil {
+		return err
+	}
+
+	imports := []string{
+		"fmt",
+		"log",
+		"github.com/aws/aws-sdk-go-v2/service/" + data.SDKPackage,
+		"github.com/hashicorp/terraform-plugin-testing/helper/resource",
+		"github.com/hashicorp/terraform-provider-aws/internal/sweep",
+		"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework",
+	}
+	if data.SweepUsesAWS() {
+		imports = append(imports, "github.com/aws/aws-sdk-go-v2/aws")
+	}
+
+	source, err := insertIntoSource(filename, src, imports, string(init), string(sweeper))
+	if err != nil {
+		return err
+	}
+
+	return writeGoSource(filename, source)
+}
+
+// insertIntoSource adds any missing imports to the Go source, the statement to the end of its init function
+// (adding one if necessary) and the declaration to its end.
+func insertIntoSource(filename string, src []byte, imports []string, stmt, decl string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", filename, err)
+	}
+
+	type insertion struct {
+		offset int
+		text   string
+	}
+	var insertions []insertion
+
+	existing := make(map[string]bool)
+	for _, v := range file.Imports {
+		if path, err := strconv.Unquote(v.Path.Value); err == nil {
+			existing[path] = true
+		}
+	}
+	var missing strings.Builder
+	for _, path := range imports {
+		if !existing[path] {
+			fmt.Fprintf(&missing, "\t%q\n", path)
+		}
+	}
+	if missing.Len() > 0 {
+		var importDecl *ast.GenDecl
+		for _, decl := range file.Decls {
+			if decl, ok := decl.(*ast.GenDecl); ok && decl.Tok == token.IMPORT && decl.Lparen.IsValid() {
+				importDecl = decl
+				break
+			}
+		}
+
+		if importDecl != nil {
+			insertions = append(insertions, insertion{fset.Position(importDecl.Rparen).Offset, missing.String()})
+		} else {
+			insertions = append(insertions, insertion{fset.Position(file.Name.End()).Offset, "\n\nimport (\n" + missing.String() + ")"})
+		}
+	}
+
+	var initFunc *ast.FuncDecl
+	for _, decl := range file.Decls {
+		if decl, ok := decl.(*ast.FuncDecl); ok && decl.Recv == nil && decl.Name.Name == "init" {
+			initFunc = decl
+			break
+		}
+	}
+
+	if initFunc != nil {
+		insertions = append(insertions, insertion{fset.Position(initFunc.Body.Rbrace).Offset, "\n" + stmt + "\n"})
+		insertions = append(insertions, insertion{len(src), "\n" + decl + "\n"})
+	} else {
+		insertions = append(insertions, insertion{len(src), "\nfunc init() {\n" + stmt + "\n}\n\n" + decl + "\n"})
+	}
+
+	sort.SliceStable(insertions, func(i, j int) bool {
+		return insertions[i].offset > insertions[j].offset
+	})
+
+	for _, v := range insertions {
+		src = append(src[:v.offset:v.offset], append([]byte(v.text), src[v.offset:]...)...)
+	}
+
+	return src, nil
+}
+
+// addExports adds the exported aliases to the var block of the service package's test exports file,
+// creating the file if necessary. Aliases are kept sorted.
+func addExports(filename, servicePackage string, exports map[string]string) error {
+	src, err := os.ReadFile(filename)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		var source strings.Builder
+
+		fmt.Fprintf(&source, "// Copyright (c) HashiCorp, Inc.\n// SPDX-License-Identifier: MPL-2.0\n\npackage %s\n\n// Exports for use in tests only.\nvar (\n", servicePackage)
+		for _, line := range exportLines(nil, exports) {
+			source.WriteString(line + "\n")
+		}
+		source.WriteString(")\n")
+
+		return writeGoSource(filename, []byte(source.String()))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", filename, err)
+	}
+
+	var varDecl *ast.GenDecl
+	for _, decl := range file.Decls {
+		if decl, ok := decl.(*ast.GenDecl); ok && decl.Tok == token.VAR && decl.Lparen.IsValid() {
+			varDecl = decl
+			break
+		}
+	}
+
+	if varDecl == nil {
+		var source strings.Builder
+
+		source.Write(src)
+		source.WriteString("\n// Exports for use in tests only.\nvar (\n")
+		for _, line := range exportLines(nil, exports) {
+			source.WriteString(line + "\n")
+		}
+		source.WriteString(")\n")
+
+		return writeGoSource(filename, []byte(source.String()))
+	}
+
+	start, end := fset.Position(varDecl.Lparen).Offset+1, fset.Position(varDecl.Rparen).Offset
+	existing := strings.Split(strings.TrimSpace(string(src[start:end])), "\n")
+	for i, line := range existing {
+		existing[i] = strings.TrimSpace(line)
+	}
+
+	var block strings.Builder
+	block.WriteString("\n")
+	for _, line := range exportLines(existing, exports) {
+		block.WriteString(line + "\n")
+	}
+
+	source := append(append(append([]byte{}, src[:start]...), block.String()...), src[end:]...)
+
+	return writeGoSource(filename, source)
+}
+
+// exportLines returns the var block lines with any exports not already declared inserted in sorted position.
+// Existing lines, including blank lines separating groups and comments, are kept in place.
+func exportLines(existing []string, exports map[string]string) []string {
+	declared := make(map[string]bool)
+	for _, line := range existing {
+		if isExportLine(line) {
+			declared[exportName(line)] = true
+		}
+	}
+
+	var names []string
+	for name := range exports {
+		if !declared[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	lines := append([]string{}, existing...)
+	for _, name := range names {
+		line := fmt.Sprintf("%s = %s", name, exports[name])
+
+		// After the last export that sorts before it, or else before the first export.
+		i := -1
+		for j, v := range lines {
+			if isExportLine(v) && exportName(v) < name {
+				i = j + 1
+			}
+		}
+		if i == -1 {
+			i = len(lines)
+			for j, v := range lines {
+				if isExportLine(v) {
+					i = j
+					break
+				}
+			}
+		}
+
+		lines = append(lines[:i], append([]string{line}, lines[i:]...)...)
+	}
+
+	return lines
+}
+
+func isExportLine(line string) bool {
+	return strings.Contains(line, "=") && !strings.HasPrefix(line, "//")
+}
+
+func exportName(line string) string {
+	name, _, _ := strings.Cut(line, "=")
+
+	return strings.TrimSpace(name)
+}
@@ -71,43 +71,72 @@ func ProviderResourceName(servicePackage, snakeName string) string {
 }
 
 func Create(resName, snakeName string, comments, force, v2, pluginFramework bool) error {
+	templateData, err := newTemplateData(resName, snakeName, comments, v2, pluginFramework)
+	if err != nil {
+		return err
+	}
+
+	tmpl := resourceTmpl
+	if pluginFramework {
+		tmpl = resourceFrameworkTmpl
+	}
+	f := fmt.Sprintf("%s.go", templateData.ResourceSnake)
+	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", templateData.ResourceSnake)
+	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	if err = writeWebsiteDoc(force, templateData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func newTemplateData(resName, snakeName string, comments, v2, pluginFramework bool) (TemplateData, error) {
+	var templateData TemplateData
+
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
-		return fmt.Errorf("error reading working directory: %s", err)
+		return templateData, fmt.Errorf("error reading working directory: %s", err)
 	}
 
 	servicePackage := filepath.Base(wd)
 
 	if resName == "" {
-		return fmt.Errorf("error checking: no name given")
+		return templateData, fmt.Errorf("error checking: no name given")
 	}
 
 	if resName == strings.ToLower(resName) {
-		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+		return templateData, fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
 	}
 
 	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
-		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+		return templateData, fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
 	}
 
 	snakeName = ToSnakeCase(resName, snakeName)
 
 	s, err := names.ProviderNameUpper(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting service connection name: %w", err)
+		return templateData, fmt.Errorf("error getting service connection name: %w", err)
 	}
 
 	sn, err := names.FullHumanFriendly(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting AWS service name: %w", err)
+		return templateData, fmt.Errorf("error getting AWS service name: %w", err)
 	}
 
 	hf, err := names.HumanFriendly(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting human-friendly name: %w", err)
+		return templateData, fmt.Errorf("error getting human-friendly name: %w", err)
 	}
 
-	templateData := TemplateData{
+	templateData = TemplateData{
 		Resource:             resName,
 		ResourceLower:        strings.ToLower(resName),
 		ResourceSnake:        snakeName,
@@ -123,23 +152,13 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework bool
 		ProviderResourceName: ProviderResourceName(servicePackage, snakeName),
 	}
 
-	tmpl := resourceTmpl
-	if pluginFramework {
-		tmpl = resourceFrameworkTmpl
-	}
-	f := fmt.Sprintf("%s.go", snakeName)
-	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource template: %w", err)
-	}
-
-	tf := fmt.Sprintf("%s_test.go", snakeName)
-	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource test template: %w", err)
-	}
+	return templateData, nil
+}
 
-	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+func writeWebsiteDoc(force bool, td TemplateData) error {
+	wf := fmt.Sprintf("%s_%s.html.markdown", td.ServicePackage, td.ResourceSnake)
 	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
-	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+	if err := writeTemplate("webdoc", wf, websiteTmpl, force, td); err != nil {
 		return fmt.Errorf("writing resource website doc template: %w", err)
 	}
 
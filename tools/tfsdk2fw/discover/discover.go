@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package discover finds the finder, status and waiter functions for a resource
+// and the AWS API client accessor used in a service package's Go source.
+package discover
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Func describes a package-level function.
+type Func struct {
+	Name    string
+	Params  []string // Parameter types, as written in source.
+	Results []string // Result types, as written in source.
+}
+
+// Signature returns the function's signature as written in source, e.g. "findQueueByURL(context.Context, *sqs.Client, string)".
+func (f *Func) Signature() string {
+	return fmt.Sprintf("%s(%s)", f.Name, strings.Join(f.Params, ", "))
+}
+
+// TakesID returns whether the function's parameters are (context.Context, <API client>, string).
+// Any trailing variadic parameter is ignored.
+func (f *Func) TakesID() bool {
+	params := f.requiredParams()
+
+	return len(params) == 3 && params[0] == "context.Context" && params[2] == "string"
+}
+
+// TakesIDAndTimeout returns whether the function's parameters are (context.Context, <API client>, string, time.Duration).
+// Any trailing variadic parameter is ignored.
+func (f *Func) TakesIDAndTimeout() bool {
+	params := f.requiredParams()
+
+	return len(params) == 4 && params[0] == "context.Context" && params[2] == "string" && params[3] == "time.Duration"
+}
+
+// requiredParams returns the function's parameter types without any trailing variadic parameter.
+func (f *Func) requiredParams() []string {
+	if n := len(f.Params); n > 0 && strings.HasPrefix(f.Params[n-1], "...") {
+		return f.Params[:n-1]
+	}
+
+	return f.Params
+}
+
+// apiClientType returns the type of the function's API client parameter, if any.
+func (f *Func) apiClientType() string {
+	if len(f.Params) < 2 || f.Params[0] != "context.Context" {
+		return ""
+	}
+
+	return f.Params[1]
+}
+
+// Resource holds the functions discovered for a single resource.
+type Resource struct {
+	APIClientAccessor string // AWSClient method returning the service's API client, e.g. "SQSClient".
+	Finder            *Func
+	Status            *Func
+	WaitCreated       *Func
+	WaitDeleted       *Func
+	WaitUpdated       *Func
+}
+
+var (
+	apiClientAccessorRegexp = regexp.MustCompile(`^[A-Z]\w*(Client|Conn)$`)
+	waitCreatedPrefixes     = []string{"Created", "Available", "Active", "Ready"}
+)
+
+// ForResource parses the Go source files in the specified directory and returns the functions for the named resource.
+// Function names are matched case-insensitively on the resource name, e.g. for resource name "Queue":
+//
+//	findQueue, findQueueByID, findQueueByURL
+//	statusQueue, statusQueueState
+//	waitQueueCreated, waitQueueAvailable, waitQueueUpdated, waitQueueDeleted
+func ForResource(dir, name string) (*Resource, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.SkipObjectResolution)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing Go source in %s: %w", dir, err)
+	}
+
+	var files []*ast.File
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			files = append(files, file)
+		}
+	}
+
+	return forResource(fset, files, name), nil
+}
+
+func forResource(fset *token.FileSet, files []*ast.File, name string) *Resource {
+	quoted := regexp.QuoteMeta(name)
+	finderRegexp := regexp.MustCompile(`(?i)^find` + quoted + `(By\w+)?$`)
+	statusRegexp := regexp.MustCompile(`(?i)^status` + quoted + `(\w+)?$`)
+	waitRegexp := regexp.MustCompile(`(?i)^wait` + quoted + `(\w+)$`)
+
+	var finders, statuses []*Func
+	waiters := make(map[string]*Func)
+	accessors := make(map[string]int)
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+
+			if !ok || funcDecl.Recv != nil {
+				continue
+			}
+
+			funcName := funcDecl.Name.Name
+
+			switch {
+			case finderRegexp.MatchString(funcName):
+				finders = append(finders, newFunc(fset, funcDecl))
+			case statusRegexp.MatchString(funcName):
+				statuses = append(statuses, newFunc(fset, funcDecl))
+			case waitRegexp.MatchString(funcName):
+				waiters[waitRegexp.FindStringSubmatch(funcName)[1]] = newFunc(fset, funcDecl)
+			}
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			if call, ok := n.(*ast.CallExpr); ok && len(call.Args) == 1 {
+				if sel, ok := call.Fun.(*ast.SelectorExpr); ok && apiClientAccessorRegexp.MatchString(sel.Sel.Name) {
+					accessors[sel.Sel.Name]++
+				}
+			}
+
+			return true
+		})
+	}
+
+	r := &Resource{
+		Finder:      preferredFinder(finders),
+		Status:      shortestName(statuses),
+		WaitCreated: waiterWithPrefix(waiters, waitCreatedPrefixes...),
+		WaitDeleted: waiterWithPrefix(waiters, "Deleted"),
+		WaitUpdated: waiterWithPrefix(waiters, "Updated"),
+	}
+
+	// AWS SDK for Go v2 API clients are named "Client" and are returned by "<Service>Client" accessors.
+	// AWS SDK for Go v1 API clients are returned by "<Service>Conn" accessors.
+	suffix := ""
+	if r.Finder != nil {
+		if strings.HasSuffix(r.Finder.apiClientType(), ".Client") {
+			suffix = "Client"
+		} else {
+			suffix = "Conn"
+		}
+	}
+	r.APIClientAccessor = mostUsed(accessors, suffix)
+
+	return r
+}
+
+func newFunc(fset *token.FileSet, funcDecl *ast.FuncDecl) *Func {
+	f := &Func{
+		Name: funcDecl.Name.Name,
+	}
+
+	f.Params = fieldTypes(fset, funcDecl.Type.Params)
+	f.Results = fieldTypes(fset, funcDecl.Type.Results)
+
+	return f
+}
+
+// fieldTypes returns the types in a function's parameter or result list, one per name.
+func fieldTypes(fset *token.FileSet, fields *ast.FieldList) []string {
+	var types []string
+
+	if fields == nil {
+		return types
+	}
+
+	for _, field := range fields.List {
+		sb := strings.Builder{}
+		printer.Fprint(&sb, fset, field.Type) //nolint:errcheck // Writes to a strings.Builder can't fail.
+		typ := sb.String()
+
+		n := len(field.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			types = append(types, typ)
+		}
+	}
+
+	return types
+}
+
+// waiterWithPrefix returns the waiter whose name, following the resource name, starts with the first matching prefix,
+// e.g. "Available" matches "waitQueueAvailable" and "waitQueueAvailableSDKv2". Exact matches are preferred.
+func waiterWithPrefix(waiters map[string]*Func, prefixes ...string) *Func {
+	for _, prefix := range prefixes {
+		if v, ok := waiters[prefix]; ok {
+			return v
+		}
+
+		var matches []*Func
+		for suffix, v := range waiters {
+			if strings.HasPrefix(suffix, prefix) {
+				matches = append(matches, v)
+			}
+		}
+
+		if v := shortestName(matches); v != nil {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// preferredFinder returns the finder that takes the resource's ID, preferring "ByID" finders.
+func preferredFinder(finders []*Func) *Func {
+	sort.Slice(finders, func(i, j int) bool {
+		return finders[i].Name < finders[j].Name
+	})
+
+	var takesID *Func
+	for _, f := range finders {
+		if !f.TakesID() {
+			continue
+		}
+
+		if strings.HasSuffix(f.Name, "ByID") {
+			return f
+		}
+
+		if takesID == nil {
+			takesID = f
+		}
+	}
+
+	if takesID != nil {
+		return takesID
+	}
+
+	return shortestName(finders)
+}
+
+func shortestName(funcs []*Func) *Func {
+	var shortest *Func
+
+	for _, f := range funcs {
+		if shortest == nil || len(f.Name) < len(shortest.Name) || (len(f.Name) == len(shortest.Name) && f.Name < shortest.Name) {
+			shortest = f
+		}
+	}
+
+	return shortest
+}
+
+// mostUsed returns the most used accessor with the specified suffix, or with any suffix if none match.
+func mostUsed(accessors map[string]int, suffix string) string {
+	var best string
+
+	for _, anySuffix := range []bool{false, true} {
+		for name, n := range accessors {
+			if !anySuffix && !strings.HasSuffix(name, suffix) {
+				continue
+			}
+
+			if best == "" || n > accessors[best] || (n == accessors[best] && name < best) {
+				best = name
+			}
+		}
+
+		if best != "" {
+			break
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package discover_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/discover"
+)
+
+const testSource = `package example
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/example"
+)
+
+func resourceWidgetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+	_ = conn
+	return nil
+}
+
+func resourceWidgetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+	legacy := meta.(*conns.AWSClient).ExampleConn(ctx)
+	_, _ = conn, legacy
+	return nil
+}
+
+func findWidget(ctx context.Context, conn *example.Client, input *example.DescribeWidgetInput) (*example.Widget, error) {
+	return nil, nil
+}
+
+func findWidgetByID(ctx context.Context, conn *example.Client, id string) (*example.Widget, error) {
+	return nil, nil
+}
+
+func findWidgetByName(ctx context.Context, conn *example.Client, name string) (*example.Widget, error) {
+	return nil, nil
+}
+
+func findWidgetPolicy(ctx context.Context, conn *example.Client, id string) (*string, error) {
+	return nil, nil
+}
+
+func statusWidget(ctx context.Context, conn *example.Client, id string) retry.StateRefreshFunc {
+	return nil
+}
+
+func waitWidgetAvailableV2(ctx context.Context, conn *example.Client, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*example.Widget, error) {
+	return nil, nil
+}
+
+func waitWidgetDeleted(ctx context.Context, conn *example.Client, id, region string) (*example.Widget, error) {
+	return nil, nil
+}
+`
+
+func TestForResource(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "widget.go"), []byte(testSource), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := discover.ForResource(dir, "Widget")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := got.APIClientAccessor, "ExampleClient"; got != want {
+		t.Errorf("APIClientAccessor = %q, want %q", got, want)
+	}
+
+	if got.Finder == nil {
+		t.Fatal("no finder")
+	}
+	if got, want := got.Finder.Name, "findWidgetByID"; got != want {
+		t.Errorf("Finder = %q, want %q", got, want)
+	}
+	if !got.Finder.TakesID() {
+		t.Errorf("Finder %s does not take ID", got.Finder.Signature())
+	}
+
+	if got.Status == nil || got.Status.Name != "statusWidget" {
+		t.Errorf("Status = %v, want statusWidget", got.Status)
+	}
+
+	if got.WaitCreated == nil {
+		t.Fatal("no create waiter")
+	}
+	if got, want := got.WaitCreated.Name, "waitWidgetAvailableV2"; got != want {
+		t.Errorf("WaitCreated = %q, want %q", got, want)
+	}
+	if !got.WaitCreated.TakesIDAndTimeout() {
+		t.Errorf("WaitCreated %s does not take ID and timeout", got.WaitCreated.Signature())
+	}
+
+	if got.WaitDeleted == nil {
+		t.Fatal("no delete waiter")
+	}
+	if got, want := got.WaitDeleted.Signature(), "waitWidgetDeleted(context.Context, *example.Client, string, string)"; got != want {
+		t.Errorf("WaitDeleted = %q, want %q", got, want)
+	}
+	if got.WaitDeleted.TakesIDAndTimeout() {
+		t.Errorf("WaitDeleted %s takes ID and timeout", got.WaitDeleted.Signature())
+	}
+
+	if got.WaitUpdated != nil {
+		t.Errorf("WaitUpdated = %v, want nil", got.WaitUpdated)
+	}
+}
+
+func TestForResourceNoMatches(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "widget.go"), []byte(testSource), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := discover.ForResource(dir, "Gadget")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Finder != nil || got.Status != nil || got.WaitCreated != nil || got.WaitDeleted != nil || got.WaitUpdated != nil {
+		t.Errorf("unexpected functions: %+v", got)
+	}
+
+	if got, want := got.APIClientAccessor, "ExampleClient"; got != want {
+		t.Errorf("APIClientAccessor = %q, want %q", got, want)
+	}
+}
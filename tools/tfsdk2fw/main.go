@@ -11,12 +11,17 @@ import (
 	"io"
 	"os"
 	"path"
+	"reflect"
+	"runtime"
 	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/discover"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 	"golang.org/x/exp/slices"
 )
@@ -58,10 +63,11 @@ func main() {
 		PackageName: packageName,
 	}
 
-	p, err := provider.New(context.Background())
+	ctx := context.Background()
+	p, err := provider.New(ctx)
 
 	if err != nil {
-		g.Fatalf(err.Error())
+		g.Fatalf("%s", err)
 	}
 
 	if v := *dataSourceType; v != "" {
@@ -76,13 +82,16 @@ func main() {
 		migrator.Template = datasourceImpl
 		migrator.TFTypeName = v
 	} else if v := *resourceType; v != "" {
-		resource, ok := p.ResourcesMap[v]
+		// Use the resource as registered by its service package, before the provider wraps its CRUD handlers, importer and state upgraders.
+		resource, ok := findSDKResource(ctx, p.Meta().(*conns.AWSClient), v)
 
 		if !ok {
 			g.Fatalf("resource type %s not found", v)
 		}
 
-		migrator.Resource = resource
+		migrator.HumanName = resource.Name
+		migrator.Resource = resource.Factory()
+		migrator.Tags = resource.Tags
 		migrator.Template = resourceImpl
 		migrator.TFTypeName = v
 	}
@@ -92,12 +101,27 @@ func main() {
 	}
 }
 
+// findSDKResource returns the service package registration for the specified Plugin SDK resource type.
+func findSDKResource(ctx context.Context, client *conns.AWSClient, typeName string) (*types.ServicePackageSDKResource, bool) {
+	for _, sp := range client.ServicePackages {
+		for _, v := range sp.SDKResources(ctx) {
+			if v.TypeName == typeName {
+				return v, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
 type migrator struct {
 	Generator    *common.Generator
+	HumanName    string
 	IsDataSource bool
 	Name         string
 	PackageName  string
 	Resource     *schema.Resource
+	Tags         *types.ServicePackageResourceTags
 	Template     string
 	TFTypeName   string
 }
@@ -114,7 +138,7 @@ func (m *migrator) migrate(outputFilename string) error {
 		return fmt.Errorf("creating target directory %s: %w", dirname, err)
 	}
 
-	templateData, err := m.generateTemplateData()
+	templateData, err := m.generateTemplateData(outputFilename)
 
 	if err != nil {
 		return err
@@ -129,14 +153,15 @@ func (m *migrator) migrate(outputFilename string) error {
 	return d.Write()
 }
 
-func (m *migrator) generateTemplateData() (*templateData, error) {
+func (m *migrator) generateTemplateData(outputFilename string) (*templateData, error) {
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
 	emitter := &emitter{
-		Generator:    m.Generator,
-		IsDataSource: m.IsDataSource,
-		SchemaWriter: &sbSchema,
-		StructWriter: &sbStruct,
+		Generator:          m.Generator,
+		IsDataSource:       m.IsDataSource,
+		SchemaWriter:       &sbSchema,
+		StructWriter:       &sbStruct,
+		TransparentTagging: m.Tags != nil,
 	}
 
 	err := emitter.emitSchemaForResource(m.Resource)
@@ -145,22 +170,85 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		return nil, fmt.Errorf("emitting schema code: %w", err)
 	}
 
+	humanName := m.HumanName
+	if humanName == "" {
+		humanName = m.Name
+	}
+
 	templateData := &templateData{
 		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
 		DefaultReadTimeout:           emitter.DefaultReadTimeout,
 		DefaultUpdateTimeout:         emitter.DefaultUpdateTimeout,
 		DefaultDeleteTimeout:         emitter.DefaultDeleteTimeout,
-		EmitResourceImportState:      m.Resource.Importer != nil,
 		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
 		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
 		HasTimeouts:                  emitter.HasTimeouts,
+		HumanName:                    humanName,
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
 		Name:                         m.Name,
 		PackageName:                  m.PackageName,
 		Schema:                       sbSchema.String(),
+		SchemaVersion:                m.Resource.SchemaVersion,
 		Struct:                       sbStruct.String(),
 		TFTypeName:                   m.TFTypeName,
+		TransparentTagging:           emitter.TransparentTagging && emitter.HasTopLevelTagsMap && emitter.HasTopLevelTagsAllMap,
+	}
+
+	if !m.IsDataSource {
+		if v := m.Resource.Importer; v != nil {
+			if v.StateContext != nil && funcName(v.StateContext) == funcName(schema.ImportStatePassthroughContext) {
+				templateData.EmitResourceImportByID = true
+			} else {
+				templateData.EmitResourceImportState = true
+				if v.StateContext != nil {
+					if name := samePackageFuncName(v.StateContext, m.PackageName); name != "" {
+						templateData.ImportStateFunc = name
+					} else {
+						templateData.ImportStateFunc = funcName(v.StateContext)
+					}
+				}
+			}
+		}
+
+		if v := m.Tags; v != nil {
+			templateData.TagsIdentifierAttribute = v.IdentifierAttribute
+			templateData.TagsResourceType = v.ResourceType
+		}
+
+		// Each Plugin SDK v2 state upgrader upgrades from its Version to Version+1.
+		for i := 0; i < m.Resource.SchemaVersion; i++ {
+			upgrader := stateUpgrader{Version: i}
+
+			for _, v := range m.Resource.StateUpgraders {
+				if v.Version == i && v.Upgrade != nil {
+					upgrader.Func = samePackageFuncName(v.Upgrade, m.PackageName)
+				}
+			}
+
+			if upgrader.Func == "" {
+				m.warnf("no package-level Plugin SDK v2 state upgrader for schema version %d", i)
+			}
+
+			templateData.StateUpgraders = append(templateData.StateUpgraders, upgrader)
+		}
+
+		// Discover finder and waiter functions in the target package.
+		resource, err := discover.ForResource(path.Dir(outputFilename), m.Name)
+
+		if err != nil {
+			m.warnf("discovering finder and waiter functions: %s", err)
+		} else {
+			templateData.APIClientAccessor = resource.APIClientAccessor
+			templateData.Finder = resource.Finder
+			templateData.WaitCreated = resource.WaitCreated
+			templateData.WaitDeleted = resource.WaitDeleted
+			templateData.WaitUpdated = resource.WaitUpdated
+		}
+
+		if templateData.APIClientAccessor == "" {
+			m.warnf("no AWS API client accessor found in %s", path.Dir(outputFilename))
+		}
 	}
 
 	for _, v := range emitter.FrameworkPlanModifierPackages {
@@ -186,6 +274,35 @@ func (m *migrator) infof(format string, a ...interface{}) {
 	m.Generator.Infof(format, a...)
 }
 
+func (m *migrator) warnf(format string, a ...interface{}) {
+	m.Generator.Warnf(format, a...)
+}
+
+// funcName returns the fully qualified name of the specified function.
+func funcName(f any) string {
+	return runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
+}
+
+// samePackageFuncName returns the unqualified name of the specified function if it's a
+// package-level function in the named service package, otherwise "".
+func samePackageFuncName(f any, packageName string) string {
+	name := funcName(f)
+	prefix := "/internal/service/" + packageName + "."
+
+	i := strings.LastIndex(name, prefix)
+	if i < 0 {
+		return ""
+	}
+
+	name = name[i+len(prefix):]
+	// Closures and methods have qualified names, e.g. "resourceQueue.func1".
+	if strings.Contains(name, ".") {
+		return ""
+	}
+
+	return name
+}
+
 type emitter struct {
 	DefaultCreateTimeout          int64
 	DefaultReadTimeout            int64
@@ -203,6 +320,7 @@ type emitter struct {
 	ProviderPlanModifierPackages  []string // Package names for any provider plan modifiers. May contain duplicates.
 	SchemaWriter                  io.Writer
 	StructWriter                  io.Writer
+	TransparentTagging            bool // Whether the resource has a @Tags annotation.
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
@@ -285,6 +403,22 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			emittedFieldName = true
 		}
 
+		// Transparently tagged resources use the standard 'tags' and 'tags_all' attributes.
+		if isTopLevelAttribute && e.TransparentTagging {
+			switch name {
+			case "tags":
+				e.HasTopLevelTagsMap = true
+				fprintf(e.SchemaWriter, "names.AttrTags:tftags.TagsAttribute(),\n")
+				fprintf(e.StructWriter, "Tags types.Map `tfsdk:%q`\n", name)
+				continue
+			case "tags_all":
+				e.HasTopLevelTagsAllMap = true
+				fprintf(e.SchemaWriter, "names.AttrTagsAll:tftags.TagsAttributeComputedOnly(),\n")
+				fprintf(e.StructWriter, "TagsAll types.Map `tfsdk:%q`\n", name)
+				continue
+			}
+		}
+
 		fprintf(e.SchemaWriter, "%q:", name)
 
 		if isTopLevelAttribute {
@@ -883,24 +1017,42 @@ func unsupportedTypeError(path []string, typ string) error {
 }
 
 type templateData struct {
+	APIClientAccessor             string // e.g. EC2Client
 	DefaultCreateTimeout          int64
 	DefaultReadTimeout            int64
 	DefaultUpdateTimeout          int64
 	DefaultDeleteTimeout          int64
+	EmitResourceImportByID        bool
 	EmitResourceImportState       bool
 	EmitResourceModifyPlan        bool
 	EmitResourceUpdateSkeleton    bool
+	Finder                        *discover.Func
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
 	HasTimeouts                   bool
+	HumanName                     string // e.g. Instance
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	ImportStateFunc               string // Name of the Plugin SDK v2 importer.
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
 	ProviderPlanModifierPackages  []string
 	Schema                        string
+	SchemaVersion                 int
+	StateUpgraders                []stateUpgrader
 	Struct                        string
+	TagsIdentifierAttribute       string
+	TagsResourceType              string
 	TFTypeName                    string // e.g. aws_instance
+	TransparentTagging            bool
+	WaitCreated                   *discover.Func
+	WaitDeleted                   *discover.Func
+	WaitUpdated                   *discover.Func
+}
+
+type stateUpgrader struct {
+	Func    string // Plugin SDK v2 state upgrade function, or "" if not found.
+	Version int    // Schema version upgraded from.
 }
 
 //go:embed datasource.tmpl